@@ -3,12 +3,18 @@ package main
 import (
 	"context"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/vlad1028/order-manager/internal/archive"
 	"github.com/vlad1028/order-manager/internal/cache"
 	"github.com/vlad1028/order-manager/internal/db"
+	"github.com/vlad1028/order-manager/internal/expiry"
 	grpc2 "github.com/vlad1028/order-manager/internal/grpc"
 	"github.com/vlad1028/order-manager/internal/kafka"
 	"github.com/vlad1028/order-manager/internal/metrics"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/notify"
+	"github.com/vlad1028/order-manager/internal/order"
 	"github.com/vlad1028/order-manager/internal/order/service"
+	"github.com/vlad1028/order-manager/internal/verification"
 	desc "github.com/vlad1028/order-manager/pkg/order-service/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -17,6 +23,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 )
 
@@ -27,11 +34,37 @@ const (
 	kafkaHost   = "localhost:9092"
 )
 
+// Advisory lock keys electing the replicas that run background jobs.
 const (
-	kafkaTopic = "pvz.events.log"
-	cacheTTL   = 45 * time.Second
-	day        = 24 * time.Hour
-	week       = 7 * day
+	expirySweeperLockKey = 7001 // expires orders
+	archiverLockKey      = 7002 // archives orders
+	partitionsLockKey    = 7003 // manages table partitions
+	outboxRelayLockKey   = 7004 // publishes outbox messages
+	dispatcherLockKey    = 7005 // delivers notifications
+	schedulerLockKey     = 7006 // schedules expiry reminders
+)
+
+// defaultPickupPointEnv names the environment variable with the pickup point of requests that don't specify one.
+// Without it such requests are served by the seeded pickup point 0.
+const defaultPickupPointEnv = "DEFAULT_PICKUP_POINT_ID"
+
+const (
+	kafkaTopic         = "pvz.events.log"
+	outboxInterval     = time.Second
+	notifyInterval     = 5 * time.Second
+	reminderInterval   = time.Hour
+	sweepInterval      = time.Minute
+	archiveInterval    = time.Hour
+	archiveRetention   = 90 * day
+	partitionsInterval = 6 * time.Hour
+	cacheTTL           = 45 * time.Second
+	cacheCompressFrom  = 1024 // size of cached values in bytes starting from which they are compressed
+	memoryCacheSize    = 10000
+	memoryCacheBytes   = 16 << 20
+	memoryCacheTTL     = 5 * time.Second // bounds the staleness if invalidations of other replicas are lost
+	negativeCacheTTL   = 2 * time.Second // how long orders not found aren't looked up again
+	day                = 24 * time.Hour
+	week               = 7 * day
 )
 
 func main() {
@@ -43,6 +76,13 @@ func main() {
 	}
 	defer pool.Close()
 
+	partitions := db.NewPartitionManager(pool, db.NewAdvisoryLock(pool, partitionsLockKey), partitionsInterval,
+		// old order partitions get empty as the archiver moves their orders out
+		db.PartitionedTable{Name: "orders", Ahead: 2, Retention: 2 * archiveRetention},
+		db.PartitionedTable{Name: "orders_archive", Ahead: 2, Retention: 5 * 365 * day, DetachNonEmpty: true},
+	)
+	go partitions.Run(ctx)
+
 	orderRepo := db.SetupOrderRepository(pool)
 	kafkaProducer, err := kafka.NewSyncProducer([]string{kafkaHost}, kafkaTopic)
 	if err != nil {
@@ -50,10 +90,32 @@ func main() {
 	}
 	defer kafkaProducer.Close()
 
-	redis := cache.MustNew(ctx, cacheTTL)
+	outboxRelay := kafka.NewOutboxRelay(db.SetupOutboxStorage(pool), kafkaProducer, db.NewAdvisoryLock(pool, outboxRelayLockKey), outboxInterval)
+	go outboxRelay.Run(ctx)
+
+	notifications := db.SetupNotificationStorage(pool)
+	go notify.NewDispatcher(notifications, notify.LogNotifier{}, db.NewAdvisoryLock(pool, dispatcherLockKey), notifyInterval).Run(ctx)
+	go notify.NewScheduler(notifications, db.NewAdvisoryLock(pool, schedulerLockKey), week, reminderInterval).Run(ctx)
+
+	redis := cache.MustNew(ctx, cacheTTL, cache.Compressed(cache.ProtoCodec{}, cacheCompressFrom))
+	memory := cache.NewLRU(memoryCacheSize, memoryCacheBytes, cache.TTLs{Default: memoryCacheTTL})
+	orders := cache.NewLoading(cache.NewTiered(memory, redis), order.ErrOrderNotFound, negativeCacheTTL)
+	go redis.Listen(ctx, func(keys ...string) {
+		memory.Evict(keys...)
+		orders.Evict(keys...)
+	})
+
+	// pickup codes are written to stdout until a real delivery channel is configured
+	codes := verification.NewVerifier(db.SetupPickupCodeStorage(pool), verification.NewWriterNotifier(os.Stdout))
 
-	orderService := service.NewOrderService(0, week, 2*day, orderRepo, kafkaProducer, redis)
-	grpcAdaptor := grpc2.NewOrderGrpcAdaptor(orderService)
+	orderService := service.NewOrderService(0, week, 2*day, orderRepo, orders, redis, codes)
+	grpcAdaptor := grpc2.NewOrderGrpcAdaptor(orderService, defaultPickupPoint())
+
+	sweeper := expiry.NewSweeper(orderService, db.NewAdvisoryLock(pool, expirySweeperLockKey), sweepInterval)
+	go sweeper.Run(ctx)
+
+	archiver := archive.NewArchiver(db.SetupArchiveStorage(pool), orderService, db.NewAdvisoryLock(pool, archiverLockKey), archiveRetention, archiveInterval)
+	go archiver.Run(ctx)
 
 	lis, err := net.Listen("tcp", grpcHost)
 	if err != nil {
@@ -83,3 +145,16 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+func defaultPickupPoint() basetypes.ID {
+	value, ok := os.LookupEnv(defaultPickupPointEnv)
+	if !ok {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", defaultPickupPointEnv, err)
+	}
+	return basetypes.ID(id)
+}
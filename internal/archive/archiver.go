@@ -0,0 +1,107 @@
+package archive
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/metrics"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+// TerminalStatuses are the statuses of orders which don't change anymore once the retention period is over.
+// The retention period must be longer than the return period, or issued orders may be archived before
+// the clients return them.
+var TerminalStatuses = []order.Status{order.Canceled, order.ReachedClient}
+
+// Storage moves orders to the archive.
+type Storage interface {
+	// ArchiveOrders moves up to limit orders to the archive: the ones with the given statuses updated before
+	// the given time and the deleted ones deleted before it. It returns the moved orders.
+	ArchiveOrders(ctx context.Context, statuses []order.Status, before time.Time, limit int) ([]*order.Order, error)
+}
+
+// Cache holds copies of orders which must not outlive the orders moved to the archive.
+type Cache interface {
+	// InvalidateOrders drops the cached copies of the orders and the cached lists they are in on all replicas.
+	InvalidateOrders(ctx context.Context, orders ...*order.Order)
+}
+
+// Lock elects the replica that runs the archiver.
+type Lock interface {
+	// TryLock acquires the lock if it is free and reports whether it is held by the caller.
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// Archiver periodically moves terminal and deleted orders older than the retention period to the archive.
+// Only the replica holding the lock archives.
+type Archiver struct {
+	storage   Storage
+	cache     Cache
+	lock      Lock
+	statuses  []order.Status
+	retention time.Duration // How long orders stay in the orders table after their last status update.
+	interval  time.Duration // Pause between archival passes.
+	batchSize int           // Maximum number of orders moved per transaction.
+}
+
+func NewArchiver(storage Storage, cache Cache, lock Lock, retention, interval time.Duration) *Archiver {
+	return &Archiver{
+		storage:   storage,
+		cache:     cache,
+		lock:      lock,
+		statuses:  TerminalStatuses,
+		retention: retention,
+		interval:  interval,
+		batchSize: 1000,
+	}
+}
+
+// Run archives orders until ctx is done and releases the lock afterwards.
+func (a *Archiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	defer func() {
+		if err := a.lock.Unlock(context.Background()); err != nil {
+			log.Printf("Failed to release the archiver lock: %v", err)
+		}
+	}()
+
+	for {
+		if _, err := a.Archive(ctx); err != nil {
+			log.Printf("Failed to archive orders: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Archive makes a single archival pass if the lock is held by this replica and returns the number of archived orders.
+// It moves orders in batches until there are none left and invalidates the cached copies of each batch.
+func (a *Archiver) Archive(ctx context.Context) (int, error) {
+	leader, err := a.lock.TryLock(ctx)
+	if err != nil || !leader {
+		return 0, err
+	}
+
+	before := time.Now().Add(-a.retention)
+	total := 0
+	for ctx.Err() == nil {
+		archived, err := a.storage.ArchiveOrders(ctx, a.statuses, before, a.batchSize)
+		n := len(archived)
+		total += n
+		metrics.AddArchivedOrdersTotal(n)
+		if n > 0 {
+			a.cache.InvalidateOrders(ctx, archived...)
+		}
+		if err != nil || n < a.batchSize {
+			return total, err
+		}
+	}
+	return total, ctx.Err()
+}
@@ -0,0 +1,73 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+type fakeLock struct {
+	held bool
+}
+
+func (l *fakeLock) TryLock(context.Context) (bool, error) {
+	return l.held, nil
+}
+
+func (l *fakeLock) Unlock(context.Context) error {
+	return nil
+}
+
+// memoryStorage archives left orders in batches.
+type memoryStorage struct {
+	left    int
+	batches []int
+	before  time.Time
+}
+
+func (s *memoryStorage) ArchiveOrders(_ context.Context, statuses []order.Status, before time.Time, limit int) ([]*order.Order, error) {
+	n := min(s.left, limit)
+	s.left -= n
+	s.batches = append(s.batches, n)
+	s.before = before
+	return make([]*order.Order, n), nil
+}
+
+// countingCache counts the invalidated orders.
+type countingCache struct {
+	invalidated int
+}
+
+func (c *countingCache) InvalidateOrders(_ context.Context, orders ...*order.Order) {
+	c.invalidated += len(orders)
+}
+
+func TestArchiver_Archive(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Leader", func(t *testing.T) {
+		storage, cache := &memoryStorage{left: 25}, &countingCache{}
+		a := NewArchiver(storage, cache, &fakeLock{held: true}, 30*24*time.Hour, 0)
+		a.batchSize = 10
+
+		n, err := a.Archive(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 25, n)
+		assert.Equal(t, []int{10, 10, 5}, storage.batches)
+		assert.Equal(t, 25, cache.invalidated)
+		assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), storage.before, time.Minute)
+	})
+
+	t.Run("Follower", func(t *testing.T) {
+		storage := &memoryStorage{left: 25}
+		a := NewArchiver(storage, &countingCache{}, &fakeLock{}, 30*24*time.Hour, 0)
+
+		n, err := a.Archive(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Empty(t, storage.batches)
+	})
+}
@@ -19,3 +19,19 @@ func (c *Mock) Get(ctx context.Context, key string) (*order.Order, bool) {
 func (c *Mock) Set(ctx context.Context, key string, value *order.Order) error {
 	return nil
 }
+
+func (c *Mock) Invalidate(ctx context.Context, keys ...string) error {
+	return nil
+}
+
+func (c *Mock) Load(ctx context.Context, key string, load func(context.Context) (*order.Order, error)) (*order.Order, error) {
+	return load(ctx)
+}
+
+func (c *Mock) LoadQuery(ctx context.Context, query string, tags []string, load func(context.Context) ([]*order.Order, error)) ([]*order.Order, error) {
+	return load(ctx)
+}
+
+func (c *Mock) InvalidateTags(ctx context.Context, tags ...string) error {
+	return nil
+}
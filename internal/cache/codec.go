@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/order/pb"
+	desc "github.com/vlad1028/order-manager/pkg/order-service/v1"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+var ErrUnknownVersion = errors.New("cached value has an unknown format version")
+
+// Codec encodes the cached values: single orders and lists of them.
+type Codec interface {
+	// Version identifies the format. It must change with every change of the format the older releases can't read.
+	Version() string
+	Marshal(orders []*order.Order) ([]byte, error)
+	Unmarshal(data []byte) ([]*order.Order, error)
+}
+
+// encode returns the orders encoded with the codec, prefixed with the codec version and a zero byte.
+// Values of other versions, e.g. the ones written by replicas of another release during a deploy, fail to decode.
+func encode(codec Codec, orders []*order.Order) ([]byte, error) {
+	data, err := codec.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, 0, len(codec.Version())+1+len(data))
+	value = append(value, codec.Version()...)
+	value = append(value, 0)
+	return append(value, data...), nil
+}
+
+// decode decodes the value encoded with encode. It fails with ErrUnknownVersion for values of other versions.
+func decode(codec Codec, value []byte) ([]*order.Order, error) {
+	version, data, ok := bytes.Cut(value, []byte{0})
+	if !ok || string(version) != codec.Version() {
+		return nil, ErrUnknownVersion
+	}
+	return codec.Unmarshal(data)
+}
+
+// JSONCodec encodes orders as JSON with the names of the Go fields, which makes it human-readable
+// but sensitive to renames of the fields.
+type JSONCodec struct{}
+
+func (JSONCodec) Version() string {
+	return "json/1"
+}
+
+func (JSONCodec) Marshal(orders []*order.Order) ([]byte, error) {
+	return json.Marshal(orders)
+}
+
+func (JSONCodec) Unmarshal(data []byte) ([]*order.Order, error) {
+	var orders []*order.Order
+	err := json.Unmarshal(data, &orders)
+	return orders, err
+}
+
+// ProtoCodec encodes orders as length-delimited desc.Order messages of the API,
+// so the values survive the changes of the Go structs and the compatible changes of the messages.
+type ProtoCodec struct{}
+
+func (ProtoCodec) Version() string {
+	return "proto/1"
+}
+
+func (ProtoCodec) Marshal(orders []*order.Order) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, o := range orders {
+		msg, err := pb.ConvertOrderToProto(o)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = protodelim.MarshalTo(&buf, msg); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (ProtoCodec) Unmarshal(data []byte) ([]*order.Order, error) {
+	r := bufio.NewReader(bytes.NewReader(data))
+
+	var orders []*order.Order
+	for {
+		msg := &desc.Order{}
+		err := protodelim.UnmarshalFrom(r, msg)
+		if errors.Is(err, io.EOF) {
+			return orders, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := pb.ConvertOrderFromProto(msg)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+}
+
+// compressed gzips the values of the codec of at least minSize bytes.
+// Every value starts with a byte telling whether the rest is compressed.
+type compressed struct {
+	codec   Codec
+	minSize int
+}
+
+const (
+	uncompressedValue byte = iota
+	gzipValue
+)
+
+// Compressed returns the codec compressing the values of codec of at least minSize bytes with gzip.
+func Compressed(codec Codec, minSize int) Codec {
+	return compressed{codec: codec, minSize: minSize}
+}
+
+func (c compressed) Version() string {
+	return c.codec.Version() + "+gzip"
+}
+
+func (c compressed) Marshal(orders []*order.Order) ([]byte, error) {
+	data, err := c.codec.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < c.minSize {
+		return append([]byte{uncompressedValue}, data...), nil
+	}
+
+	buf := bytes.NewBuffer([]byte{gzipValue})
+	w := gzip.NewWriter(buf)
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c compressed) Unmarshal(data []byte) ([]*order.Order, error) {
+	if len(data) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	switch data[0] {
+	case uncompressedValue:
+		return c.codec.Unmarshal(data[1:])
+	case gzipValue:
+		r, err := gzip.NewReader(bytes.NewReader(data[1:]))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.codec.Unmarshal(data)
+	}
+	return nil, fmt.Errorf("unknown compression %d", data[0])
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+func testOrders(n int) []*order.Order {
+	expiresAt := time.Date(2024, 10, 25, 12, 0, 0, 0, time.UTC)
+	orders := make([]*order.Order, 0, n)
+	for i := range n {
+		orders = append(orders, &order.Order{
+			ID:            basetypes.ID(i + 1),
+			ClientID:      2,
+			PickupPointID: 3,
+			Status:        order.Stored,
+			StatusUpdated: time.Date(2024, 10, 20, 12, 0, 0, 123, time.UTC),
+			Weight:        400,
+			Cost:          1020,
+			BaseCost:      1000,
+			ExpiresAt:     &expiresAt,
+			Dimensions:    order.Dimensions{Length: 10, Width: 20, Height: 30},
+			Packaging:     []order.PackagingLayer{{Type: "box", Cost: 20}},
+			Version:       4,
+		})
+	}
+	return orders
+}
+
+func TestCodecs(t *testing.T) {
+	codecs := []Codec{
+		JSONCodec{},
+		ProtoCodec{},
+		Compressed(ProtoCodec{}, 0),
+		Compressed(ProtoCodec{}, 1<<20),
+	}
+
+	for _, codec := range codecs {
+		t.Run(codec.Version(), func(t *testing.T) {
+			for _, orders := range [][]*order.Order{testOrders(1), testOrders(50), nil} {
+				value, err := encode(codec, orders)
+				assert.NoError(t, err)
+
+				decoded, err := decode(codec, value)
+				assert.NoError(t, err)
+				assert.Len(t, decoded, len(orders))
+				for i, o := range orders {
+					assert.True(t, o.StatusUpdated.Equal(decoded[i].StatusUpdated))
+					decoded[i].StatusUpdated = o.StatusUpdated
+					assert.Equal(t, o, decoded[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCodecs_IgnoreOtherVersions(t *testing.T) {
+	value, err := encode(JSONCodec{}, testOrders(1))
+	assert.NoError(t, err)
+
+	_, err = decode(ProtoCodec{}, value)
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+	_, err = decode(ProtoCodec{}, []byte(`{"ID":1}`))
+	assert.ErrorIs(t, err, ErrUnknownVersion, "values written before the versions were introduced")
+}
+
+func TestCompressed_CompressesLargeValues(t *testing.T) {
+	orders := testOrders(100)
+	raw, err := ProtoCodec{}.Marshal(orders)
+	assert.NoError(t, err)
+
+	small, err := Compressed(ProtoCodec{}, len(raw)+1).Marshal(orders)
+	assert.NoError(t, err)
+	assert.Equal(t, len(raw)+1, len(small))
+
+	large, err := Compressed(ProtoCodec{}, len(raw)).Marshal(orders)
+	assert.NoError(t, err)
+	assert.Less(t, len(large), len(raw)/2)
+}
@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"golang.org/x/sync/singleflight"
+)
+
+// earlyRefreshShare is the share of the TTL the early refresh of entries is spread over, see expiresEarly.
+const earlyRefreshShare = 0.05
+
+// expiresEarly reports whether a lookup should treat an entry which expires in remaining as expired already.
+// The chance grows as the expiry approaches, as in the XFetch algorithm, so the entries cached at the same time
+// are refreshed one by one by a few lookups instead of missing all at once.
+func expiresEarly(remaining, ttl time.Duration) bool {
+	return float64(remaining) <= float64(ttl)*earlyRefreshShare*-math.Log(1-rand.Float64())
+}
+
+// Loading adds loading of missing orders to a cache and keeps bursts of lookups away from the loader.
+// Concurrent loads of a key are coalesced into one, and lookups of orders the loader reports as not found
+// fail without calling it again for a short time.
+type Loading struct {
+	Tier
+	notFound    error         // the error of the loader for missing orders
+	negativeTTL time.Duration // How long the orders are reported as missing without loading them.
+	flights     singleflight.Group
+
+	mu      sync.Mutex
+	missing map[string]time.Time // expiry of the negative entries by key
+	now     func() time.Time
+}
+
+func NewLoading(tier Tier, notFound error, negativeTTL time.Duration) *Loading {
+	return &Loading{
+		Tier:        tier,
+		notFound:    notFound,
+		negativeTTL: negativeTTL,
+		missing:     make(map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Load returns the cached order or the one load returns, caching it.
+func (c *Loading) Load(ctx context.Context, key string, load func(context.Context) (*order.Order, error)) (*order.Order, error) {
+	if o, ok := c.Tier.Get(ctx, key); ok {
+		return o, nil
+	}
+	if c.isMissing(key) {
+		return nil, c.notFound
+	}
+
+	v, err, shared := c.flights.Do(key, func() (any, error) {
+		// the load is shared, so it must not fail the other callers if the first one is cancelled
+		o, err := load(context.WithoutCancel(ctx))
+		if errors.Is(err, c.notFound) {
+			c.setMissing(key)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err = c.Tier.Set(ctx, key, o); err != nil {
+			log.Printf("Failed to set order to cache: %v", err)
+		}
+		return o, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	o := v.(*order.Order)
+	if shared {
+		o = cloneOrder(o) // callers may modify the order
+	}
+	return o, nil
+}
+
+func (c *Loading) Set(ctx context.Context, key string, value *order.Order) error {
+	c.Evict(key)
+	return c.Tier.Set(ctx, key, value)
+}
+
+func (c *Loading) Invalidate(ctx context.Context, keys ...string) error {
+	c.Evict(keys...)
+	return c.Tier.Invalidate(ctx, keys...)
+}
+
+// Evict drops the negative entries of the keys, e.g. when other replicas add the orders.
+func (c *Loading) Evict(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.missing, key)
+	}
+}
+
+func (c *Loading) isMissing(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt, ok := c.missing[key]
+	if ok && !c.now().Before(expiresAt) {
+		delete(c.missing, key)
+		return false
+	}
+	return ok
+}
+
+func (c *Loading) setMissing(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.missing[key] = c.now().Add(c.negativeTTL)
+}
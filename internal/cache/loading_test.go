@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestLoading_CoalescesConcurrentLoads(t *testing.T) {
+	ctx := context.Background()
+	c := NewLoading(NewLRU(10, 0, TTLs{Default: time.Minute}), errNotFound, time.Second)
+
+	var loads atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	load := func(context.Context) (*order.Order, error) {
+		if loads.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return &order.Order{ID: 1, Status: order.Stored}, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*order.Order, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o, err := c.Load(ctx, "order:1", load)
+			assert.NoError(t, err)
+			results[i] = o
+		}()
+	}
+	<-started
+	time.Sleep(50 * time.Millisecond) // lets the other lookups join the load
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), loads.Load())
+	results[0].SetStatus(order.Canceled)
+	for _, o := range results[1:] {
+		assert.Equal(t, order.Stored, o.Status, "callers get their own copies")
+	}
+
+	_, err := c.Load(ctx, "order:1", func(context.Context) (*order.Order, error) {
+		return nil, errors.New("the cached order must be used")
+	})
+	assert.NoError(t, err)
+}
+
+func TestLoading_CachesNotFound(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+	c := NewLoading(NewLRU(10, 0, TTLs{Default: time.Minute}), errNotFound, time.Second)
+	c.now = func() time.Time { return now }
+
+	loads := 0
+	load := func(context.Context) (*order.Order, error) {
+		loads++
+		return nil, errNotFound
+	}
+
+	for range 3 {
+		_, err := c.Load(ctx, "order:1", load)
+		assert.ErrorIs(t, err, errNotFound)
+	}
+	assert.Equal(t, 1, loads)
+
+	now = now.Add(time.Second)
+	_, err := c.Load(ctx, "order:1", load)
+	assert.ErrorIs(t, err, errNotFound)
+	assert.Equal(t, 2, loads, "negative entries expire")
+
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1}))
+	o, err := c.Load(ctx, "order:1", load)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, loads)
+	assert.NotNil(t, o)
+}
+
+func TestLoading_DoesNotCacheOtherErrors(t *testing.T) {
+	ctx := context.Background()
+	c := NewLoading(NewLRU(10, 0, TTLs{Default: time.Minute}), errNotFound, time.Second)
+
+	loads := 0
+	load := func(context.Context) (*order.Order, error) {
+		loads++
+		return nil, errors.New("database is unavailable")
+	}
+
+	_, err := c.Load(ctx, "order:1", load)
+	assert.Error(t, err)
+	_, err = c.Load(ctx, "order:1", load)
+	assert.Error(t, err)
+	assert.Equal(t, 2, loads)
+}
+
+func TestExpiresEarly(t *testing.T) {
+	ttl := time.Minute
+
+	early := 0
+	for range 1000 {
+		assert.False(t, expiresEarly(ttl, ttl), "fresh entries are never refreshed early")
+		if expiresEarly(time.Millisecond, ttl) {
+			early++
+		}
+	}
+	assert.Greater(t, early, 900, "entries about to expire are almost always refreshed")
+}
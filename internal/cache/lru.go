@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/vlad1028/order-manager/internal/metrics"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+// Tier names of the cache metrics.
+const (
+	TierMemory = "memory"
+	TierRedis  = "redis"
+)
+
+// TTLs are the lifetimes of cached entries by kind, the key prefix before the first colon, e.g. "order"
+// for "order:42". Entries of the kinds missing from Kinds live for Default.
+type TTLs struct {
+	Default time.Duration
+	Kinds   map[string]time.Duration
+}
+
+func (t TTLs) of(key string) time.Duration {
+	kind, _, _ := strings.Cut(key, ":")
+	if ttl, ok := t.Kinds[kind]; ok {
+		return ttl
+	}
+	return t.Default
+}
+
+// LRU is an in-process cache of a bounded number of orders of a bounded total size. Entries live for the TTL
+// of their kind at most and are sometimes reported as missing shortly before, so they are refreshed before they expire.
+// The least recently used entries are evicted when the cache is full. It is safe for concurrent use.
+// Orders are copied in and out, so callers modifying them don't change the cached values.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int // Maximum number of entries.
+	maxBytes int // Maximum total cost of the entries, see entryCost, 0 means no limit.
+	bytes    int
+	ttls     TTLs
+	entries  map[string]*list.Element
+	recency  *list.List // of *lruEntry, the most recently used first
+	now      func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	order     *order.Order
+	cost      int
+	ttl       time.Duration
+	expiresAt time.Time
+}
+
+func NewLRU(capacity, maxBytes int, ttls TTLs) *LRU {
+	return &LRU{
+		capacity: capacity,
+		maxBytes: maxBytes,
+		ttls:     ttls,
+		entries:  make(map[string]*list.Element, capacity),
+		recency:  list.New(),
+		now:      time.Now,
+	}
+}
+
+// entryCost approximates the memory the entry of the order takes.
+func entryCost(key string, o *order.Order) int {
+	cost := len(key) + int(unsafe.Sizeof(lruEntry{})+unsafe.Sizeof(*o))
+	for _, l := range o.Packaging {
+		cost += len(l.Type) + int(unsafe.Sizeof(l))
+	}
+	return cost
+}
+
+func (c *LRU) Get(_ context.Context, key string) (*order.Order, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if ok {
+		e := el.Value.(*lruEntry)
+		remaining := e.expiresAt.Sub(c.now())
+		if remaining <= 0 {
+			c.remove(el)
+			metrics.IncCacheEvictions(TierMemory, "expired")
+			ok = false
+		} else if expiresEarly(remaining, e.ttl) {
+			ok = false // the entry stays for the other lookups until the refreshed order replaces it
+		}
+	}
+	metrics.IncCacheLookups(TierMemory, ok)
+	if !ok {
+		return nil, false
+	}
+
+	c.recency.MoveToFront(el)
+	return cloneOrder(el.Value.(*lruEntry).order), true
+}
+
+// Set caches the order unless a newer version of it is already cached.
+func (c *LRU) Set(ctx context.Context, key string, value *order.Order) error {
+	_, err := c.SetNewest(ctx, key, value)
+	return err
+}
+
+// SetNewest works like Set and returns the cached order, which is newer than value if value was skipped.
+func (c *LRU) SetNewest(_ context.Context, key string, value *order.Order) (*order.Order, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ttl := c.ttls.of(key)
+	expiresAt := c.now().Add(ttl)
+	cost := entryCost(key, value)
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*lruEntry)
+		if e.order.Version > value.Version {
+			c.recency.MoveToFront(el)
+			return cloneOrder(e.order), nil
+		}
+		c.remove(el)
+	}
+	if c.maxBytes > 0 && cost > c.maxBytes {
+		return value, nil // it would evict everything else
+	}
+
+	for c.recency.Len() > 0 && (c.recency.Len() >= c.capacity || c.maxBytes > 0 && c.bytes+cost > c.maxBytes) {
+		c.remove(c.recency.Back())
+		metrics.IncCacheEvictions(TierMemory, "capacity")
+	}
+	c.entries[key] = c.recency.PushFront(&lruEntry{key: key, order: cloneOrder(value), cost: cost, ttl: ttl, expiresAt: expiresAt})
+	c.bytes += cost
+	return value, nil
+}
+
+// Invalidate drops the keys from this cache only.
+func (c *LRU) Invalidate(_ context.Context, keys ...string) error {
+	c.Evict(keys...)
+	return nil
+}
+
+// Evict drops the keys. It suits as the handler of Redis.Listen.
+func (c *LRU) Evict(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := c.entries[key]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.recency.Len()
+}
+
+func (c *LRU) remove(el *list.Element) {
+	e := el.Value.(*lruEntry)
+	c.recency.Remove(el)
+	delete(c.entries, e.key)
+	c.bytes -= e.cost
+}
+
+func cloneOrder(o *order.Order) *order.Order {
+	clone := *o
+	clone.Packaging = slices.Clone(o.Packaging)
+	if o.ExpiresAt != nil {
+		expiresAt := *o.ExpiresAt
+		clone.ExpiresAt = &expiresAt
+	}
+	return &clone
+}
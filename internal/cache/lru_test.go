@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2, 0, TTLs{Default: time.Minute})
+
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1}))
+	assert.NoError(t, c.Set(ctx, "order:2", &order.Order{ID: 2}))
+	_, ok := c.Get(ctx, "order:1")
+	assert.True(t, ok)
+
+	assert.NoError(t, c.Set(ctx, "order:3", &order.Order{ID: 3}))
+	assert.Equal(t, 2, c.Len())
+
+	_, ok = c.Get(ctx, "order:2")
+	assert.False(t, ok, "order 2 is the least recently used")
+	_, ok = c.Get(ctx, "order:1")
+	assert.True(t, ok)
+	_, ok = c.Get(ctx, "order:3")
+	assert.True(t, ok)
+}
+
+func TestLRU_Expires(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+	c := NewLRU(10, 0, TTLs{Default: time.Minute})
+	c.now = func() time.Time { return now }
+
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1}))
+	now = now.Add(30 * time.Second)
+	_, ok := c.Get(ctx, "order:1")
+	assert.True(t, ok)
+
+	now = now.Add(30 * time.Second)
+	_, ok = c.Get(ctx, "order:1")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLRU_KindTTLs(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+	c := NewLRU(10, 0, TTLs{Default: time.Minute, Kinds: map[string]time.Duration{"query": 10 * time.Second}})
+	c.now = func() time.Time { return now }
+
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1}))
+	assert.NoError(t, c.Set(ctx, "query:stored", &order.Order{ID: 2}))
+	now = now.Add(10 * time.Second)
+
+	_, ok := c.Get(ctx, "query:stored")
+	assert.False(t, ok)
+	_, ok = c.Get(ctx, "order:1")
+	assert.True(t, ok)
+}
+
+func TestLRU_EvictsOverMaxBytes(t *testing.T) {
+	ctx := context.Background()
+	small := &order.Order{ID: 1}
+	c := NewLRU(10, 2*entryCost("order:1", small), TTLs{Default: time.Minute})
+
+	assert.NoError(t, c.Set(ctx, "order:1", small))
+	assert.NoError(t, c.Set(ctx, "order:2", &order.Order{ID: 2}))
+	assert.Equal(t, 2, c.Len())
+
+	packed := &order.Order{ID: 3, Packaging: []order.PackagingLayer{{Type: "box", Cost: 20}}}
+	assert.NoError(t, c.Set(ctx, "order:3", packed))
+	assert.Equal(t, 1, c.Len(), "the packed order takes the room of both")
+	_, ok := c.Get(ctx, "order:3")
+	assert.True(t, ok)
+
+	huge := &order.Order{ID: 4, Packaging: make([]order.PackagingLayer, 100)}
+	assert.NoError(t, c.Set(ctx, "order:4", huge))
+	_, ok = c.Get(ctx, "order:4")
+	assert.False(t, ok, "an order over the limit isn't cached")
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestLRU_KeepsNewerVersionsAndCopies(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(10, 0, TTLs{Default: time.Minute})
+
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1, Status: order.ReachedClient, Version: 2}))
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1, Status: order.Stored, Version: 1}))
+
+	o, ok := c.Get(ctx, "order:1")
+	assert.True(t, ok)
+	assert.Equal(t, order.ReachedClient, o.Status)
+
+	o.SetStatus(order.Returned)
+	o, _ = c.Get(ctx, "order:1")
+	assert.Equal(t, order.ReachedClient, o.Status, "changes of the returned order don't reach the cache")
+
+	c.Evict("order:1")
+	_, ok = c.Get(ctx, "order:1")
+	assert.False(t, ok)
+}
+
+func TestTiered(t *testing.T) {
+	ctx := context.Background()
+	l1, l2 := NewLRU(10, 0, TTLs{Default: time.Minute}), NewLRU(10, 0, TTLs{Default: time.Minute})
+	c := NewTiered(l1, l2)
+
+	assert.NoError(t, l2.Set(ctx, "order:1", &order.Order{ID: 1}))
+	_, ok := c.Get(ctx, "order:1")
+	assert.True(t, ok)
+	_, ok = l1.Get(ctx, "order:1")
+	assert.True(t, ok, "values found in the second level are copied to the first one")
+
+	assert.NoError(t, c.Set(ctx, "order:2", &order.Order{ID: 2}))
+	assert.Equal(t, 2, l1.Len())
+	assert.Equal(t, 2, l2.Len())
+
+	assert.NoError(t, c.Invalidate(ctx, "order:1", "order:2"))
+	assert.Equal(t, 0, l1.Len())
+	assert.Equal(t, 0, l2.Len())
+}
+
+func TestTiered_SetKeepsNewerVersion(t *testing.T) {
+	ctx := context.Background()
+	l1, l2 := NewLRU(10, 0, TTLs{Default: time.Minute}), NewLRU(10, 0, TTLs{Default: time.Minute})
+	c := NewTiered(l1, l2)
+
+	// a loader reads version 1, meanwhile another replica writes version 2,
+	// evicts the key from the first levels and caches version 2
+	loaded := &order.Order{ID: 1, Status: order.Stored, Version: 1}
+	assert.NoError(t, c.Set(ctx, "order:1", &order.Order{ID: 1, Status: order.ReachedClient, Version: 2}))
+	l1.Evict("order:1")
+
+	assert.NoError(t, c.Set(ctx, "order:1", loaded))
+
+	o, ok := l1.Get(ctx, "order:1")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), o.Version)
+	assert.Equal(t, order.ReachedClient, o.Status)
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/vlad1028/order-manager/internal/metrics"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+// TierQueries is the tier name of the metrics of cached lists of orders.
+const TierQueries = "queries"
+
+const (
+	queryKeyPrefix = "orders-query:"
+	tagKeyPrefix   = "orders-tag:"
+	// tagTTL is how long the generation of a tag lives after its last invalidation. It must be longer
+	// than the TTL of the lists, or a generation could start over while lists cached under it are still alive.
+	tagTTL = 24 * time.Hour
+)
+
+// LoadQuery returns the cached list of the query or the one load returns, caching it.
+// Every tag has a generation which its invalidation increments, and lists are cached under the generations
+// of their tags read before load. So an invalidation makes the lists with the tag unreachable,
+// even the ones loaded before it and cached after it. They expire after the TTL.
+func (r *Redis) LoadQuery(ctx context.Context, query string, tags []string, load func(context.Context) ([]*order.Order, error)) ([]*order.Order, error) {
+	key, err := r.queryKey(ctx, query, tags)
+	if err != nil {
+		log.Printf("failed to read tags of query %s: %v", query, err)
+		return load(ctx)
+	}
+
+	if orders, ok := r.getQuery(ctx, key); ok {
+		metrics.IncCacheLookups(TierQueries, true)
+		return orders, nil
+	}
+	metrics.IncCacheLookups(TierQueries, false)
+
+	orders, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := encode(r.codec, orders)
+	if err == nil {
+		err = r.client.Set(ctx, key, b, r.ttl).Err()
+	}
+	if err != nil {
+		log.Printf("failed to cache query %s: %v", query, err)
+	}
+	return orders, nil
+}
+
+// InvalidateTags increments the generations of the tags.
+func (r *Redis) InvalidateTags(ctx context.Context, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, tag := range tags {
+			pipe.Incr(ctx, tagKeyPrefix+tag)
+			pipe.Expire(ctx, tagKeyPrefix+tag, tagTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invalidate tags in redis: %w", err)
+	}
+	return nil
+}
+
+// queryKey returns the key of the list of the query with the current generations of the tags.
+func (r *Redis) queryKey(ctx context.Context, query string, tags []string) (string, error) {
+	var b strings.Builder
+	b.WriteString(queryKeyPrefix + query)
+	if len(tags) == 0 {
+		return b.String(), nil
+	}
+
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, tagKeyPrefix+tag)
+	}
+	generations, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return "", err
+	}
+
+	for i, tag := range tags {
+		generation, _ := generations[i].(string) // nil for tags never invalidated
+		fmt.Fprintf(&b, "|%s@%s", tag, generation)
+	}
+	return b.String(), nil
+}
+
+func (r *Redis) getQuery(ctx context.Context, key string) ([]*order.Order, bool) {
+	val, err := r.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			log.Printf("failed to fetch key %s: %v", key, err)
+		}
+		return nil, false
+	}
+
+	orders, err := decode(r.codec, val)
+	if err != nil {
+		if !errors.Is(err, ErrUnknownVersion) {
+			log.Printf("failed to decode key %s: %v", key, err)
+		}
+		return nil, false
+	}
+	return orders, true
+}
@@ -2,17 +2,19 @@ package cache
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/redis/go-redis/v9"
+	"github.com/vlad1028/order-manager/internal/metrics"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	"log"
 	"os"
+	"strings"
 	"time"
 )
 
-func MustNew(ctx context.Context, ttl time.Duration) *Redis {
+// MustNew connects to Redis. The values are encoded with codec.
+func MustNew(ctx context.Context, ttl time.Duration, codec Codec) *Redis {
 	url := os.Getenv("REDIS_URL")
 	pwd := os.Getenv("REDIS_PWD")
 
@@ -30,19 +32,47 @@ func MustNew(ctx context.Context, ttl time.Duration) *Redis {
 	return &Redis{
 		ttl:    ttl,
 		client: client,
+		codec:  codec,
 	}
 }
 
-// Redis Использую TTL как механизм инвалидации кэша.
-// При этом данные могут быть устаревшими, если другие серверы обновили данные в бд.
-// Но при небольших ttl это не критично.
+// setAttempts is the number of times Set tries to write a key other clients keep changing.
+const setAttempts = 3
+
+// InvalidationChannel is the Redis channel the keys of invalidated orders are published to,
+// separated by spaces. Replicas keeping their own copies of orders drop them on these messages.
+const InvalidationChannel = "order-cache:invalidate"
+
+// Redis is the order cache shared by all replicas. Entries live for the TTL at most.
+// Writers invalidate the entries of the orders they change, and an entry is never replaced
+// with an older version of the order, so a stale read racing with a write can't undo it.
 type Redis struct {
 	ttl    time.Duration
 	client *redis.Client
+	codec  Codec
 }
 
+// Get returns the cached order. Orders close to their expiry are sometimes reported as missing,
+// so they are refreshed before they expire.
 func (r *Redis) Get(ctx context.Context, key string) (*order.Order, bool) {
-	val, err := r.client.Get(ctx, key).Result()
+	var get *redis.StringCmd
+	var ttl *redis.DurationCmd
+	_, _ = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key)
+		ttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+
+	o, ok := r.decode(key, get)
+	if ok && ttl.Err() == nil && expiresEarly(ttl.Val(), r.ttl) {
+		ok = false
+	}
+	metrics.IncCacheLookups(TierRedis, ok)
+	return o, ok
+}
+
+func (r *Redis) decode(key string, get *redis.StringCmd) (*order.Order, bool) {
+	val, err := get.Result()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
 			return nil, false
@@ -52,25 +82,94 @@ func (r *Redis) Get(ctx context.Context, key string) (*order.Order, bool) {
 		return nil, false
 	}
 
-	var result *order.Order
-	err = json.Unmarshal([]byte(val), &result)
+	orders, err := decode(r.codec, []byte(val))
+	if err == nil && len(orders) != 1 {
+		err = fmt.Errorf("%d orders instead of one", len(orders))
+	}
 	if err != nil {
-		log.Printf("failed to unmarshal key %s: %v", key, err)
+		// values of other versions are expected during deploys, they are replaced with the loaded orders
+		if !errors.Is(err, ErrUnknownVersion) {
+			log.Printf("failed to decode key %s: %v", key, err)
+		}
 		return nil, false
 	}
 
-	return result, true
+	return orders[0], true
+}
+
+// Set caches the order unless a newer version of it is already cached.
+func (r *Redis) Set(ctx context.Context, key string, value *order.Order) error {
+	_, err := r.SetNewest(ctx, key, value)
+	return err
 }
 
-func (r *Redis) Set(ctx context.Context, key string, order *order.Order) error {
-	b, err := json.Marshal(order)
+// SetNewest works like Set and returns the cached order, which is newer than value if value was skipped.
+func (r *Redis) SetNewest(ctx context.Context, key string, value *order.Order) (*order.Order, error) {
+	b, err := encode(r.codec, []*order.Order{value})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode order: %v", err)
+	}
+
+	// the key is watched, so the write fails and is retried if the key changes after the cached version is checked
+	newest := value
+	for attempt := 0; attempt < setAttempts; attempt++ {
+		newest = value
+		err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+			if cached, ok := r.decode(key, tx.Get(ctx, key)); ok && cached.Version > value.Version {
+				newest = cached
+				return nil
+			}
+
+			_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Set(ctx, key, b, r.ttl)
+				return nil
+			})
+			return err
+		}, key)
+		if !errors.Is(err, redis.TxFailedErr) {
+			break
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("failed to marshal order: %v", err)
+		return nil, fmt.Errorf("failed write value to redis %w", err)
+	}
+	return newest, nil
+}
+
+// Invalidate deletes the keys and publishes them to InvalidationChannel.
+func (r *Redis) Invalidate(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
 	}
 
-	err = r.client.Set(ctx, key, b, r.ttl).Err()
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.Publish(ctx, InvalidationChannel, strings.Join(keys, " "))
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed write value to redis %w", err)
+		return fmt.Errorf("failed to invalidate keys in redis: %w", err)
 	}
 	return nil
 }
+
+// Listen calls evict with the keys published to InvalidationChannel until ctx is done,
+// including the ones this replica publishes. Messages published while the subscription reconnects are lost,
+// so the copies evict drops must expire soon anyway.
+func (r *Redis) Listen(ctx context.Context, evict func(keys ...string)) {
+	sub := r.client.Subscribe(ctx, InvalidationChannel)
+	defer sub.Close()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			evict(strings.Fields(msg.Payload)...)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+// Tier is a single level of a Tiered cache.
+type Tier interface {
+	Get(ctx context.Context, key string) (*order.Order, bool)
+	// Set caches the order unless a newer version of it is already cached.
+	Set(ctx context.Context, key string, value *order.Order) error
+	Invalidate(ctx context.Context, keys ...string) error
+}
+
+// VersionedTier is a Tier which tells the version of the order it keeps after a write.
+type VersionedTier interface {
+	Tier
+	// SetNewest works like Set and returns the cached order, which is newer than value if value was skipped.
+	SetNewest(ctx context.Context, key string, value *order.Order) (*order.Order, error)
+}
+
+// Tiered is a two-level cache, usually a small LRU of the replica in front of the Redis shared by all replicas.
+// Values found in the second level are copied to the first one. The first level only learns about
+// the invalidations of other replicas from the second one, e.g. with Redis.Listen, so its TTL should be short.
+type Tiered struct {
+	l1 Tier
+	l2 VersionedTier
+}
+
+func NewTiered(l1 Tier, l2 VersionedTier) *Tiered {
+	return &Tiered{l1: l1, l2: l2}
+}
+
+func (t *Tiered) Get(ctx context.Context, key string) (*order.Order, bool) {
+	if o, ok := t.l1.Get(ctx, key); ok {
+		return o, true
+	}
+
+	o, ok := t.l2.Get(ctx, key)
+	if !ok {
+		return nil, false
+	}
+	_ = t.l1.Set(ctx, key, o)
+	return o, true
+}
+
+// Set caches the order in both levels. The second level is written first, so the first one
+// never has a value the other replicas can't see. If the second level already has a newer version,
+// e.g. written by another replica after value was loaded, the first one gets that version instead.
+func (t *Tiered) Set(ctx context.Context, key string, value *order.Order) error {
+	cached, err := t.l2.SetNewest(ctx, key, value)
+	if err != nil {
+		return err
+	}
+	return t.l1.Set(ctx, key, cached)
+}
+
+// Invalidate drops the keys from both levels, even if one of them fails.
+func (t *Tiered) Invalidate(ctx context.Context, keys ...string) error {
+	return errors.Join(t.l1.Invalidate(ctx, keys...), t.l2.Invalidate(ctx, keys...))
+}
@@ -24,10 +24,18 @@ type OrderManagerCLI struct {
 type OrderCLIAdaptor interface {
 	AcceptOrder(req *AcceptOrderRequest) error
 	CancelOrder(req *CancelOrderRequest) error
-	IssueOrder(req *IssueOrderRequest) ([]*order.Order, error)
-	GetOrders(req *GetOrdersRequest) ([]*order.Order, error)
+	IssueOrder(req *IssueOrderRequest) ([]order.IssueResult, error)
+	// GetOrders returns a page of the client's orders and the token of the next page, empty on the last one.
+	GetOrders(req *GetOrdersRequest) ([]*order.Order, string, error)
 	AcceptReturn(req *AcceptReturnRequest) error
-	GetReturned(req *GetReturnedRequest) ([]*order.Order, error)
+	// GetReturned returns a page of returned orders and the token of the next page, empty on the last one.
+	GetReturned(req *GetReturnedRequest) ([]*order.Order, string, error)
+	// SearchOrders returns a page of the orders matching the request and the token of the next page.
+	SearchOrders(req *SearchOrdersRequest) ([]*order.Order, string, error)
+	GetOrderHistory(req *GetOrderHistoryRequest) ([]*order.StatusChange, error)
+	SuggestPackaging(req *SuggestPackagingRequest) ([]*order.PackagingType, error)
+	GetCourierManifest(req *GetCourierManifestRequest) ([]*order.Order, error)
+	CourierHandover(req *CourierHandoverRequest) (*order.Handover, error)
 }
 
 func NewOrderManagerCLI(a OrderCLIAdaptor, r io.Reader, w io.Writer) *OrderManagerCLI {
@@ -56,6 +64,11 @@ func (r *OrderManagerCLI) addCommands() {
 		r.newGetOrdersCmd(),
 		r.newAcceptReturnCmd(),
 		r.newGetReturnedCmd(),
+		r.newSearchCmd(),
+		r.newOrderHistoryCmd(),
+		r.newSuggestPackagingCmd(),
+		r.newCourierManifestCmd(),
+		r.newCourierHandoverCmd(),
 		r.newSetWorkersCmd(),
 	)
 }
@@ -159,19 +172,25 @@ func (r *OrderManagerCLI) newSetWorkersCmd() *cobra.Command {
 func (r *OrderManagerCLI) newAcceptOrderCmd() *cobra.Command {
 	var pack string
 	var addFilm bool
+	var storageUntil string
+	var pickupPoint string
+	var size string
 
 	cmd := &cobra.Command{
 		Use:   "accept-order [orderID] [clientID] [weight] [cost]",
-		Short: "Accept an order delivery from adaptor courier",
+		Short: "Accept an order delivery from adaptor courier, the weight is in grams",
 		Args:  cobra.ExactArgs(4),
 		Run: func(cmd *cobra.Command, args []string) {
 			req := &AcceptOrderRequest{
-				ID:        args[0],
-				ClientID:  args[1],
-				Weight:    args[2],
-				Cost:      args[3],
-				Packaging: pack,
-				AddFilm:   addFilm,
+				ID:             args[0],
+				ClientID:       args[1],
+				Weight:         args[2],
+				Size:           size,
+				Cost:           args[3],
+				Packaging:      pack,
+				AddFilm:        addFilm,
+				ExpirationDate: storageUntil,
+				PickupPointID:  pickupPoint,
 			}
 
 			go func() {
@@ -184,8 +203,11 @@ func (r *OrderManagerCLI) newAcceptOrderCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&pack, "package", "p", "", "Order package")
+	cmd.Flags().StringVarP(&pack, "package", "p", "", "Comma-separated packaging types from the outermost layer, e.g. box,bag,film")
 	cmd.Flags().BoolVarP(&addFilm, "firm", "f", false, "Add additional firm")
+	cmd.Flags().StringVar(&storageUntil, "storage-until", "", "Store the order until the given time (RFC3339)")
+	addSizeFlag(cmd, &size)
+	addPickupPointFlag(cmd, &pickupPoint)
 
 	return cmd
 }
@@ -212,29 +234,50 @@ func (r *OrderManagerCLI) newCancelOrderCmd() *cobra.Command {
 }
 
 func (r *OrderManagerCLI) newIssueOrderCmd() *cobra.Command {
-	return &cobra.Command{
+	var pickupPoint string
+	var clientID string
+	var code string
+	var bestEffort bool
+
+	cmd := &cobra.Command{
 		Use:   "issue-order [orderIDs...]",
 		Short: "Issue orders to adaptor client",
 		Args:  cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			req := &IssueOrderRequest{
-				IDs: args,
+				IDs:           args,
+				ClientID:      clientID,
+				Code:          code,
+				BestEffort:    bestEffort,
+				PickupPointID: pickupPoint,
 			}
 
 			r.workerPool.AddTask(func() {
-				orders, err := r.adaptor.IssueOrder(req)
+				results, err := r.adaptor.IssueOrder(req)
+				for _, res := range results {
+					r.printfln("Order ID: %d, Result: %s", res.OrderID, res.Reason)
+				}
 				if err != nil {
 					r.writeErr(err)
 				}
-				r.printfln("Orders issued: %v", orders)
 			})
 		},
 	}
+
+	cmd.Flags().StringVar(&clientID, "client", "", "Client receiving the orders")
+	cmd.Flags().StringVar(&code, "code", "", "Pickup code sent to the client")
+	_ = cmd.MarkFlagRequired("client")
+	_ = cmd.MarkFlagRequired("code")
+	cmd.Flags().BoolVar(&bestEffort, "best-effort", false, "Issue the orders that can be issued instead of rejecting the whole batch")
+	addPickupPointFlag(cmd, &pickupPoint)
+
+	return cmd
 }
 
 func (r *OrderManagerCLI) newGetOrdersCmd() *cobra.Command {
 	var limit int
 	var localOnly bool
+	var pickupPoint string
 
 	cmd := &cobra.Command{
 		Use:   "get-orders [clientID]",
@@ -242,47 +285,76 @@ func (r *OrderManagerCLI) newGetOrdersCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			req := &GetOrdersRequest{
-				ClientID:  args[0],
-				LocalOnly: localOnly,
+				ClientID:      args[0],
+				LocalOnly:     localOnly,
+				PickupPointID: pickupPoint,
+				PageSize:      limit,
 			}
 
 			r.workerPool.AddTask(func() {
-				if orders, err := r.adaptor.GetOrders(req); err != nil {
-					r.writeErr(err)
-					return
-				} else {
-					r.paginateOrders(orders, limit)
-				}
+				r.paginate(func(token string) ([]*order.Order, string, error) {
+					req.PageToken = token
+					return r.adaptor.GetOrders(req)
+				}, r.printOrders)
 			})
 		},
 	}
 
-	cmd.Flags().IntVarP(&limit, "limit", "n", -1, "Limit the number of orders returned")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Number of orders per page, all orders at once if 0")
 	cmd.Flags().BoolVarP(&localOnly, "local", "l", false, "Show only orders that are in this Pick Up Point")
+	addPickupPointFlag(cmd, &pickupPoint)
 
 	return cmd
 }
 
-func (r *OrderManagerCLI) paginateOrders(orders []*order.Order, limit int) {
-	if limit == -1 {
-		limit = len(orders)
-	}
+func addSizeFlag(cmd *cobra.Command, size *string) {
+	cmd.Flags().StringVar(size, "size", "", "Parcel size in centimetres as LxWxH, e.g. 30x20x10")
+}
+
+func addPickupPointFlag(cmd *cobra.Command, pickupPoint *string) {
+	cmd.Flags().StringVar(pickupPoint, "pickup-point", "", "Pick Up Point serving the request, the server default if empty")
+}
 
-	for i := 0; i < len(orders); i += limit {
-		end := min(i+limit, len(orders))
+// paginate prints the pages fetch returns one by one, fetching the next page only if the user asks for more.
+func (r *OrderManagerCLI) paginate(fetch func(token string) ([]*order.Order, string, error), print func([]*order.Order)) {
+	token := ""
+	for {
+		orders, next, err := fetch(token)
+		if err != nil {
+			r.writeErr(err)
+			return
+		}
 
-		r.printOrders(orders[i:end])
+		print(orders)
 
-		if end == len(orders) || !r.promptForMore() {
-			break
+		if next == "" || !r.promptForMore() {
+			return
 		}
+		token = next
 	}
 }
 
 func (r *OrderManagerCLI) printOrders(orders []*order.Order) {
 	for _, o := range orders {
-		r.printfln("Order ID: %d, Delivered At: %s", o.ID, o.StatusUpdated)
+		if o.ExpiresAt != nil {
+			r.printfln("Order ID: %d, Delivered At: %s, Stored Until: %s, %s", o.ID, o.StatusUpdated, *o.ExpiresAt, formatCost(o))
+		} else {
+			r.printfln("Order ID: %d, Delivered At: %s, %s", o.ID, o.StatusUpdated, formatCost(o))
+		}
+	}
+}
+
+// formatCost returns the order cost itemised into the base cost and the packaging fees.
+func formatCost(o *order.Order) string {
+	if len(o.Packaging) == 0 {
+		return fmt.Sprintf("Cost: %d", o.Cost)
+	}
+
+	fees := make([]string, len(o.Packaging))
+	for i, l := range o.Packaging {
+		fees[i] = fmt.Sprintf("%s %d", l.Type, l.Cost)
 	}
+	return fmt.Sprintf("Cost: %d (base %d, packaging: %s)", o.Cost, o.BaseCost, strings.Join(fees, ", "))
 }
 
 func (r *OrderManagerCLI) promptForMore() bool {
@@ -291,14 +363,17 @@ func (r *OrderManagerCLI) promptForMore() bool {
 }
 
 func (r *OrderManagerCLI) newAcceptReturnCmd() *cobra.Command {
-	return &cobra.Command{
+	var pickupPoint string
+
+	cmd := &cobra.Command{
 		Use:   "accept-return [clientID] [orderID]",
 		Short: "Accept an order return from adaptor client",
 		Args:  cobra.ExactArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
 			req := &AcceptReturnRequest{
-				ClientID: args[0],
-				OrderID:  args[1],
+				ClientID:      args[0],
+				OrderID:       args[1],
+				PickupPointID: pickupPoint,
 			}
 
 			r.workerPool.AddTask(func() {
@@ -310,10 +385,13 @@ func (r *OrderManagerCLI) newAcceptReturnCmd() *cobra.Command {
 			})
 		},
 	}
+
+	addPickupPointFlag(cmd, &pickupPoint)
+
+	return cmd
 }
 
 func (r *OrderManagerCLI) newGetReturnedCmd() *cobra.Command {
-	var page int
 	var perPage int
 
 	cmd := &cobra.Command{
@@ -321,26 +399,194 @@ func (r *OrderManagerCLI) newGetReturnedCmd() *cobra.Command {
 		Short: "Get returned orders with pagination",
 		Run: func(cmd *cobra.Command, args []string) {
 			req := &GetReturnedRequest{
-				Page:    page,
 				PerPage: perPage,
 			}
 
 			r.workerPool.AddTask(func() {
-				returns, err := r.adaptor.GetReturned(req)
+				r.paginate(func(token string) ([]*order.Order, string, error) {
+					req.PageToken = token
+					return r.adaptor.GetReturned(req)
+				}, r.printReturns)
+			})
+		},
+	}
+
+	cmd.Flags().IntVarP(&perPage, "per-page", "n", 10, "Number of returns per page")
+
+	return cmd
+}
+
+func (r *OrderManagerCLI) printReturns(returns []*order.Order) {
+	for _, ret := range returns {
+		r.printfln("Return ID: %d, Return Date: %s", ret.ID, ret.StatusUpdated)
+	}
+}
+
+func (r *OrderManagerCLI) newSearchCmd() *cobra.Command {
+	req := &SearchOrdersRequest{}
+
+	cmd := &cobra.Command{
+		Use:     "search",
+		Short:   "Search orders by client, pickup point, status, status update time, weight and cost",
+		Example: "search --status stored --older-than 120h --min-weight 20000 --pickup-point 1 --sort -cost",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			search := *req
+
+			r.workerPool.AddTask(func() {
+				r.paginate(func(token string) ([]*order.Order, string, error) {
+					search.PageToken = token
+					return r.adaptor.SearchOrders(&search)
+				}, r.printFoundOrders)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&req.ClientID, "client", "", "Client the orders belong to")
+	cmd.Flags().StringVar(&req.PickupPointID, "pickup-point", "", "Pick Up Point the orders are stored at")
+	cmd.Flags().StringSliceVar(&req.Statuses, "status", nil, "Statuses the orders have any of, e.g. stored,expired")
+	cmd.Flags().StringVar(&req.UpdatedAfter, "updated-after", "", "Earliest status update time (RFC3339)")
+	cmd.Flags().StringVar(&req.UpdatedBefore, "updated-before", "", "Latest status update time, exclusive (RFC3339)")
+	cmd.Flags().StringVar(&req.OlderThan, "older-than", "", "Minimum time since the last status update, e.g. 120h")
+	cmd.Flags().StringVar(&req.MinWeight, "min-weight", "", "Minimum weight in grams")
+	cmd.Flags().StringVar(&req.MaxWeight, "max-weight", "", "Maximum weight in grams")
+	cmd.Flags().StringVar(&req.MinCost, "min-cost", "", "Minimum cost")
+	cmd.Flags().StringVar(&req.MaxCost, "max-cost", "", "Maximum cost")
+	cmd.Flags().StringVar(&req.Sort, "sort", "", "Comma-separated fields to sort by: status_updated, weight, cost, id; prefix with - to sort descending")
+	cmd.Flags().BoolVar(&req.Archived, "archived", false, "Look for archived orders too")
+	cmd.Flags().IntVarP(&req.PageSize, "limit", "n", 0, "Number of orders per page, all orders at once if 0")
+
+	return cmd
+}
+
+func (r *OrderManagerCLI) printFoundOrders(orders []*order.Order) {
+	for _, o := range orders {
+		r.printfln("Order ID: %d, Client ID: %d, Pick Up Point ID: %d, Status: %s, Updated At: %s, Weight: %d, %s",
+			o.ID, o.ClientID, o.PickupPointID, o.Status, o.StatusUpdated, o.Weight, formatCost(o))
+	}
+}
+
+func (r *OrderManagerCLI) newOrderHistoryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "order-history [orderID]",
+		Short: "Show the status history of an order",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &GetOrderHistoryRequest{
+				OrderID: args[0],
+			}
+
+			r.workerPool.AddTask(func() {
+				history, err := r.adaptor.GetOrderHistory(req)
 				if err != nil {
 					r.writeErr(err)
 					return
 				}
 
-				for _, ret := range returns {
-					r.printfln("Return ID: %d, Return Date: %s", ret.ID, ret.StatusUpdated)
+				for _, c := range history {
+					r.printfln("Status: %s, Pickup Point ID: %d, Changed At: %s", c.Status, c.PickupPointID, c.ChangedAt)
 				}
 			})
 		},
 	}
+}
 
-	cmd.Flags().IntVarP(&page, "page", "p", 0, "Page number")
-	cmd.Flags().IntVarP(&perPage, "per-page", "n", 10, "Number of returns per page")
+func (r *OrderManagerCLI) newSuggestPackagingCmd() *cobra.Command {
+	var size string
+	var required []string
+
+	cmd := &cobra.Command{
+		Use:   "suggest-packaging [weight]",
+		Short: "Suggest the cheapest packaging for a parcel of the given weight in grams",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &SuggestPackagingRequest{
+				Weight:   args[0],
+				Size:     size,
+				Required: required,
+			}
+
+			r.workerPool.AddTask(func() {
+				layers, err := r.adaptor.SuggestPackaging(req)
+				if err != nil {
+					r.writeErr(err)
+					return
+				}
+
+				names := make([]string, len(layers))
+				var cost uint
+				for i, l := range layers {
+					names[i] = l.Name
+					cost += l.Cost
+				}
+				r.printfln("Packaging: %s, Cost: %d", strings.Join(names, ","), cost)
+			})
+		},
+	}
+
+	addSizeFlag(cmd, &size)
+	cmd.Flags().StringSliceVar(&required, "require", nil, "Packaging types the suggestion must contain, e.g. film")
+
+	return cmd
+}
+
+func (r *OrderManagerCLI) newCourierManifestCmd() *cobra.Command {
+	var pickupPoint string
+
+	cmd := &cobra.Command{
+		Use:   "courier-manifest",
+		Short: "List returned and expired orders to hand over to the courier",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &GetCourierManifestRequest{
+				PickupPointID: pickupPoint,
+			}
+
+			r.workerPool.AddTask(func() {
+				orders, err := r.adaptor.GetCourierManifest(req)
+				if err != nil {
+					r.writeErr(err)
+					return
+				}
+
+				for _, o := range orders {
+					r.printfln("Order ID: %d, Client ID: %d, Status: %s, Since: %s", o.ID, o.ClientID, o.Status, o.StatusUpdated)
+				}
+			})
+		},
+	}
+
+	addPickupPointFlag(cmd, &pickupPoint)
+
+	return cmd
+}
+
+func (r *OrderManagerCLI) newCourierHandoverCmd() *cobra.Command {
+	var pickupPoint string
+
+	cmd := &cobra.Command{
+		Use:   "courier-handover [courierID] [orderIDs...]",
+		Short: "Hand orders over to the courier, the whole manifest if no orders are given",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &CourierHandoverRequest{
+				CourierID:     args[0],
+				OrderIDs:      args[1:],
+				PickupPointID: pickupPoint,
+			}
+
+			r.workerPool.AddTask(func() {
+				h, err := r.adaptor.CourierHandover(req)
+				if err != nil {
+					r.writeErr(err)
+					return
+				}
+
+				r.printfln("Handover ID: %d, Courier ID: %d, Orders: %d, Signed off: %s", h.ID, h.CourierID, len(h.OrderIDs), h.SignedOffAt)
+			})
+		},
+	}
+
+	addPickupPointFlag(cmd, &pickupPoint)
 
 	return cmd
 }
@@ -6,9 +6,11 @@ type (
 		ClientID       string
 		ExpirationDate string
 		Weight         string
+		Size           string
 		Cost           string
 		Packaging      string
 		AddFilm        bool
+		PickupPointID  string
 	}
 
 	CancelOrderRequest struct {
@@ -16,21 +18,66 @@ type (
 	}
 
 	IssueOrderRequest struct {
-		IDs []string
+		IDs           []string
+		ClientID      string
+		Code          string
+		BestEffort    bool
+		PickupPointID string
 	}
 
 	GetOrdersRequest struct {
-		ClientID  string
-		LocalOnly bool
+		ClientID      string
+		LocalOnly     bool
+		PickupPointID string
+		PageToken     string
+		PageSize      int
 	}
 
 	AcceptReturnRequest struct {
-		ClientID string
-		OrderID  string
+		ClientID      string
+		OrderID       string
+		PickupPointID string
 	}
 
 	GetReturnedRequest struct {
-		Page    int
-		PerPage int
+		PageToken string
+		PerPage   int
+	}
+
+	SearchOrdersRequest struct {
+		ClientID      string
+		PickupPointID string
+		Statuses      []string
+		UpdatedAfter  string // RFC3339
+		UpdatedBefore string // RFC3339
+		OlderThan     string // duration since the last status update, e.g. 120h
+		MinWeight     string
+		MaxWeight     string
+		MinCost       string
+		MaxCost       string
+		Sort          string // comma-separated fields, "-" before a field sorts it descending, e.g. -cost,id
+		Archived      bool
+		PageToken     string
+		PageSize      int
+	}
+
+	GetOrderHistoryRequest struct {
+		OrderID string
+	}
+
+	SuggestPackagingRequest struct {
+		Weight   string
+		Size     string
+		Required []string
+	}
+
+	GetCourierManifestRequest struct {
+		PickupPointID string
+	}
+
+	CourierHandoverRequest struct {
+		CourierID     string
+		OrderIDs      []string
+		PickupPointID string
 	}
 )
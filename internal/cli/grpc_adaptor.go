@@ -8,7 +8,9 @@ import (
 
 	"github.com/vlad1028/order-manager/internal/grpc"
 	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/order/pb"
 	desc "github.com/vlad1028/order-manager/pkg/order-service/v1"
+	"google.golang.org/grpc/status"
 )
 
 var _ OrderCLIAdaptor = (*OrderGrpcAdaptor)(nil)
@@ -38,7 +40,16 @@ func (a *OrderGrpcAdaptor) AcceptOrder(req *AcceptOrderRequest) error {
 	cost, err := a.parseUnsigned(req.Cost)
 	parseErr = errors.Join(parseErr, err)
 
-	pack, err := a.parsePackaging(req.Packaging)
+	expiresAt, err := parseTime(req.ExpirationDate)
+	parseErr = errors.Join(parseErr, err)
+
+	ppID, err := parsePickupPoint(req.PickupPointID)
+	parseErr = errors.Join(parseErr, err)
+
+	pack, err := parsePackaging(req.Packaging)
+	parseErr = errors.Join(parseErr, err)
+
+	size, err := parseSize(req.Size)
 	parseErr = errors.Join(parseErr, err)
 
 	if parseErr != nil {
@@ -46,12 +57,17 @@ func (a *OrderGrpcAdaptor) AcceptOrder(req *AcceptOrderRequest) error {
 	}
 
 	r := &desc.AcceptOrderRequest{
-		Id:        orderID,
-		ClientId:  clientID,
-		Weight:    weight,
-		Cost:      cost,
-		Packaging: pack,
-		AddFilm:   req.AddFilm,
+		Id:              orderID,
+		ClientId:        clientID,
+		Weight:          weight,
+		Length:          uint32(size.Length),
+		Width:           uint32(size.Width),
+		Height:          uint32(size.Height),
+		Cost:            cost,
+		PackagingLayers: pack,
+		AddFilm:         req.AddFilm,
+		StorageUntil:    pb.ConvertTimestampToProto(expiresAt),
+		PickupPointId:   ppID,
 	}
 
 	_, err = a.orderService.AcceptOrder(context.Background(), r)
@@ -74,7 +90,7 @@ func (a *OrderGrpcAdaptor) CancelOrder(req *CancelOrderRequest) error {
 	return err
 }
 
-func (a *OrderGrpcAdaptor) IssueOrder(req *IssueOrderRequest) ([]*order.Order, error) {
+func (a *OrderGrpcAdaptor) IssueOrder(req *IssueOrderRequest) ([]order.IssueResult, error) {
 	var orderIDs []uint64
 	for _, idStr := range req.IDs {
 		id, err := a.parseID(idStr)
@@ -83,36 +99,70 @@ func (a *OrderGrpcAdaptor) IssueOrder(req *IssueOrderRequest) ([]*order.Order, e
 		}
 		orderIDs = append(orderIDs, id)
 	}
+	clientID, err := a.parseID(req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	ppID, err := parsePickupPoint(req.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
 
 	r := &desc.IssueOrderRequest{
-		Ids: orderIDs,
+		Ids:           orderIDs,
+		ClientId:      clientID,
+		Code:          req.Code,
+		Mode:          desc.IssueMode_ISSUE_MODE_ALL_OR_NOTHING,
+		PickupPointId: ppID,
+	}
+	if req.BestEffort {
+		r.Mode = desc.IssueMode_ISSUE_MODE_BEST_EFFORT
 	}
 
 	resp, err := a.orderService.IssueOrder(context.Background(), r)
 	if err != nil {
-		return nil, err
+		return rejectedIssueResults(err), err
 	}
 
-	return grpc.ConvertOrdersFromProto(resp.Orders)
+	return grpc.ConvertIssueResultsFromProto(resp.Results)
 }
 
-func (a *OrderGrpcAdaptor) GetOrders(req *GetOrdersRequest) ([]*order.Order, error) {
+// rejectedIssueResults returns the per-order results the server attaches to a rejected batch.
+func rejectedIssueResults(err error) []order.IssueResult {
+	for _, d := range status.Convert(err).Details() {
+		if resp, ok := d.(*desc.IssueOrderResponse); ok {
+			results, _ := grpc.ConvertIssueResultsFromProto(resp.Results)
+			return results
+		}
+	}
+	return nil
+}
+
+func (a *OrderGrpcAdaptor) GetOrders(req *GetOrdersRequest) ([]*order.Order, string, error) {
 	clientID, err := a.parseID(req.ClientID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	ppID, err := parsePickupPoint(req.PickupPointID)
+	if err != nil {
+		return nil, "", err
 	}
 
 	r := &desc.GetOrdersRequest{
-		ClientId:  clientID,
-		LocalOnly: req.LocalOnly,
+		ClientId:      clientID,
+		LocalOnly:     req.LocalOnly,
+		PickupPointId: ppID,
+		PageToken:     req.PageToken,
+		PageSize:      uint32(max(req.PageSize, 0)),
 	}
 
 	resp, err := a.orderService.GetOrders(context.Background(), r)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return grpc.ConvertOrdersFromProto(resp.Orders)
+	orders, err := pb.ConvertOrdersFromProto(resp.Orders)
+	return orders, resp.NextPageToken, err
 }
 
 func (a *OrderGrpcAdaptor) AcceptReturn(req *AcceptReturnRequest) error {
@@ -124,10 +174,15 @@ func (a *OrderGrpcAdaptor) AcceptReturn(req *AcceptReturnRequest) error {
 	if err != nil {
 		return err
 	}
+	ppID, err := parsePickupPoint(req.PickupPointID)
+	if err != nil {
+		return err
+	}
 
 	r := &desc.AcceptReturnRequest{
-		ClientId: clientID,
-		OrderId:  orderID,
+		ClientId:      clientID,
+		OrderId:       orderID,
+		PickupPointId: ppID,
 	}
 
 	_, err = a.orderService.AcceptReturn(context.Background(), r)
@@ -135,14 +190,90 @@ func (a *OrderGrpcAdaptor) AcceptReturn(req *AcceptReturnRequest) error {
 	return err
 }
 
-func (a *OrderGrpcAdaptor) GetReturned(req *GetReturnedRequest) ([]*order.Order, error) {
-	r := &desc.GetReturnedRequest{Page: uint32(req.Page), PerPage: uint32(req.PerPage)}
+func (a *OrderGrpcAdaptor) GetReturned(req *GetReturnedRequest) ([]*order.Order, string, error) {
+	r := &desc.GetReturnedRequest{PageToken: req.PageToken, PerPage: uint32(max(req.PerPage, 0))}
 
 	resp, err := a.orderService.GetReturned(context.Background(), r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders, err := pb.ConvertOrdersFromProto(resp.Orders)
+	return orders, resp.NextPageToken, err
+}
+
+func (a *OrderGrpcAdaptor) SearchOrders(req *SearchOrdersRequest) ([]*order.Order, string, error) {
+	filter, sort, err := parseSearch(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	r := &desc.SearchOrdersRequest{
+		Sort:      grpc.ConvertSortToProto(sort),
+		PageToken: req.PageToken,
+		PageSize:  uint32(max(req.PageSize, 0)),
+	}
+	if err = grpc.ConvertSearchFilterToProto(&filter, r); err != nil {
+		return nil, "", err
+	}
+
+	resp, err := a.orderService.SearchOrders(context.Background(), r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders, err := pb.ConvertOrdersFromProto(resp.Orders)
+	return orders, resp.NextPageToken, err
+}
+
+func (a *OrderGrpcAdaptor) GetOrderHistory(req *GetOrderHistoryRequest) ([]*order.StatusChange, error) {
+	orderID, err := a.parseID(req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &desc.GetOrderHistoryRequest{
+		OrderId: orderID,
+	}
+
+	resp, err := a.orderService.GetOrderHistory(context.Background(), r)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.ConvertStatusChangesFromProto(resp.History)
+}
+
+func (a *OrderGrpcAdaptor) SuggestPackaging(req *SuggestPackagingRequest) ([]*order.PackagingType, error) {
+	var parseErr error = nil
+
+	weight, err := a.parseUnsigned(req.Weight)
+	parseErr = errors.Join(parseErr, err)
+
+	size, err := parseSize(req.Size)
+	parseErr = errors.Join(parseErr, err)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	r := &desc.SuggestPackagingRequest{
+		Weight:   weight,
+		Length:   uint32(size.Length),
+		Width:    uint32(size.Width),
+		Height:   uint32(size.Height),
+		Required: req.Required,
+	}
+
+	resp, err := a.orderService.SuggestPackaging(context.Background(), r)
 	if err != nil {
 		return nil, err
 	}
-	return grpc.ConvertOrdersFromProto(resp.Orders)
+
+	layers := make([]*order.PackagingType, len(resp.Layers))
+	for i, l := range resp.Layers {
+		layers[i] = grpc.ConvertPackagingTypeFromProto(l)
+	}
+	return layers, nil
 }
 
 func (a *OrderGrpcAdaptor) parseUnsigned(str string) (uint32, error) {
@@ -164,20 +295,61 @@ func (a *OrderGrpcAdaptor) parseID(idStr string) (uint64, error) {
 	return uint64(idInt), nil
 }
 
-func (a *OrderGrpcAdaptor) parsePackaging(p string) (*desc.OrderPackaging, error) {
-	pack := desc.OrderPackaging_ORDER_PACKAGING_UNSPECIFIED
+func (a *OrderGrpcAdaptor) GetCourierManifest(req *GetCourierManifestRequest) ([]*order.Order, error) {
+	ppID, err := parsePickupPoint(req.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &desc.GetCourierManifestRequest{PickupPointId: ppID}
+
+	resp, err := a.orderService.GetCourierManifest(context.Background(), r)
+	if err != nil {
+		return nil, err
+	}
+	return pb.ConvertOrdersFromProto(resp.Orders)
+}
 
-	switch p {
-	case "":
+func (a *OrderGrpcAdaptor) CourierHandover(req *CourierHandoverRequest) (*order.Handover, error) {
+	courierID, err := a.parseID(req.CourierID)
+	if err != nil {
+		return nil, err
+	}
+	var orderIDs []uint64
+	for _, idStr := range req.OrderIDs {
+		id, err := a.parseID(idStr)
+		if err != nil {
+			return nil, err
+		}
+		orderIDs = append(orderIDs, id)
+	}
+	ppID, err := parsePickupPoint(req.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &desc.CourierHandoverRequest{
+		CourierId:     courierID,
+		OrderIds:      orderIDs,
+		PickupPointId: ppID,
+	}
+
+	resp, err := a.orderService.CourierHandover(context.Background(), r)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.ConvertHandoverFromProto(resp.Handover), nil
+}
+
+// parsePickupPoint returns nil for an empty string, so that the server picks the pickup point.
+func parsePickupPoint(idStr string) (*uint64, error) {
+	if idStr == "" {
 		return nil, nil
-	case "box":
-		pack = desc.OrderPackaging_ORDER_PACKAGING_BOX
-	case "bag":
-		pack = desc.OrderPackaging_ORDER_PACKAGING_BAG
-	case "film":
-		pack = desc.OrderPackaging_ORDER_PACKAGING_FILM
-	default:
-		return &pack, fmt.Errorf("unknown package")
-	}
-	return &pack, nil
+	}
+	id, err := parseID(idStr)
+	if err != nil {
+		return nil, err
+	}
+	ppID := uint64(id)
+	return &ppID, nil
 }
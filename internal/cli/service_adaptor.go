@@ -8,6 +8,8 @@ import (
 	"github.com/vlad1028/order-manager/internal/models/order"
 	orderServise "github.com/vlad1028/order-manager/internal/order"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var _ OrderCLIAdaptor = (*OrderServiceAdaptor)(nil)
@@ -37,20 +39,33 @@ func (a *OrderServiceAdaptor) AcceptOrder(req *AcceptOrderRequest) error {
 	cost, err := parseUnsigned(req.Cost)
 	parseErr = errors.Join(parseErr, err)
 
+	expiresAt, err := parseTime(req.ExpirationDate)
+	parseErr = errors.Join(parseErr, err)
+
+	ppID, err := parseOptionalID(req.PickupPointID)
+	parseErr = errors.Join(parseErr, err)
+
 	pack, err := parsePackaging(req.Packaging)
 	parseErr = errors.Join(parseErr, err)
 
+	size, err := parseSize(req.Size)
+	parseErr = errors.Join(parseErr, err)
+
 	if parseErr != nil {
 		return parseErr
 	}
 
 	r := &orderServise.AcceptOrderRequest{
-		ID:        orderID,
-		ClientID:  clientID,
-		Weight:    weight,
-		Cost:      cost,
-		Packaging: pack,
-		AddFilm:   req.AddFilm,
+		ID:         orderID,
+		ClientID:   clientID,
+		Weight:     weight,
+		Dimensions: size,
+		Cost:       cost,
+		Packaging:  pack,
+		AddFilm:    req.AddFilm,
+		ExpiresAt:  expiresAt,
+
+		PickupPointID: ppID,
 	}
 
 	_, err = a.orderService.AcceptOrder(context.Background(), r)
@@ -73,7 +88,7 @@ func (a *OrderServiceAdaptor) CancelOrder(req *CancelOrderRequest) error {
 	return err
 }
 
-func (a *OrderServiceAdaptor) IssueOrder(req *IssueOrderRequest) ([]*order.Order, error) {
+func (a *OrderServiceAdaptor) IssueOrder(req *IssueOrderRequest) ([]order.IssueResult, error) {
 	var orderIDs []basetypes.ID
 	for _, idStr := range req.IDs {
 		id, err := parseID(idStr)
@@ -82,30 +97,52 @@ func (a *OrderServiceAdaptor) IssueOrder(req *IssueOrderRequest) ([]*order.Order
 		}
 		orderIDs = append(orderIDs, id)
 	}
+	clientID, err := parseID(req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	ppID, err := parseOptionalID(req.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
 
 	r := &orderServise.IssueOrderRequest{
-		IDs: orderIDs,
+		IDs:           orderIDs,
+		ClientID:      clientID,
+		Code:          req.Code,
+		Mode:          orderServise.IssueAllOrNothing,
+		PickupPointID: ppID,
+	}
+	if req.BestEffort {
+		r.Mode = orderServise.IssueBestEffort
 	}
 
 	resp, err := a.orderService.IssueOrder(context.Background(), r)
 
-	return resp.Orders, err
+	return resp.Results, err
 }
 
-func (a *OrderServiceAdaptor) GetOrders(req *GetOrdersRequest) ([]*order.Order, error) {
+func (a *OrderServiceAdaptor) GetOrders(req *GetOrdersRequest) ([]*order.Order, string, error) {
 	clientID, err := parseID(req.ClientID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	ppID, err := parseOptionalID(req.PickupPointID)
+	if err != nil {
+		return nil, "", err
 	}
 
 	r := &orderServise.GetOrdersRequest{
-		ClientID:  clientID,
-		LocalOnly: req.LocalOnly,
+		ClientID:      clientID,
+		LocalOnly:     req.LocalOnly,
+		PickupPointID: ppID,
+		PageToken:     req.PageToken,
+		PageSize:      req.PageSize,
 	}
 
 	resp, err := a.orderService.GetOrders(context.Background(), r)
 
-	return resp.Orders, err
+	return resp.Orders, resp.NextPageToken, err
 }
 
 func (a *OrderServiceAdaptor) AcceptReturn(req *AcceptReturnRequest) error {
@@ -117,10 +154,15 @@ func (a *OrderServiceAdaptor) AcceptReturn(req *AcceptReturnRequest) error {
 	if err != nil {
 		return err
 	}
+	ppID, err := parseOptionalID(req.PickupPointID)
+	if err != nil {
+		return err
+	}
 
 	r := &orderServise.AcceptReturnRequest{
-		ClientID: clientID,
-		OrderID:  orderID,
+		ClientID:      clientID,
+		OrderID:       orderID,
+		PickupPointID: ppID,
 	}
 
 	_, err = a.orderService.AcceptReturn(context.Background(), r)
@@ -128,11 +170,65 @@ func (a *OrderServiceAdaptor) AcceptReturn(req *AcceptReturnRequest) error {
 	return err
 }
 
-func (a *OrderServiceAdaptor) GetReturned(req *GetReturnedRequest) ([]*order.Order, error) {
-	r := &orderServise.GetReturnedRequest{Page: req.Page, PerPage: req.PerPage}
+func (a *OrderServiceAdaptor) GetReturned(req *GetReturnedRequest) ([]*order.Order, string, error) {
+	r := &orderServise.GetReturnedRequest{PageToken: req.PageToken, PerPage: req.PerPage}
 
 	resp, err := a.orderService.GetReturned(context.Background(), r)
-	return resp.Orders, err
+	return resp.Orders, resp.NextPageToken, err
+}
+
+func (a *OrderServiceAdaptor) SearchOrders(req *SearchOrdersRequest) ([]*order.Order, string, error) {
+	filter, sort, err := parseSearch(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	r := &orderServise.SearchOrdersRequest{
+		Filter:    filter,
+		Sort:      sort,
+		PageToken: req.PageToken,
+		PageSize:  req.PageSize,
+	}
+
+	resp, err := a.orderService.SearchOrders(context.Background(), r)
+	return resp.Orders, resp.NextPageToken, err
+}
+
+func (a *OrderServiceAdaptor) GetOrderHistory(req *GetOrderHistoryRequest) ([]*order.StatusChange, error) {
+	orderID, err := parseID(req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &orderServise.GetOrderHistoryRequest{
+		OrderID: orderID,
+	}
+
+	resp, err := a.orderService.GetOrderHistory(context.Background(), r)
+	return resp.History, err
+}
+
+func (a *OrderServiceAdaptor) SuggestPackaging(req *SuggestPackagingRequest) ([]*order.PackagingType, error) {
+	var parseErr error = nil
+
+	weight, err := parseUnsigned(req.Weight)
+	parseErr = errors.Join(parseErr, err)
+
+	size, err := parseSize(req.Size)
+	parseErr = errors.Join(parseErr, err)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	r := &orderServise.SuggestPackagingRequest{
+		Weight:     weight,
+		Dimensions: size,
+		Required:   req.Required,
+	}
+
+	resp, err := a.orderService.SuggestPackaging(context.Background(), r)
+	return resp.Layers, err
 }
 
 func parseUnsigned(str string) (uint, error) {
@@ -153,17 +249,181 @@ func parseID(idStr string) (basetypes.ID, error) {
 	}
 	return basetypes.ID(idInt), nil
 }
-func parsePackaging(p string) (order.Packaging, error) {
-	switch p {
-	case "":
+
+// parseOptionalID returns 0 for an empty string.
+func parseOptionalID(idStr string) (basetypes.ID, error) {
+	if idStr == "" {
+		return 0, nil
+	}
+	return parseID(idStr)
+}
+
+// parsePackaging splits a comma-separated list of packaging layers, e.g. "box,bag,film".
+func parsePackaging(p string) ([]string, error) {
+	if p == "" {
 		return nil, nil
-	case "box":
-		return order.NewBox(), nil
-	case "bag":
-		return order.NewBag(), nil
-	case "film":
-		return order.NewFilm(), nil
-	default:
-		return nil, fmt.Errorf("unknown package")
 	}
+
+	layers := strings.Split(p, ",")
+	for i, l := range layers {
+		layers[i] = strings.TrimSpace(l)
+		if layers[i] == "" {
+			return nil, errors.New("empty packaging layer")
+		}
+	}
+	return layers, nil
+}
+
+// parseSize parses parcel sizes given as LxWxH, an empty string means unknown sizes.
+func parseSize(s string) (order.Dimensions, error) {
+	if s == "" {
+		return order.Dimensions{}, nil
+	}
+
+	parts := strings.Split(s, "x")
+	if len(parts) != 3 {
+		return order.Dimensions{}, errors.New("invalid size format, LxWxH expected")
+	}
+
+	var sizes [3]uint
+	for i, p := range parts {
+		v, err := parseUnsigned(p)
+		if err != nil {
+			return order.Dimensions{}, fmt.Errorf("invalid size format, LxWxH expected: %w", err)
+		}
+		sizes[i] = v
+	}
+	return order.Dimensions{Length: sizes[0], Width: sizes[1], Height: sizes[2]}, nil
+}
+
+func parseTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format, RFC3339 expected: %w", err)
+	}
+	return &t, nil
+}
+
+// parseSearch returns the filter and the sort keys of the search request.
+func parseSearch(req *SearchOrdersRequest) (order.Filter, []order.Sort, error) {
+	f := order.Filter{IncludeArchived: req.Archived}
+	var parseErr error = nil
+
+	if req.ClientID != "" {
+		clientID, err := parseID(req.ClientID)
+		parseErr = errors.Join(parseErr, err)
+		f.ClientID = &clientID
+	}
+	if req.PickupPointID != "" {
+		ppID, err := parseID(req.PickupPointID)
+		parseErr = errors.Join(parseErr, err)
+		f.PickUpPointID = &ppID
+	}
+
+	for _, s := range req.Statuses {
+		status := order.Status(strings.TrimSpace(s))
+		if !status.Valid() {
+			parseErr = errors.Join(parseErr, fmt.Errorf("unknown order status: %s", s))
+		}
+		f.Statuses = append(f.Statuses, status)
+	}
+
+	var err error
+	f.StatusUpdated.From, err = parseTime(req.UpdatedAfter)
+	parseErr = errors.Join(parseErr, err)
+	f.StatusUpdated.To, err = parseTime(req.UpdatedBefore)
+	parseErr = errors.Join(parseErr, err)
+
+	if req.OlderThan != "" {
+		age, err := time.ParseDuration(req.OlderThan)
+		parseErr = errors.Join(parseErr, err)
+		before := time.Now().Add(-age)
+		if f.StatusUpdated.To == nil || before.Before(*f.StatusUpdated.To) {
+			f.StatusUpdated.To = &before
+		}
+	}
+
+	f.Weight.Min, err = parseOptionalUnsigned(req.MinWeight)
+	parseErr = errors.Join(parseErr, err)
+	f.Weight.Max, err = parseOptionalUnsigned(req.MaxWeight)
+	parseErr = errors.Join(parseErr, err)
+	f.Cost.Min, err = parseOptionalUnsigned(req.MinCost)
+	parseErr = errors.Join(parseErr, err)
+	f.Cost.Max, err = parseOptionalUnsigned(req.MaxCost)
+	parseErr = errors.Join(parseErr, err)
+
+	sort, err := parseSort(req.Sort)
+	parseErr = errors.Join(parseErr, err)
+
+	return f, sort, parseErr
+}
+
+// parseOptionalUnsigned returns nil for an empty string.
+func parseOptionalUnsigned(str string) (*uint, error) {
+	if str == "" {
+		return nil, nil
+	}
+	v, err := parseUnsigned(str)
+	return &v, err
+}
+
+// parseSort parses comma-separated sort fields, "-" before a field sorts it descending, e.g. "-cost,id".
+func parseSort(s string) ([]order.Sort, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var sort []order.Sort
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		key := order.Sort{Field: order.SortField(strings.TrimPrefix(f, "-")), Desc: strings.HasPrefix(f, "-")}
+		if !key.Field.Valid() {
+			return nil, fmt.Errorf("%w: %s", order.ErrInvalidSortField, f)
+		}
+		sort = append(sort, key)
+	}
+	return sort, nil
+}
+
+func (a *OrderServiceAdaptor) GetCourierManifest(req *GetCourierManifestRequest) ([]*order.Order, error) {
+	ppID, err := parseOptionalID(req.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &orderServise.GetCourierManifestRequest{PickupPointID: ppID}
+
+	resp, err := a.orderService.GetCourierManifest(context.Background(), r)
+	return resp.Orders, err
+}
+
+func (a *OrderServiceAdaptor) CourierHandover(req *CourierHandoverRequest) (*order.Handover, error) {
+	courierID, err := parseID(req.CourierID)
+	if err != nil {
+		return nil, err
+	}
+	var orderIDs []basetypes.ID
+	for _, idStr := range req.OrderIDs {
+		id, err := parseID(idStr)
+		if err != nil {
+			return nil, err
+		}
+		orderIDs = append(orderIDs, id)
+	}
+	ppID, err := parseOptionalID(req.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &orderServise.CourierHandoverRequest{
+		CourierID:     courierID,
+		OrderIDs:      orderIDs,
+		PickupPointID: ppID,
+	}
+
+	resp, err := a.orderService.CourierHandover(context.Background(), r)
+	return resp.Handover, err
 }
@@ -0,0 +1,15 @@
+package db
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vlad1028/order-manager/internal/archive"
+	"github.com/vlad1028/order-manager/internal/order/repository/postgres"
+)
+
+func SetupArchiveStorage(pool *pgxpool.Pool) archive.Storage {
+	txManager := postgres.NewTxManager(pool)
+	repos := postgres.NewPgRepository()
+	storage := postgres.NewStorageFacade(txManager, repos)
+
+	return storage
+}
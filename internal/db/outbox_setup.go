@@ -0,0 +1,15 @@
+package db
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vlad1028/order-manager/internal/kafka"
+	"github.com/vlad1028/order-manager/internal/order/repository/postgres"
+)
+
+func SetupOutboxStorage(pool *pgxpool.Pool) kafka.OutboxStorage {
+	txManager := postgres.NewTxManager(pool)
+	repos := postgres.NewPgRepository()
+	storage := postgres.NewStorageFacade(txManager, repos)
+
+	return storage
+}
@@ -0,0 +1,177 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// PartitionedTable is a table partitioned by ranges of a timestamp column, one partition per month.
+// Partitions are named after the table and the month, e.g. orders_202410.
+type PartitionedTable struct {
+	Name  string
+	Ahead int // Number of upcoming months to create partitions for in advance.
+	// Partitions ending more than Retention ago are detached, zero keeps all of them.
+	// Detached partitions stay in the database as standalone tables.
+	Retention time.Duration
+	// Whether old partitions with rows are detached too. Otherwise they are kept until they are empty,
+	// e.g. until their rows are archived.
+	DetachNonEmpty bool
+}
+
+// PartitionManager periodically creates upcoming partitions of tables and detaches old ones.
+// Only the instance holding the lock manages partitions.
+type PartitionManager struct {
+	pool     *pgxpool.Pool
+	lock     *AdvisoryLock
+	tables   []PartitionedTable
+	interval time.Duration // Pause between maintenance passes.
+}
+
+func NewPartitionManager(pool *pgxpool.Pool, lock *AdvisoryLock, interval time.Duration, tables ...PartitionedTable) *PartitionManager {
+	return &PartitionManager{
+		pool:     pool,
+		lock:     lock,
+		tables:   tables,
+		interval: interval,
+	}
+}
+
+// Run maintains partitions until ctx is done and releases the lock afterwards.
+func (m *PartitionManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	defer func() {
+		if err := m.lock.Unlock(context.Background()); err != nil {
+			log.Printf("Failed to release the partition manager lock: %v", err)
+		}
+	}()
+
+	for {
+		if err := m.Maintain(ctx, time.Now()); err != nil {
+			log.Printf("Failed to maintain partitions: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Maintain makes a single maintenance pass as of now if the lock is held by this instance.
+func (m *PartitionManager) Maintain(ctx context.Context, now time.Time) error {
+	leader, err := m.lock.TryLock(ctx)
+	if err != nil || !leader {
+		return err
+	}
+
+	for _, t := range m.tables {
+		if err = m.createPartitions(ctx, t, now); err != nil {
+			return fmt.Errorf("create partitions of %s: %w", t.Name, err)
+		}
+		if err = m.detachPartitions(ctx, t, now); err != nil {
+			return fmt.Errorf("detach partitions of %s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// createPartitions creates the partitions of the current and the upcoming months.
+func (m *PartitionManager) createPartitions(ctx context.Context, t PartitionedTable, now time.Time) error {
+	month := monthStart(now)
+	for i := 0; i <= t.Ahead; i++ {
+		from, to := month.AddDate(0, i, 0), month.AddDate(0, i+1, 0)
+
+		_, err := m.pool.Exec(ctx, fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			partitionName(t.Name, from), pgx.Identifier{t.Name}.Sanitize(), from.Format(time.RFC3339), to.Format(time.RFC3339)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detachPartitions detaches the monthly partitions which ended more than the retention period ago.
+func (m *PartitionManager) detachPartitions(ctx context.Context, t PartitionedTable, now time.Time) error {
+	if t.Retention == 0 {
+		return nil
+	}
+
+	rows, err := m.pool.Query(ctx, `
+		SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = $1::regclass ORDER BY c.relname`,
+		t.Name)
+	if err != nil {
+		return err
+	}
+
+	var partitions []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			rows.Close()
+			return err
+		}
+		partitions = append(partitions, name)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	deadline := now.Add(-t.Retention)
+	for _, p := range partitions {
+		month, ok := partitionMonth(t.Name, p)
+		if !ok || month.AddDate(0, 1, 0).After(deadline) {
+			continue // the default partition or a recent one
+		}
+
+		if !t.DetachNonEmpty {
+			var empty bool
+			if err = m.pool.QueryRow(ctx, fmt.Sprintf("SELECT NOT EXISTS (SELECT 1 FROM %s)", pgx.Identifier{p}.Sanitize())).Scan(&empty); err != nil {
+				return err
+			}
+			if !empty {
+				log.Printf("Partition %s is past the retention period but still has rows, keeping it", p)
+				continue
+			}
+		}
+
+		_, err = m.pool.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s",
+			pgx.Identifier{t.Name}.Sanitize(), pgx.Identifier{p}.Sanitize()))
+		if err != nil {
+			return err
+		}
+		log.Printf("Detached partition %s", p)
+	}
+	return nil
+}
+
+// monthStart returns the start of the month of t in UTC.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func partitionName(table string, month time.Time) string {
+	return pgx.Identifier{table + "_" + month.Format("200601")}.Sanitize()
+}
+
+// partitionMonth returns the month of the partition named after the table or false if it isn't a monthly one.
+func partitionMonth(table, partition string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(partition, table+"_")
+	if !ok {
+		return time.Time{}, false
+	}
+
+	month, err := time.Parse("200601", suffix)
+	return month, err == nil
+}
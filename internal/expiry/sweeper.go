@@ -0,0 +1,73 @@
+package expiry
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/metrics"
+)
+
+// Expirer moves the orders past their storage deadline to the expired status.
+type Expirer interface {
+	// ExpireOrders returns the number of expired orders.
+	ExpireOrders(ctx context.Context) (int, error)
+}
+
+// Lock elects the replica that runs the sweeper.
+type Lock interface {
+	// TryLock acquires the lock if it is free and reports whether it is held by the caller.
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// Sweeper periodically expires stale orders. Only the replica holding the lock sweeps,
+// it keeps the lock between passes until it stops or loses the connection.
+type Sweeper struct {
+	expirer  Expirer
+	lock     Lock
+	interval time.Duration // Pause between sweeps.
+}
+
+func NewSweeper(expirer Expirer, lock Lock, interval time.Duration) *Sweeper {
+	return &Sweeper{
+		expirer:  expirer,
+		lock:     lock,
+		interval: interval,
+	}
+}
+
+// Run sweeps until ctx is done and releases the lock afterwards.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	defer func() {
+		if err := s.lock.Unlock(context.Background()); err != nil {
+			log.Printf("Failed to release the expiry sweeper lock: %v", err)
+		}
+	}()
+
+	for {
+		if err := s.Sweep(ctx); err != nil {
+			log.Printf("Failed to expire orders: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep expires stale orders if the lock is held by this replica.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	leader, err := s.lock.TryLock(ctx)
+	if err != nil || !leader {
+		return err
+	}
+
+	n, err := s.expirer.ExpireOrders(ctx)
+	metrics.AddExpiredOrdersTotal(n)
+	return err
+}
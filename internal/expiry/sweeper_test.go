@@ -0,0 +1,72 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeLock struct {
+	free    bool
+	held    bool
+	lockErr error
+}
+
+func (l *fakeLock) TryLock(context.Context) (bool, error) {
+	if l.lockErr != nil {
+		return false, l.lockErr
+	}
+	if l.free {
+		l.free, l.held = false, true
+	}
+	return l.held, nil
+}
+
+func (l *fakeLock) Unlock(context.Context) error {
+	if l.held {
+		l.free, l.held = true, false
+	}
+	return nil
+}
+
+type countingExpirer struct {
+	calls int
+}
+
+func (e *countingExpirer) ExpireOrders(context.Context) (int, error) {
+	e.calls++
+	return 1, nil
+}
+
+func TestSweeper_Sweep(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Leader", func(t *testing.T) {
+		lock, expirer := &fakeLock{free: true}, &countingExpirer{}
+		s := NewSweeper(expirer, lock, 0)
+
+		assert.NoError(t, s.Sweep(ctx))
+		assert.NoError(t, s.Sweep(ctx))
+		assert.Equal(t, 2, expirer.calls)
+		assert.True(t, lock.held)
+	})
+
+	t.Run("Follower", func(t *testing.T) {
+		lock, expirer := &fakeLock{}, &countingExpirer{}
+		s := NewSweeper(expirer, lock, 0)
+
+		assert.NoError(t, s.Sweep(ctx))
+		assert.Zero(t, expirer.calls)
+	})
+
+	t.Run("LockError", func(t *testing.T) {
+		lockErr := errors.New("connection refused")
+		lock, expirer := &fakeLock{lockErr: lockErr}, &countingExpirer{}
+		s := NewSweeper(expirer, lock, 0)
+
+		assert.ErrorIs(t, s.Sweep(ctx), lockErr)
+		assert.Zero(t, expirer.calls)
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/order/pb"
+	"github.com/vlad1028/order-manager/internal/models/pickuppoint"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
 	desc "github.com/vlad1028/order-manager/pkg/order-service/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -18,115 +21,309 @@ func ConvertIDsFromProto(ids []uint64) []basetypes.ID {
 	return res
 }
 
-func ConvertOrdersFromProto(orders []*desc.Order) ([]*order.Order, error) {
-	res := make([]*order.Order, len(orders))
+func ConvertStatusChangesFromProto(history []*desc.OrderStatusChange) ([]*order.StatusChange, error) {
+	res := make([]*order.StatusChange, len(history))
 
-	for i, o := range orders {
-		r, err := ConvertOrderFromProto(o)
+	for i, c := range history {
+		status, err := pb.ConvertStatusFromProto(c.Status)
 		if err != nil {
 			return res, err
 		}
-		res[i] = r
+		res[i] = &order.StatusChange{
+			OrderID:       basetypes.ID(c.OrderId),
+			Status:        status,
+			PickupPointID: basetypes.ID(c.PickupPointId),
+			ChangedAt:     c.ChangedAt.AsTime(),
+		}
 	}
 
 	return res, nil
 }
 
-func ConvertOrdersToProto(orders []*order.Order) ([]*desc.Order, error) {
-	res := make([]*desc.Order, len(orders))
+func ConvertStatusChangesToProto(history []*order.StatusChange) ([]*desc.OrderStatusChange, error) {
+	res := make([]*desc.OrderStatusChange, len(history))
 
-	for i, o := range orders {
-		r, err := ConvertOrderToProto(o)
+	for i, c := range history {
+		status, err := pb.ConvertStatusToProto(c.Status)
 		if err != nil {
 			return res, err
 		}
-		res[i] = r
+		res[i] = &desc.OrderStatusChange{
+			OrderId:       uint64(c.OrderID),
+			Status:        status,
+			PickupPointId: uint64(c.PickupPointID),
+			ChangedAt:     timestamppb.New(c.ChangedAt),
+		}
 	}
 
 	return res, nil
 }
 
-func ConvertOrderFromProto(o *desc.Order) (*order.Order, error) {
-	res := &order.Order{}
+// ConvertPackagingFromProto returns the name of the catalogue packaging type the enum value stands for.
+func ConvertPackagingFromProto(packaging desc.OrderPackaging) (string, error) {
+	switch packaging {
+	case desc.OrderPackaging_ORDER_PACKAGING_UNSPECIFIED:
+		return "", nil
+	case desc.OrderPackaging_ORDER_PACKAGING_BOX:
+		return "box", nil
+	case desc.OrderPackaging_ORDER_PACKAGING_BAG:
+		return "bag", nil
+	case desc.OrderPackaging_ORDER_PACKAGING_FILM:
+		return "film", nil
+	default:
+		return "", fmt.Errorf("unknown package")
+	}
+}
+
+func ConvertPickupPointFromProto(id uint64, name, address, workingHours string, maxOrders, maxWeight uint32) *pickuppoint.PickupPoint {
+	return &pickuppoint.PickupPoint{
+		ID:           basetypes.ID(id),
+		Name:         name,
+		Address:      address,
+		WorkingHours: workingHours,
+		MaxOrders:    uint(maxOrders),
+		MaxWeight:    uint(maxWeight),
+	}
+}
+
+func ConvertPickupPointToProto(p *pickuppoint.PickupPoint) *desc.PickupPoint {
+	return &desc.PickupPoint{
+		Id:           uint64(p.ID),
+		Name:         p.Name,
+		Address:      p.Address,
+		WorkingHours: p.WorkingHours,
+		MaxOrders:    uint32(p.MaxOrders),
+		MaxWeight:    uint32(p.MaxWeight),
+		StoredOrders: uint32(p.Occupancy.Orders),
+		StoredWeight: uint32(p.Occupancy.Weight),
+	}
+}
+
+func ConvertPickupPointsToProto(points []*pickuppoint.PickupPoint) []*desc.PickupPoint {
+	res := make([]*desc.PickupPoint, len(points))
+
+	for i, p := range points {
+		res[i] = ConvertPickupPointToProto(p)
+	}
+
+	return res
+}
+
+func ConvertPackagingTypeFromProto(t *desc.PackagingType) *order.PackagingType {
+	return &order.PackagingType{
+		Name:        t.GetName(),
+		WeightLimit: uint(t.GetWeightLimit()),
+		Cost:        uint(t.GetCost()),
+		CanWrap:     t.GetCanWrap(),
+		Wraps:       t.GetWraps(),
+		MaxLength:   uint(t.GetMaxLength()),
+		MaxWidth:    uint(t.GetMaxWidth()),
+		MaxHeight:   uint(t.GetMaxHeight()),
+	}
+}
+
+func ConvertPackagingTypeToProto(t *order.PackagingType) *desc.PackagingType {
+	return &desc.PackagingType{
+		Name:        t.Name,
+		WeightLimit: uint32(t.WeightLimit),
+		Cost:        uint32(t.Cost),
+		CanWrap:     t.CanWrap,
+		Wraps:       t.Wraps,
+		MaxLength:   uint32(t.MaxLength),
+		MaxWidth:    uint32(t.MaxWidth),
+		MaxHeight:   uint32(t.MaxHeight),
+	}
+}
 
-	res.ID = basetypes.ID(o.Id)
-	res.ClientID = basetypes.ID(o.ClientId)
-	res.PickupPointID = basetypes.ID(o.PickupPointId)
+func ConvertPackagingTypesToProto(types []*order.PackagingType) []*desc.PackagingType {
+	res := make([]*desc.PackagingType, len(types))
+
+	for i, t := range types {
+		res[i] = ConvertPackagingTypeToProto(t)
+	}
+
+	return res
+}
 
-	status, err := ConvertStatusFromProto(o.Status)
-	if err != nil {
-		return res, err
+func ConvertIssueModeFromProto(mode desc.IssueMode) orderServise.IssueMode {
+	if mode == desc.IssueMode_ISSUE_MODE_BEST_EFFORT {
+		return orderServise.IssueBestEffort
 	}
-	res.Status = status
+	return orderServise.IssueAllOrNothing
+}
+
+var issueReasons = map[order.IssueReason]desc.IssueReason{
+	order.Issued:                desc.IssueReason_ISSUE_REASON_ISSUED,
+	order.IssueNotFound:         desc.IssueReason_ISSUE_REASON_NOT_FOUND,
+	order.IssueWrongClient:      desc.IssueReason_ISSUE_REASON_WRONG_CLIENT,
+	order.IssueWrongPickupPoint: desc.IssueReason_ISSUE_REASON_WRONG_PICKUP_POINT,
+	order.IssueNotStored:        desc.IssueReason_ISSUE_REASON_NOT_STORED,
+	order.IssueExpired:          desc.IssueReason_ISSUE_REASON_EXPIRED,
+}
 
-	res.StatusUpdated = o.StatusUpdated.AsTime()
-	res.Weight = uint(o.Weight)
-	res.Cost = uint(o.Weight)
+func ConvertIssueResultsToProto(results []order.IssueResult) []*desc.IssueResult {
+	res := make([]*desc.IssueResult, len(results))
+	for i, r := range results {
+		res[i] = &desc.IssueResult{OrderId: uint64(r.OrderID), Reason: issueReasons[r.Reason]}
+	}
+	return res
+}
 
+func ConvertIssueResultsFromProto(results []*desc.IssueResult) ([]order.IssueResult, error) {
+	res := make([]order.IssueResult, len(results))
+	for i, r := range results {
+		res[i].OrderID = basetypes.ID(r.GetOrderId())
+		for reason, protoReason := range issueReasons {
+			if protoReason == r.GetReason() {
+				res[i].Reason = reason
+			}
+		}
+		if res[i].Reason == "" {
+			return res, fmt.Errorf("unknown issue reason: %v", r.GetReason())
+		}
+	}
 	return res, nil
 }
 
-func ConvertOrderToProto(o *order.Order) (*desc.Order, error) {
-	res := &desc.Order{}
+func ConvertIDsToProto(ids []basetypes.ID) []uint64 {
+	res := make([]uint64, len(ids))
+	for i, id := range ids {
+		res[i] = uint64(id)
+	}
+	return res
+}
 
-	res.Id = uint64(o.ID)
-	res.ClientId = uint64(o.ClientID)
-	res.PickupPointId = uint64(o.PickupPointID)
+func ConvertHandoverToProto(h *order.Handover) *desc.Handover {
+	return &desc.Handover{
+		Id:            h.ID,
+		CourierId:     uint64(h.CourierID),
+		PickupPointId: uint64(h.PickupPointID),
+		SignedOffAt:   timestamppb.New(h.SignedOffAt),
+		OrderIds:      ConvertIDsToProto(h.OrderIDs),
+	}
+}
 
-	status, err := ConvertStatusToProto(o.Status)
-	if err != nil {
-		return res, err
+func ConvertHandoverFromProto(h *desc.Handover) *order.Handover {
+	return &order.Handover{
+		ID:            h.GetId(),
+		CourierID:     basetypes.ID(h.GetCourierId()),
+		PickupPointID: basetypes.ID(h.GetPickupPointId()),
+		SignedOffAt:   h.GetSignedOffAt().AsTime(),
+		OrderIDs:      ConvertIDsFromProto(h.GetOrderIds()),
 	}
-	res.Status = status
+}
 
-	res.StatusUpdated = timestamppb.New(o.StatusUpdated)
-	res.Weight = uint32(o.Weight)
-	res.Cost = uint32(o.Weight)
+var sortFields = map[order.SortField]desc.OrderSortField{
+	order.SortByStatusUpdated: desc.OrderSortField_ORDER_SORT_FIELD_STATUS_UPDATED,
+	order.SortByWeight:        desc.OrderSortField_ORDER_SORT_FIELD_WEIGHT,
+	order.SortByCost:          desc.OrderSortField_ORDER_SORT_FIELD_COST,
+	order.SortByID:            desc.OrderSortField_ORDER_SORT_FIELD_ID,
+}
 
+func ConvertSortFromProto(sort []*desc.OrderSort) ([]order.Sort, error) {
+	res := make([]order.Sort, len(sort))
+	for i, s := range sort {
+		field, err := convertSortFieldFromProto(s.GetField())
+		if err != nil {
+			return nil, err
+		}
+		res[i] = order.Sort{Field: field, Desc: s.GetDesc()}
+	}
 	return res, nil
 }
 
-func ConvertStatusFromProto(s desc.OrderStatus) (order.Status, error) {
-	switch s {
-	case desc.OrderStatus_ORDER_STATUS_RETURNED:
-		return order.Returned, nil
-	case desc.OrderStatus_ORDER_STATUS_STORED:
-		return order.Stored, nil
-	case desc.OrderStatus_ORDER_STATUS_REACHED_CLIENT:
-		return order.ReachedClient, nil
-	case desc.OrderStatus_ORDER_STATUS_CANCELED:
-		return order.Canceled, nil
-	default:
-		return "", fmt.Errorf("unknown order status: %v", s)
+func convertSortFieldFromProto(f desc.OrderSortField) (order.SortField, error) {
+	for field, pf := range sortFields {
+		if pf == f {
+			return field, nil
+		}
 	}
+	return "", fmt.Errorf("%w: %v", order.ErrInvalidSortField, f)
 }
 
-func ConvertStatusToProto(s order.Status) (desc.OrderStatus, error) {
-	switch s {
-	case order.Canceled:
-		return desc.OrderStatus_ORDER_STATUS_CANCELED, nil
-	case order.ReachedClient:
-		return desc.OrderStatus_ORDER_STATUS_REACHED_CLIENT, nil
-	case order.Returned:
-		return desc.OrderStatus_ORDER_STATUS_RETURNED, nil
-	case order.Stored:
-		return desc.OrderStatus_ORDER_STATUS_STORED, nil
-	default:
-		return desc.OrderStatus_ORDER_STATUS_UNSPECIFIED, fmt.Errorf("unknown order status: %v", s)
+func ConvertSortToProto(sort []order.Sort) []*desc.OrderSort {
+	res := make([]*desc.OrderSort, len(sort))
+	for i, s := range sort {
+		res[i] = &desc.OrderSort{Field: sortFields[s.Field], Desc: s.Desc}
 	}
+	return res
 }
 
-func ConvertPackagingFromProto(packaging desc.OrderPackaging) (order.Packaging, error) {
-	switch packaging {
-	case desc.OrderPackaging_ORDER_PACKAGING_UNSPECIFIED:
-		return nil, nil
-	case desc.OrderPackaging_ORDER_PACKAGING_BOX:
-		return order.NewBox(), nil
-	case desc.OrderPackaging_ORDER_PACKAGING_BAG:
-		return order.NewBag(), nil
-	case desc.OrderPackaging_ORDER_PACKAGING_FILM:
-		return order.NewFilm(), nil
-	default:
-		return nil, fmt.Errorf("unknown package")
+// ConvertSearchFilterFromProto returns the filter of the search criteria of req.
+func ConvertSearchFilterFromProto(req *desc.SearchOrdersRequest) (order.Filter, error) {
+	f := order.Filter{
+		StatusUpdated: order.TimeRange{
+			From: pb.ConvertTimestampFromProto(req.GetStatusUpdatedFrom()),
+			To:   pb.ConvertTimestampFromProto(req.GetStatusUpdatedTo()),
+		},
+		Weight: order.UintRange{Min: convertOptionalUintFromProto(req.MinWeight), Max: convertOptionalUintFromProto(req.MaxWeight)},
+		Cost:   order.UintRange{Min: convertOptionalUintFromProto(req.MinCost), Max: convertOptionalUintFromProto(req.MaxCost)},
+
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+
+	if req.GetClientId() != 0 {
+		clientID := basetypes.ID(req.GetClientId())
+		f.ClientID = &clientID
+	}
+	if req.PickupPointId != nil {
+		ppID := basetypes.ID(req.GetPickupPointId())
+		f.PickUpPointID = &ppID
+	}
+
+	for _, s := range req.GetStatuses() {
+		status, err := pb.ConvertStatusFromProto(s)
+		if err != nil {
+			return order.Filter{}, err
+		}
+		f.Statuses = append(f.Statuses, status)
+	}
+
+	return f, nil
+}
+
+// ConvertSearchFilterToProto fills the search criteria of req from the filter.
+// Only the criteria SearchOrdersRequest has are converted.
+func ConvertSearchFilterToProto(f *order.Filter, req *desc.SearchOrdersRequest) error {
+	if f.ClientID != nil {
+		req.ClientId = uint64(*f.ClientID)
+	}
+	if f.PickUpPointID != nil {
+		ppID := uint64(*f.PickUpPointID)
+		req.PickupPointId = &ppID
+	}
+
+	for _, s := range f.Statuses {
+		status, err := pb.ConvertStatusToProto(s)
+		if err != nil {
+			return err
+		}
+		req.Statuses = append(req.Statuses, status)
+	}
+
+	req.StatusUpdatedFrom = pb.ConvertTimestampToProto(f.StatusUpdated.From)
+	req.StatusUpdatedTo = pb.ConvertTimestampToProto(f.StatusUpdated.To)
+	req.MinWeight = convertOptionalUintToProto(f.Weight.Min)
+	req.MaxWeight = convertOptionalUintToProto(f.Weight.Max)
+	req.MinCost = convertOptionalUintToProto(f.Cost.Min)
+	req.MaxCost = convertOptionalUintToProto(f.Cost.Max)
+	req.IncludeArchived = f.IncludeArchived
+
+	return nil
+}
+
+func convertOptionalUintFromProto(v *uint32) *uint {
+	if v == nil {
+		return nil
+	}
+	res := uint(*v)
+	return &res
+}
+
+func convertOptionalUintToProto(v *uint) *uint32 {
+	if v == nil {
+		return nil
 	}
+	res := uint32(*v)
+	return &res
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order/pb"
 	orderServise "github.com/vlad1028/order-manager/internal/order"
 	desc "github.com/vlad1028/order-manager/pkg/order-service/v1"
 	"google.golang.org/grpc/codes"
@@ -12,11 +13,12 @@ import (
 
 type OrderGrpcAdaptor struct {
 	desc.UnimplementedOrderServiceServer
-	service orderServise.Service
+	service            orderServise.Service
+	defaultPickupPoint basetypes.ID // Pickup point of requests that don't specify one.
 }
 
-func NewOrderGrpcAdaptor(s orderServise.Service) *OrderGrpcAdaptor {
-	return &OrderGrpcAdaptor{service: s}
+func NewOrderGrpcAdaptor(s orderServise.Service, defaultPickupPoint basetypes.ID) *OrderGrpcAdaptor {
+	return &OrderGrpcAdaptor{service: s, defaultPickupPoint: defaultPickupPoint}
 }
 
 func (s *OrderGrpcAdaptor) AcceptOrder(ctx context.Context, req *desc.AcceptOrderRequest) (*desc.AcceptOrderResponse, error) {
@@ -24,18 +26,27 @@ func (s *OrderGrpcAdaptor) AcceptOrder(ctx context.Context, req *desc.AcceptOrde
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	pack, err := ConvertPackagingFromProto(req.GetPackaging())
+	pack, err := packagingFromRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ppID, err := s.pickupPointFromRequest(ctx, req.PickupPointId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	r := &orderServise.AcceptOrderRequest{
-		ID:        basetypes.ID(req.GetId()),
-		ClientID:  basetypes.ID(req.GetClientId()),
-		Weight:    uint(req.GetWeight()),
-		Cost:      uint(req.GetCost()),
-		Packaging: pack,
-		AddFilm:   req.GetAddFilm(),
+		ID:         basetypes.ID(req.GetId()),
+		ClientID:   basetypes.ID(req.GetClientId()),
+		Weight:     uint(req.GetWeight()),
+		Dimensions: pb.ConvertDimensionsFromProto(req.GetLength(), req.GetWidth(), req.GetHeight()),
+		Cost:       uint(req.GetCost()),
+		Packaging:  pack,
+		AddFilm:    req.GetAddFilm(),
+		ExpiresAt:  pb.ConvertTimestampFromProto(req.GetStorageUntil()),
+
+		PickupPointID: ppID,
 	}
 
 	_, err = s.service.AcceptOrder(ctx, r)
@@ -43,6 +54,17 @@ func (s *OrderGrpcAdaptor) AcceptOrder(ctx context.Context, req *desc.AcceptOrde
 	if err != nil {
 		if errors.Is(err, orderServise.ErrOrderExists) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
+		} else if errors.Is(err, orderServise.ErrPickupPointNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrPickupPointFull) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		} else if errors.Is(err, orderServise.ErrExpiresInPast) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if errors.Is(err, orderServise.ErrPackagingNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrNoPrimaryPack) || errors.Is(err, orderServise.ErrAdditionalPackNotAllowed) ||
+			errors.Is(err, orderServise.ErrPackagingLimitExceeded) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -50,21 +72,49 @@ func (s *OrderGrpcAdaptor) AcceptOrder(ctx context.Context, req *desc.AcceptOrde
 	return &desc.AcceptOrderResponse{}, nil
 }
 
+// packagingFromRequest returns the packaging layers of the request,
+// falling back to the single-layer fields older clients send.
+func packagingFromRequest(req *desc.AcceptOrderRequest) ([]string, error) {
+	if layers := req.GetPackagingLayers(); len(layers) != 0 {
+		return layers, nil
+	}
+	if t := req.GetPackagingType(); t != "" {
+		return []string{t}, nil
+	}
+
+	t, err := ConvertPackagingFromProto(req.GetPackaging())
+	if err != nil || t == "" {
+		return nil, err
+	}
+	return []string{t}, nil
+}
+
 func (s *OrderGrpcAdaptor) AcceptReturn(ctx context.Context, req *desc.AcceptReturnRequest) (*desc.AcceptReturnResponse, error) {
 	if err := req.ValidateAll(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ppID, err := s.pickupPointFromRequest(ctx, req.PickupPointId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	r := &orderServise.AcceptReturnRequest{
 		ClientID: basetypes.ID(req.GetClientId()),
 		OrderID:  basetypes.ID(req.GetOrderId()),
+
+		PickupPointID: ppID,
 	}
 
-	_, err := s.service.AcceptReturn(ctx, r)
+	_, err = s.service.AcceptReturn(ctx, r)
 
 	if err != nil {
-		if errors.Is(err, orderServise.ErrReturnExpired) {
+		if errors.Is(err, orderServise.ErrPickupPointNotFound) || errors.Is(err, orderServise.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrReturnExpired) {
 			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		} else if errors.Is(err, orderServise.ErrConcurrentModification) {
+			return nil, status.Error(codes.Aborted, err.Error())
 		} else if errors.Is(err, orderServise.ErrOrderNotIssued) || errors.Is(err, orderServise.ErrWrongClientID) || errors.Is(err, orderServise.ErrWrongPickupPoint) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
@@ -88,6 +138,10 @@ func (s *OrderGrpcAdaptor) CancelOrder(ctx context.Context, req *desc.CancelOrde
 	if err != nil {
 		if errors.Is(err, orderServise.ErrCantCancel) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if errors.Is(err, orderServise.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrConcurrentModification) {
+			return nil, status.Error(codes.Aborted, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -100,22 +154,37 @@ func (s *OrderGrpcAdaptor) GetOrders(ctx context.Context, req *desc.GetOrdersReq
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ppID, err := s.pickupPointFromRequest(ctx, req.PickupPointId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	r := &orderServise.GetOrdersRequest{
 		ClientID:  basetypes.ID(req.GetClientId()),
 		LocalOnly: req.GetLocalOnly(),
+
+		PickupPointID: ppID,
+		PageToken:     req.GetPageToken(),
+		PageSize:      int(req.GetPageSize()),
 	}
 
 	resp, err := s.service.GetOrders(ctx, r)
 
 	if err != nil {
+		if errors.Is(err, orderServise.ErrPickupPointNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, orderServise.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	orders, err := ConvertOrdersToProto(resp.Orders)
+	orders, err := pb.ConvertOrdersToProto(resp.Orders)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &desc.GetOrdersResponse{Orders: orders}, nil
+	return &desc.GetOrdersResponse{Orders: orders, NextPageToken: resp.NextPageToken}, nil
 }
 
 func (s *OrderGrpcAdaptor) GetReturned(ctx context.Context, req *desc.GetReturnedRequest) (*desc.GetReturnedResponse, error) {
@@ -123,19 +192,59 @@ func (s *OrderGrpcAdaptor) GetReturned(ctx context.Context, req *desc.GetReturne
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	r := &orderServise.GetReturnedRequest{Page: int(req.GetPage()), PerPage: int(req.GetPerPage())}
+	r := &orderServise.GetReturnedRequest{PageToken: req.GetPageToken(), PerPage: int(req.GetPerPage())}
 
 	resp, err := s.service.GetReturned(ctx, r)
 
 	if err != nil {
+		if errors.Is(err, orderServise.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	orders, err := pb.ConvertOrdersToProto(resp.Orders)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &desc.GetReturnedResponse{Orders: orders, NextPageToken: resp.NextPageToken}, nil
+}
+
+func (s *OrderGrpcAdaptor) SearchOrders(ctx context.Context, req *desc.SearchOrdersRequest) (*desc.SearchOrdersResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := ConvertSearchFilterFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sort, err := ConvertSortFromProto(req.GetSort())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.SearchOrdersRequest{
+		Filter:    filter,
+		Sort:      sort,
+		PageToken: req.GetPageToken(),
+		PageSize:  int(req.GetPageSize()),
+	}
+
+	resp, err := s.service.SearchOrders(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrInvalidPageToken) || errors.Is(err, orderServise.ErrInvalidSortField) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	orders, err := ConvertOrdersToProto(resp.Orders)
+	orders, err := pb.ConvertOrdersToProto(resp.Orders)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &desc.GetReturnedResponse{Orders: orders}, nil
+	return &desc.SearchOrdersResponse{Orders: orders, NextPageToken: resp.NextPageToken}, nil
 }
 
 func (s *OrderGrpcAdaptor) IssueOrder(ctx context.Context, req *desc.IssueOrderRequest) (*desc.IssueOrderResponse, error) {
@@ -143,19 +252,254 @@ func (s *OrderGrpcAdaptor) IssueOrder(ctx context.Context, req *desc.IssueOrderR
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ppID, err := s.pickupPointFromRequest(ctx, req.PickupPointId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	r := &orderServise.IssueOrderRequest{
-		IDs: ConvertIDsFromProto(req.GetIds()),
+		IDs:      ConvertIDsFromProto(req.GetIds()),
+		ClientID: basetypes.ID(req.GetClientId()),
+		Code:     req.GetCode(),
+		Mode:     ConvertIssueModeFromProto(req.GetMode()),
+
+		PickupPointID: ppID,
 	}
 
 	resp, err := s.service.IssueOrder(ctx, r)
 
 	if err != nil {
+		if errors.Is(err, orderServise.ErrPickupPointNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrInvalidPickupCode) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		} else if errors.Is(err, orderServise.ErrPickupCodeLocked) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		} else if errors.Is(err, orderServise.ErrConcurrentModification) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		} else if errors.Is(err, orderServise.ErrIssueRejected) {
+			st, detailsErr := status.New(codes.FailedPrecondition, err.Error()).
+				WithDetails(&desc.IssueOrderResponse{Results: ConvertIssueResultsToProto(resp.Results)})
+			if detailsErr != nil {
+				return nil, status.Error(codes.Internal, detailsErr.Error())
+			}
+			return nil, st.Err()
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	orders, err := pb.ConvertOrdersToProto(resp.Orders)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &desc.IssueOrderResponse{Orders: orders, Results: ConvertIssueResultsToProto(resp.Results)}, nil
+}
+
+func (s *OrderGrpcAdaptor) GetOrderHistory(ctx context.Context, req *desc.GetOrderHistoryRequest) (*desc.GetOrderHistoryResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.GetOrderHistoryRequest{
+		OrderID: basetypes.ID(req.GetOrderId()),
+	}
+
+	resp, err := s.service.GetOrderHistory(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	orders, err := ConvertOrdersToProto(resp.Orders)
+	history, err := ConvertStatusChangesToProto(resp.History)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &desc.IssueOrderResponse{Orders: orders}, nil
+	return &desc.GetOrderHistoryResponse{History: history}, nil
+}
+
+func (s *OrderGrpcAdaptor) CreatePickupPoint(ctx context.Context, req *desc.CreatePickupPointRequest) (*desc.CreatePickupPointResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.CreatePickupPointRequest{
+		PickupPoint: ConvertPickupPointFromProto(req.GetId(), req.GetName(), req.GetAddress(), req.GetWorkingHours(), req.GetMaxOrders(), req.GetMaxWeight()),
+	}
+
+	resp, err := s.service.CreatePickupPoint(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrPickupPointExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.CreatePickupPointResponse{PickupPoint: ConvertPickupPointToProto(resp.PickupPoint)}, nil
+}
+
+func (s *OrderGrpcAdaptor) UpdatePickupPoint(ctx context.Context, req *desc.UpdatePickupPointRequest) (*desc.UpdatePickupPointResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.UpdatePickupPointRequest{
+		PickupPoint: ConvertPickupPointFromProto(req.GetId(), req.GetName(), req.GetAddress(), req.GetWorkingHours(), req.GetMaxOrders(), req.GetMaxWeight()),
+	}
+
+	resp, err := s.service.UpdatePickupPoint(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrPickupPointNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.UpdatePickupPointResponse{PickupPoint: ConvertPickupPointToProto(resp.PickupPoint)}, nil
+}
+
+func (s *OrderGrpcAdaptor) ListPickupPoints(ctx context.Context, _ *desc.ListPickupPointsRequest) (*desc.ListPickupPointsResponse, error) {
+	resp, err := s.service.ListPickupPoints(ctx, &orderServise.ListPickupPointsRequest{})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.ListPickupPointsResponse{PickupPoints: ConvertPickupPointsToProto(resp.PickupPoints)}, nil
+}
+
+func (s *OrderGrpcAdaptor) SavePackagingType(ctx context.Context, req *desc.SavePackagingTypeRequest) (*desc.SavePackagingTypeResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.SavePackagingTypeRequest{
+		PackagingType: ConvertPackagingTypeFromProto(req.GetPackagingType()),
+	}
+
+	resp, err := s.service.SavePackagingType(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrPackagingNotFound) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.SavePackagingTypeResponse{Created: resp.Created}, nil
+}
+
+func (s *OrderGrpcAdaptor) DeletePackagingType(ctx context.Context, req *desc.DeletePackagingTypeRequest) (*desc.DeletePackagingTypeResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err := s.service.DeletePackagingType(ctx, &orderServise.DeletePackagingTypeRequest{Name: req.GetName()})
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrPackagingNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrPackagingInUse) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.DeletePackagingTypeResponse{}, nil
+}
+
+func (s *OrderGrpcAdaptor) ListPackagingTypes(ctx context.Context, _ *desc.ListPackagingTypesRequest) (*desc.ListPackagingTypesResponse, error) {
+	resp, err := s.service.ListPackagingTypes(ctx, &orderServise.ListPackagingTypesRequest{})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.ListPackagingTypesResponse{PackagingTypes: ConvertPackagingTypesToProto(resp.PackagingTypes)}, nil
+}
+
+func (s *OrderGrpcAdaptor) SuggestPackaging(ctx context.Context, req *desc.SuggestPackagingRequest) (*desc.SuggestPackagingResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.SuggestPackagingRequest{
+		Weight:     uint(req.GetWeight()),
+		Dimensions: pb.ConvertDimensionsFromProto(req.GetLength(), req.GetWidth(), req.GetHeight()),
+		Required:   req.GetRequired(),
+	}
+
+	resp, err := s.service.SuggestPackaging(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrNoSuitablePackaging) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.SuggestPackagingResponse{Layers: ConvertPackagingTypesToProto(resp.Layers), Cost: uint32(resp.Cost)}, nil
+}
+
+func (s *OrderGrpcAdaptor) GetCourierManifest(ctx context.Context, req *desc.GetCourierManifestRequest) (*desc.GetCourierManifestResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ppID, err := s.pickupPointFromRequest(ctx, req.PickupPointId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.service.GetCourierManifest(ctx, &orderServise.GetCourierManifestRequest{PickupPointID: ppID})
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrPickupPointNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	orders, err := pb.ConvertOrdersToProto(resp.Orders)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &desc.GetCourierManifestResponse{Orders: orders}, nil
+}
+
+func (s *OrderGrpcAdaptor) CourierHandover(ctx context.Context, req *desc.CourierHandoverRequest) (*desc.CourierHandoverResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ppID, err := s.pickupPointFromRequest(ctx, req.PickupPointId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r := &orderServise.CourierHandoverRequest{
+		CourierID: basetypes.ID(req.GetCourierId()),
+		OrderIDs:  ConvertIDsFromProto(req.GetOrderIds()),
+
+		PickupPointID: ppID,
+	}
+
+	resp, err := s.service.CourierHandover(ctx, r)
+
+	if err != nil {
+		if errors.Is(err, orderServise.ErrPickupPointNotFound) || errors.Is(err, orderServise.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, orderServise.ErrCantHandOver) || errors.Is(err, orderServise.ErrNothingToHandOver) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		} else if errors.Is(err, orderServise.ErrConcurrentModification) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.CourierHandoverResponse{Handover: ConvertHandoverToProto(resp.Handover)}, nil
 }
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"google.golang.org/grpc/metadata"
+)
+
+// PickupPointMetadataKey is the gRPC metadata key carrying the pickup point serving the request.
+// The HTTP gateway forwards it from the Grpc-Metadata-X-Pickup-Point-Id header.
+const PickupPointMetadataKey = "x-pickup-point-id"
+
+// pickupPointFromRequest returns the pickup point from the request field or, if it is unset, from metadata.
+// Requests specifying neither are served by the default pickup point.
+func (s *OrderGrpcAdaptor) pickupPointFromRequest(ctx context.Context, field *uint64) (basetypes.ID, error) {
+	if field != nil {
+		return basetypes.ID(*field), nil
+	}
+
+	values := metadata.ValueFromIncomingContext(ctx, PickupPointMetadataKey)
+	if len(values) == 0 {
+		return s.defaultPickupPoint, nil
+	}
+
+	id, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s metadata: %w", PickupPointMetadataKey, err)
+	}
+	return basetypes.ID(id), nil
+}
@@ -0,0 +1,133 @@
+package kafka
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/models/outbox"
+)
+
+// MessageSender defines the interface for publishing a message to the broker.
+type MessageSender interface {
+	SendMessage(key, value []byte) error
+}
+
+// OutboxStorage defines the interface for reading pending outbox messages and recording the results of sending them.
+type OutboxStorage interface {
+	// GetPendingMessages returns messages which are due to be sent, leaving out the ones
+	// behind a message with the same key which waits for its next attempt.
+	GetPendingMessages(ctx context.Context, limit int) ([]*outbox.Message, error)
+	MarkSent(ctx context.Context, id uint64) error
+	// MarkFailed counts a failed attempt and postpones the next one by retryIn.
+	MarkFailed(ctx context.Context, id uint64, retryIn time.Duration) error
+	// MarkDead counts a failed attempt and parks the message so it is never sent.
+	MarkDead(ctx context.Context, id uint64) error
+}
+
+// Lock elects the replica that runs the relay.
+type Lock interface {
+	// TryLock acquires the lock if it is free and reports whether it is held by the caller.
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// OutboxRelay periodically publishes pending outbox messages to the broker.
+// Only the replica holding the lock publishes, so the messages aren't sent once per replica.
+// Messages with the same key are published in the order they were written;
+// a message that failed to be sent blocks the following messages with its key until its next attempt,
+// which is postponed by a backoff doubled after each failure. After maxRetries failed attempts
+// the message is parked as dead and the following messages with its key are published.
+// Delivery is at-least-once: a message may be resent if marking it as sent fails.
+type OutboxRelay struct {
+	storage      OutboxStorage
+	producer     MessageSender
+	lock         Lock
+	interval     time.Duration // Pause between relay passes.
+	batchSize    int           // Maximum number of messages fetched per pass.
+	maxRetries   int           // Number of send attempts per message before it is parked as dead.
+	retryBackoff time.Duration // Pause before the second attempt, doubled after each further failure.
+}
+
+func NewOutboxRelay(storage OutboxStorage, producer MessageSender, lock Lock, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		storage:      storage,
+		producer:     producer,
+		lock:         lock,
+		interval:     interval,
+		batchSize:    100,
+		maxRetries:   10,
+		retryBackoff: time.Second,
+	}
+}
+
+// Run relays pending messages until ctx is done and releases the lock afterwards.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	defer func() {
+		if err := r.lock.Unlock(context.Background()); err != nil {
+			log.Printf("Failed to release the outbox relay lock: %v", err)
+		}
+	}()
+
+	for {
+		if err := r.RelayPending(ctx); err != nil {
+			log.Printf("Failed to relay outbox messages: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending makes a single pass over pending messages if the lock is held by this replica.
+func (r *OutboxRelay) RelayPending(ctx context.Context) error {
+	leader, err := r.lock.TryLock(ctx)
+	if err != nil || !leader {
+		return err
+	}
+
+	messages, err := r.storage.GetPendingMessages(ctx, r.batchSize)
+	if err != nil {
+		return err
+	}
+
+	blocked := make(map[string]struct{})
+	for _, msg := range messages {
+		if _, ok := blocked[msg.Key]; ok {
+			continue
+		}
+
+		if sendErr := r.producer.SendMessage([]byte(msg.Key), msg.Payload); sendErr != nil {
+			parked, err := r.fail(ctx, msg)
+			if err != nil {
+				return err
+			}
+			if parked {
+				log.Printf("Outbox message %d is parked as dead after %d attempts: %v", msg.ID, msg.Attempts+1, sendErr)
+			} else {
+				log.Printf("Failed to send outbox message %d: %v", msg.ID, sendErr)
+				blocked[msg.Key] = struct{}{}
+			}
+			continue
+		}
+
+		if err = r.storage.MarkSent(ctx, msg.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail records a failed attempt to send msg and reports whether the message is parked as dead.
+func (r *OutboxRelay) fail(ctx context.Context, msg *outbox.Message) (bool, error) {
+	attempts := msg.Attempts + 1
+	if attempts >= r.maxRetries {
+		return true, r.storage.MarkDead(ctx, msg.ID)
+	}
+	return false, r.storage.MarkFailed(ctx, msg.ID, r.retryBackoff<<(attempts-1))
+}
@@ -0,0 +1,136 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/outbox"
+)
+
+type memoryOutbox struct {
+	messages []*outbox.Message
+	sent     []uint64
+	failed   map[uint64]time.Duration
+	dead     []uint64
+}
+
+func (s *memoryOutbox) GetPendingMessages(_ context.Context, limit int) ([]*outbox.Message, error) {
+	return s.messages[:min(limit, len(s.messages))], nil
+}
+
+func (s *memoryOutbox) MarkSent(_ context.Context, id uint64) error {
+	s.sent = append(s.sent, id)
+	return nil
+}
+
+func (s *memoryOutbox) MarkFailed(_ context.Context, id uint64, retryIn time.Duration) error {
+	if s.failed == nil {
+		s.failed = make(map[uint64]time.Duration)
+	}
+	s.failed[id] = retryIn
+	return nil
+}
+
+func (s *memoryOutbox) MarkDead(_ context.Context, id uint64) error {
+	s.dead = append(s.dead, id)
+	return nil
+}
+
+type fakeLock struct {
+	held bool
+}
+
+func (l *fakeLock) TryLock(context.Context) (bool, error) {
+	return l.held, nil
+}
+
+func (l *fakeLock) Unlock(context.Context) error {
+	l.held = false
+	return nil
+}
+
+type failingProducer struct {
+	MockProducer
+	failKey string
+}
+
+func (p *failingProducer) SendMessage(key, value []byte) error {
+	if string(key) == p.failKey {
+		return errors.New("broker is unavailable")
+	}
+	return p.MockProducer.SendMessage(key, value)
+}
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	storage := &memoryOutbox{
+		messages: []*outbox.Message{
+			{ID: 1, Key: "1", Payload: []byte("accept")},
+			{ID: 2, Key: "2", Payload: []byte("accept")},
+			{ID: 3, Key: "1", Payload: []byte("issue")},
+			{ID: 4, Key: "2", Payload: []byte("issue")},
+		},
+	}
+	producer := &failingProducer{failKey: "2"}
+
+	relay := NewOutboxRelay(storage, producer, &fakeLock{held: true}, 0)
+
+	err := relay.RelayPending(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []uint64{1, 3}, storage.sent)
+	assert.Equal(t, map[uint64]time.Duration{2: time.Second}, storage.failed)
+	assert.Empty(t, storage.dead)
+	assert.Len(t, producer.Messages, 2)
+	assert.Equal(t, []byte("accept"), producer.Messages[0].Value)
+	assert.Equal(t, []byte("issue"), producer.Messages[1].Value)
+}
+
+func TestOutboxRelay_RelayPendingBackoff(t *testing.T) {
+	storage := &memoryOutbox{
+		messages: []*outbox.Message{{ID: 1, Key: "1", Payload: []byte("accept"), Attempts: 3}},
+	}
+
+	relay := NewOutboxRelay(storage, &failingProducer{failKey: "1"}, &fakeLock{held: true}, 0)
+
+	err := relay.RelayPending(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[uint64]time.Duration{1: 8 * time.Second}, storage.failed)
+}
+
+func TestOutboxRelay_RelayPendingParksDeadMessage(t *testing.T) {
+	storage := &memoryOutbox{
+		messages: []*outbox.Message{
+			{ID: 1, Key: "1", Payload: []byte("accept"), Attempts: 9},
+			{ID: 2, Key: "1", Payload: []byte("issue")},
+		},
+	}
+	producer := &failingProducer{failKey: "1"}
+
+	relay := NewOutboxRelay(storage, producer, &fakeLock{held: true}, 0)
+
+	err := relay.RelayPending(context.Background())
+	assert.NoError(t, err)
+
+	// the parked message no longer blocks its key, so the next one is tried as well
+	assert.Equal(t, []uint64{1}, storage.dead)
+	assert.Equal(t, map[uint64]time.Duration{2: time.Second}, storage.failed)
+}
+
+func TestOutboxRelay_RelayPendingFollower(t *testing.T) {
+	storage := &memoryOutbox{
+		messages: []*outbox.Message{{ID: 1, Key: "1", Payload: []byte("accept")}},
+	}
+	producer := NewMockProducer()
+
+	relay := NewOutboxRelay(storage, producer, &fakeLock{}, 0)
+
+	err := relay.RelayPending(context.Background())
+	assert.NoError(t, err)
+
+	assert.Empty(t, storage.sent)
+	assert.Empty(t, producer.Messages)
+}
@@ -10,6 +10,13 @@ import (
 
 var IssuedOrdersLabel = "issued_orders_total"
 
+// Labels of the cache metrics.
+const (
+	CacheTierLabel   = "tier"   // the cache tier, e.g. memory or redis
+	CacheResultLabel = "result" // hit or miss
+	CacheReasonLabel = "reason" // why an entry was evicted: capacity or expired
+)
+
 var (
 	IssuedOrdersTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -18,6 +25,32 @@ var (
 		},
 		[]string{IssuedOrdersLabel},
 	)
+	ExpiredOrdersTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "expired_orders_total",
+			Help: "Total number of orders moved to the expired status by the sweeper",
+		},
+	)
+	ArchivedOrdersTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "archived_orders_total",
+			Help: "Total number of orders moved to the archive",
+		},
+	)
+	CacheLookupsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_lookups_total",
+			Help: "Total number of order cache lookups by tier and result",
+		},
+		[]string{CacheTierLabel, CacheResultLabel},
+	)
+	CacheEvictionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_evictions_total",
+			Help: "Total number of entries evicted from the order cache by tier and reason",
+		},
+		[]string{CacheTierLabel, CacheReasonLabel},
+	)
 )
 
 func AddIssuedOrdersTotal(cnt int, label string) {
@@ -26,6 +59,32 @@ func AddIssuedOrdersTotal(cnt int, label string) {
 	}).Add(float64(cnt))
 }
 
+func AddExpiredOrdersTotal(cnt int) {
+	ExpiredOrdersTotal.Add(float64(cnt))
+}
+
+func AddArchivedOrdersTotal(cnt int) {
+	ArchivedOrdersTotal.Add(float64(cnt))
+}
+
+func IncCacheLookups(tier string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	CacheLookupsTotal.With(prometheus.Labels{
+		CacheTierLabel:   tier,
+		CacheResultLabel: result,
+	}).Inc()
+}
+
+func IncCacheEvictions(tier, reason string) {
+	CacheEvictionsTotal.With(prometheus.Labels{
+		CacheTierLabel:   tier,
+		CacheReasonLabel: reason,
+	}).Inc()
+}
+
 func StartMetricsServer(addr string) {
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
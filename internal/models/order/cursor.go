@@ -0,0 +1,79 @@
+package order
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"hash/fnv"
+	"time"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Cursor is the position of an order in a sorted list of orders. A page starts right after its cursor.
+// It keeps all the sortable fields, so the same cursor works for any sort keys, yet a page token
+// is only meaningful for the sort and the filter it was issued for and is bound to them.
+type Cursor struct {
+	StatusUpdated time.Time
+	Weight        uint
+	Cost          uint
+	ID            basetypes.ID
+}
+
+// CursorOf returns the cursor pointing at the order.
+func CursorOf(o *Order) *Cursor {
+	return &Cursor{StatusUpdated: o.StatusUpdated, Weight: o.Weight, Cost: o.Cost, ID: o.ID}
+}
+
+// Value returns the value of the field at the cursor.
+func (c *Cursor) Value(f SortField) interface{} {
+	switch f {
+	case SortByStatusUpdated:
+		return c.StatusUpdated
+	case SortByWeight:
+		return c.Weight
+	case SortByCost:
+		return c.Cost
+	default:
+		return c.ID
+	}
+}
+
+// Token returns the cursor as an opaque page token of the list of orders the query, see QueryKey, identifies.
+func (c *Cursor) Token(query string) string {
+	raw := fmt.Sprintf("%d:%d:%d:%d:%d:%x", c.StatusUpdated.Unix(), c.StatusUpdated.Nanosecond(), c.Weight, c.Cost, c.ID, queryHash(query))
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParsePageToken returns the cursor of the page token or nil for the empty token, which stands for the first page.
+// It fails with ErrInvalidPageToken if the token was issued for another query.
+func ParsePageToken(token string, query string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var sec, nsec int64
+	var hash uint64
+	var c Cursor
+	if _, err = fmt.Sscanf(string(raw), "%d:%d:%d:%d:%d:%x", &sec, &nsec, &c.Weight, &c.Cost, &c.ID, &hash); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if hash != queryHash(query) {
+		return nil, ErrInvalidPageToken
+	}
+	c.StatusUpdated = time.Unix(sec, nsec).UTC()
+
+	return &c, nil
+}
+
+func queryHash(query string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(query))
+	return h.Sum64()
+}
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePageToken(t *testing.T) {
+	c := &Cursor{StatusUpdated: time.Date(2024, 10, 20, 12, 0, 0, 123, time.UTC), Weight: 5, Cost: 100, ID: 42}
+
+	stored := Stored
+	query := QueryKey(&Filter{Status: &stored}, DefaultSort)
+
+	parsed, err := ParsePageToken(c.Token(query), query)
+	assert.NoError(t, err)
+	assert.Equal(t, c, parsed)
+
+	parsed, err = ParsePageToken("", query)
+	assert.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	_, err = ParsePageToken("bm90IGEgY3Vyc29y", query)
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestParsePageToken_OtherQuery(t *testing.T) {
+	c := &Cursor{StatusUpdated: time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC), Weight: 5, Cost: 100, ID: 42}
+	stored, expired := Stored, Expired
+	token := c.Token(QueryKey(&Filter{Status: &stored}, DefaultSort))
+
+	_, err := ParsePageToken(token, QueryKey(&Filter{Status: &expired}, DefaultSort))
+	assert.ErrorIs(t, err, ErrInvalidPageToken, "another filter")
+
+	_, err = ParsePageToken(token, QueryKey(&Filter{Status: &stored}, []Sort{{Field: SortByCost}, {Field: SortByID}}))
+	assert.ErrorIs(t, err, ErrInvalidPageToken, "another sort")
+}
@@ -0,0 +1,46 @@
+package order
+
+import (
+	"math"
+	"slices"
+)
+
+// VolumetricDivisor is the number of cubic centimetres charged as one kilogram.
+const VolumetricDivisor = 5000
+
+// Dimensions are the sizes of a parcel in centimetres. Zero sizes mean they are unknown.
+type Dimensions struct {
+	Length uint `db:"length"`
+	Width  uint `db:"width"`
+	Height uint `db:"height"`
+}
+
+func (d Dimensions) Volume() uint {
+	return d.Length * d.Width * d.Height
+}
+
+// VolumetricWeight returns the weight in grams the parcel is charged by for its size, rounded up.
+func (d Dimensions) VolumetricWeight() uint {
+	return (d.Volume()*1000 + VolumetricDivisor - 1) / VolumetricDivisor
+}
+
+// FitsInto reports whether the parcel can be put into a space of the given inner sizes
+// in some orientation. A zero limit doesn't restrict its side.
+func (d Dimensions) FitsInto(limit Dimensions) bool {
+	sizes := []uint{d.Length, d.Width, d.Height}
+	limits := []uint{limit.Length, limit.Width, limit.Height}
+	for i, l := range limits {
+		if l == 0 {
+			limits[i] = math.MaxUint
+		}
+	}
+
+	slices.Sort(sizes)
+	slices.Sort(limits)
+	for i := range sizes {
+		if sizes[i] > limits[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDimensions_FitsInto(t *testing.T) {
+	tests := []struct {
+		name   string
+		parcel Dimensions
+		limit  Dimensions
+		want   bool
+	}{
+		{"Unknown", Dimensions{}, Dimensions{10, 10, 10}, true},
+		{"NoLimit", Dimensions{100, 100, 100}, Dimensions{}, true},
+		{"Fits", Dimensions{10, 20, 30}, Dimensions{30, 20, 10}, true},
+		{"Rotated", Dimensions{5, 40, 5}, Dimensions{10, 10, 40}, true},
+		{"TooLong", Dimensions{10, 20, 31}, Dimensions{30, 20, 10}, false},
+		{"PartialLimit", Dimensions{50, 50, 9}, Dimensions{0, 0, 10}, true},
+		{"PartialLimitExceeded", Dimensions{50, 50, 11}, Dimensions{0, 0, 10}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.parcel.FitsInto(tt.limit))
+		})
+	}
+}
+
+func TestOrder_ChargeableWeight(t *testing.T) {
+	assert.Equal(t, uint(300), (&Order{Weight: 300}).ChargeableWeight())
+	assert.Equal(t, uint(300), (&Order{Weight: 300, Dimensions: Dimensions{10, 10, 10}}).ChargeableWeight())
+	assert.Equal(t, uint(12800), (&Order{Weight: 300, Dimensions: Dimensions{40, 40, 40}}).ChargeableWeight())
+	assert.Equal(t, uint(1), (&Order{Dimensions: Dimensions{1, 1, 1}}).ChargeableWeight(), "rounded up")
+}
+
+func TestPackagingType_ValidateLightBulkyParcel(t *testing.T) {
+	bag := &PackagingType{Name: "bag", WeightLimit: 5000}
+
+	assert.NoError(t, bag.Validate(&Order{Weight: 300, Dimensions: Dimensions{20, 20, 20}}))
+	// 300 g but charged as 12.8 kg
+	assert.ErrorIs(t, bag.Validate(&Order{Weight: 300, Dimensions: Dimensions{40, 40, 40}}), ErrPackagingLimitExceeded)
+}
@@ -1,12 +1,151 @@
 package order
 
 import (
+	"errors"
+	"fmt"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"slices"
+	"strings"
+	"time"
 )
 
+var ErrInvalidSortField = errors.New("invalid sort field")
+
+// Filter selects orders matching all the set criteria. Unset criteria match any order.
 type Filter struct {
-	ID            *basetypes.ID `db:"id"`
-	ClientID      *basetypes.ID `db:"client_id"`
-	PickUpPointID *basetypes.ID `db:"pickup_point_id"`
-	Status        *Status       `db:"status"`
+	ID            *basetypes.ID
+	ClientID      *basetypes.ID
+	PickUpPointID *basetypes.ID
+	Status        *Status
+	Statuses      []Status // any of the statuses
+
+	StatusUpdated TimeRange
+	Weight        UintRange
+	Cost          UintRange
+
+	IncludeArchived bool // whether to look for orders moved to the archive too, only lists of orders respect it
+}
+
+// Key returns a canonical encoding of the filter: filters with the same criteria have equal keys,
+// whatever the order of Statuses and the time zones of the time bounds.
+func (f *Filter) Key() string {
+	var b strings.Builder
+	writeID := func(name string, id *basetypes.ID) {
+		if id != nil {
+			fmt.Fprintf(&b, "%s=%d;", name, *id)
+		}
+	}
+	writeTime := func(name string, t *time.Time) {
+		if t != nil {
+			fmt.Fprintf(&b, "%s=%s;", name, t.UTC().Format(time.RFC3339Nano))
+		}
+	}
+	writeUint := func(name string, v *uint) {
+		if v != nil {
+			fmt.Fprintf(&b, "%s=%d;", name, *v)
+		}
+	}
+
+	writeID("id", f.ID)
+	writeID("client", f.ClientID)
+	writeID("pickup_point", f.PickUpPointID)
+	if f.Status != nil {
+		fmt.Fprintf(&b, "status=%s;", *f.Status)
+	}
+	if len(f.Statuses) > 0 {
+		statuses := slices.Clone(f.Statuses)
+		slices.Sort(statuses)
+		fmt.Fprintf(&b, "statuses=%s;", strings.Join(slices.Compact(statusStrings(statuses)), ","))
+	}
+	writeTime("updated_from", f.StatusUpdated.From)
+	writeTime("updated_to", f.StatusUpdated.To)
+	writeUint("min_weight", f.Weight.Min)
+	writeUint("max_weight", f.Weight.Max)
+	writeUint("min_cost", f.Cost.Min)
+	writeUint("max_cost", f.Cost.Max)
+	if f.IncludeArchived {
+		b.WriteString("archived;")
+	}
+	return b.String()
+}
+
+func statusStrings(statuses []Status) []string {
+	s := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		s = append(s, string(status))
+	}
+	return s
+}
+
+// TimeRange matches times from From inclusive to To exclusive. A nil bound leaves the range open.
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// UintRange matches values from Min to Max inclusive. A nil bound leaves the range open.
+type UintRange struct {
+	Min *uint
+	Max *uint
+}
+
+// SortField is an order field lists of orders can be sorted by.
+type SortField string
+
+const (
+	SortByStatusUpdated SortField = "status_updated"
+	SortByWeight        SortField = "weight"
+	SortByCost          SortField = "cost"
+	SortByID            SortField = "id"
+)
+
+func (f SortField) Valid() bool {
+	switch f {
+	case SortByStatusUpdated, SortByWeight, SortByCost, SortByID:
+		return true
+	}
+	return false
+}
+
+// Sort is a key of a sorted list of orders.
+type Sort struct {
+	Field SortField
+	Desc  bool
+}
+
+// DefaultSort is the order of lists without explicit sort keys.
+var DefaultSort = []Sort{{Field: SortByStatusUpdated}, {Field: SortByID}}
+
+// SortKeys returns the keys to sort orders by: the given ones followed by ID, which makes the order total.
+// Without keys it returns DefaultSort.
+func SortKeys(sort []Sort) ([]Sort, error) {
+	if len(sort) == 0 {
+		return DefaultSort, nil
+	}
+
+	keys := make([]Sort, 0, len(sort)+1)
+	for _, s := range sort {
+		if !s.Field.Valid() {
+			return nil, ErrInvalidSortField
+		}
+		keys = append(keys, s)
+		if s.Field == SortByID {
+			return keys, nil // the rest keys never apply
+		}
+	}
+	return append(keys, Sort{Field: SortByID}), nil
+}
+
+// QueryKey returns a canonical encoding of the list of orders matching the filter sorted by the keys.
+func QueryKey(filter *Filter, keys []Sort) string {
+	var b strings.Builder
+	b.WriteString(filter.Key())
+	b.WriteString("sort=")
+	for _, k := range keys {
+		if k.Desc {
+			b.WriteByte('-')
+		}
+		b.WriteString(string(k.Field) + ",")
+	}
+	return b.String()
 }
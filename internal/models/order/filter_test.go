@@ -0,0 +1,47 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+)
+
+func TestSortKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    []Sort
+		want    []Sort
+		wantErr error
+	}{
+		{"Default", nil, DefaultSort, nil},
+		{"TieBrokenByID", []Sort{{Field: SortByCost, Desc: true}}, []Sort{{Field: SortByCost, Desc: true}, {Field: SortByID}}, nil},
+		{"EndsWithID", []Sort{{Field: SortByID, Desc: true}, {Field: SortByWeight}}, []Sort{{Field: SortByID, Desc: true}}, nil},
+		{"UnknownField", []Sort{{Field: "client_id"}}, nil, ErrInvalidSortField},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SortKeys(tt.sort)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFilter_Key(t *testing.T) {
+	clientID := basetypes.ID(1)
+	from := time.Date(2024, 10, 20, 15, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	fromUTC := from.UTC()
+	minCost := uint(0)
+
+	a := &Filter{ClientID: &clientID, Statuses: []Status{Stored, Expired, Stored}, StatusUpdated: TimeRange{From: &from}}
+	b := &Filter{ClientID: &clientID, Statuses: []Status{Expired, Stored}, StatusUpdated: TimeRange{From: &fromUTC}}
+	assert.Equal(t, a.Key(), b.Key())
+	assert.Equal(t, "client=1;statuses=expired,stored;updated_from=2024-10-20T12:00:00Z;", a.Key())
+
+	b.Cost.Min = &minCost
+	assert.NotEqual(t, a.Key(), b.Key(), "a zero bound is still a bound")
+	assert.Equal(t, (&Filter{}).Key(), (&Filter{Statuses: []Status{}}).Key(), "empty statuses match any order")
+}
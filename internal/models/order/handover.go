@@ -0,0 +1,26 @@
+package order
+
+import (
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"time"
+)
+
+// Handover is a batch of orders a courier takes away from a Pick Up Point.
+type Handover struct {
+	ID            uint64         `db:"id"`
+	CourierID     basetypes.ID   `db:"courier_id"`
+	PickupPointID basetypes.ID   `db:"pickup_point_id"`
+	SignedOffAt   time.Time      `db:"signed_off_at"`
+	OrderIDs      []basetypes.ID `db:"order_ids"`
+}
+
+// MustLeave reports whether the order has to be handed over to a courier:
+// it was returned by the client or its storage period is over.
+func (o *Order) MustLeave(storeDuration time.Duration, now time.Time) bool {
+	switch o.Status {
+	case Returned, Expired:
+		return true
+	default:
+		return o.IsExpired(storeDuration, now)
+	}
+}
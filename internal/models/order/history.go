@@ -0,0 +1,14 @@
+package order
+
+import (
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"time"
+)
+
+// StatusChange is a single entry of the order status history.
+type StatusChange struct {
+	OrderID       basetypes.ID `db:"order_id"`
+	Status        Status       `db:"status"`
+	PickupPointID basetypes.ID `db:"pickup_point_id"`
+	ChangedAt     time.Time    `db:"changed_at"`
+}
@@ -0,0 +1,46 @@
+package order
+
+import (
+	"errors"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+)
+
+// IssueReason tells whether an order was issued and why not.
+type IssueReason string
+
+const (
+	Issued                IssueReason = "issued"
+	IssueNotFound         IssueReason = "not_found"
+	IssueWrongClient      IssueReason = "wrong_client"
+	IssueWrongPickupPoint IssueReason = "wrong_pickup_point"
+	IssueNotStored        IssueReason = "not_stored"
+	IssueExpired          IssueReason = "expired"
+)
+
+// IssueResult is the outcome of issuing a single order of a batch.
+type IssueResult struct {
+	OrderID basetypes.ID
+	Reason  IssueReason
+}
+
+func (r IssueResult) Issued() bool {
+	return r.Reason == Issued
+}
+
+// IssueReasonOf returns the reason for the error the state machine rejected issuing an order with.
+func IssueReasonOf(err error) IssueReason {
+	var transitionErr *TransitionError
+	switch {
+	case err == nil:
+		return Issued
+	case errors.Is(err, ErrWrongClient):
+		return IssueWrongClient
+	case errors.Is(err, ErrWrongPickupPoint):
+		return IssueWrongPickupPoint
+	case errors.Is(err, ErrStorageExpired),
+		errors.As(err, &transitionErr) && transitionErr.From == Expired:
+		return IssueExpired
+	default:
+		return IssueNotStored
+	}
+}
@@ -12,7 +12,19 @@ type Order struct {
 	Status        Status       `db:"status"`
 	StatusUpdated time.Time    `db:"status_updated"` // only should db update this field
 	Weight        uint         `db:"weight"`
-	Cost          uint         `db:"cost"`
+	Cost          uint         `db:"cost"`       // BaseCost plus the packaging fees
+	BaseCost      uint         `db:"base_cost"`  // cost of the order itself
+	ExpiresAt     *time.Time   `db:"expires_at"` // nil means the Pick Up Point default storage period
+	Version       uint64       `db:"version"`    // incremented by every update, zero for orders not stored yet
+	Dimensions
+
+	Packaging []PackagingLayer `db:"packaging"` // from the outermost layer to the innermost one
+}
+
+// PackagingLayer is a single packaging layer applied to the order and the fee charged for it.
+type PackagingLayer struct {
+	Type string `json:"type"`
+	Cost uint   `json:"cost"`
 }
 
 func NewOrder(ID, cID, ppID basetypes.ID, w, c uint) *Order {
@@ -23,6 +35,7 @@ func NewOrder(ID, cID, ppID basetypes.ID, w, c uint) *Order {
 		Status:        Stored,
 		Weight:        w,
 		Cost:          c,
+		BaseCost:      c,
 	}
 }
 
@@ -30,14 +43,37 @@ func (o *Order) SetStatus(newStatus Status) {
 	o.Status = newStatus
 }
 
+// StorageDeadline returns the moment the order storage ends.
+// The default storeDuration is used if the order has no deadline of its own.
+func (o *Order) StorageDeadline(storeDuration time.Duration) time.Time {
+	if o.ExpiresAt != nil {
+		return *o.ExpiresAt
+	}
+	return o.StatusUpdated.Add(storeDuration)
+}
+
 func (o *Order) IsExpired(storeDuration time.Duration, now time.Time) bool {
-	return o.Status != Stored || o.StatusUpdated.Add(storeDuration).Before(now)
+	return o.Status == Stored && o.StorageDeadline(storeDuration).Before(now)
 }
 
 func (o *Order) CanBeReturned(timeToMakeReturn time.Duration, now time.Time) bool {
 	return o.Status == ReachedClient && o.StatusUpdated.Add(timeToMakeReturn).After(now)
 }
 
+// ChargeableWeight returns the greater of the actual and the volumetric weight in grams.
+func (o *Order) ChargeableWeight() uint {
+	return max(o.Weight, o.VolumetricWeight())
+}
+
+// PackagingCost returns the sum of the packaging fees included in the order cost.
+func (o *Order) PackagingCost() uint {
+	var cost uint
+	for _, l := range o.Packaging {
+		cost += l.Cost
+	}
+	return cost
+}
+
 func (o *Order) ApplyPackaging(p Packaging) error {
 	if p != nil {
 		return p.ApplyPackaging(o)
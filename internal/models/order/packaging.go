@@ -1,86 +1,119 @@
 package order
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"math"
+	"slices"
 )
 
+var ErrPackagingLimitExceeded = errors.New("the order exceeds the packaging limits")
+
 type Packaging interface {
 	ApplyPackaging(order *Order) error
 }
 
-type BasePackaging struct {
-	weightLimit uint
-	cost        uint
+// PackagingType describes a kind of packaging from the catalogue.
+type PackagingType struct {
+	Name        string   `db:"name"`
+	WeightLimit uint     `db:"weight_limit"` // in grams, 0 means no limit
+	Cost        uint     `db:"cost"`
+	CanWrap     bool     `db:"can_wrap"`
+	Wraps       []string `db:"wraps"` // types it may wrap, empty means any
+
+	// Inner sizes in centimetres, 0 means no limit for the side.
+	MaxLength uint `db:"max_length"`
+	MaxWidth  uint `db:"max_width"`
+	MaxHeight uint `db:"max_height"`
+}
+
+func (t *PackagingType) MaxSize() Dimensions {
+	return Dimensions{Length: t.MaxLength, Width: t.MaxWidth, Height: t.MaxHeight}
 }
 
-func (p *BasePackaging) validateWeight(weight uint) error {
-	if weight > p.weightLimit {
-		return fmt.Errorf("the order weight exceeds %d kg, choose another packaging", p.weightLimit)
+// Validate checks the order fits into the packaging by weight and size.
+// The weight limit is compared with the chargeable weight of the order.
+func (t *PackagingType) Validate(o *Order) error {
+	if weight := o.ChargeableWeight(); t.WeightLimit != 0 && weight > t.WeightLimit {
+		return fmt.Errorf("%w: the order weight %d g exceeds %d g of %s, choose another packaging",
+			ErrPackagingLimitExceeded, weight, t.WeightLimit, t.Name)
+	}
+	if !o.Dimensions.FitsInto(t.MaxSize()) {
+		return fmt.Errorf("%w: the order doesn't fit into %s, choose another packaging",
+			ErrPackagingLimitExceeded, t.Name)
 	}
 	return nil
 }
 
-func (p *BasePackaging) ApplyPackaging(order *Order) error {
-	if err := p.validateWeight(order.Weight); err != nil {
-		return err
+// MayWrap reports whether packaging of this type may be put over packaging of the other type.
+func (t *PackagingType) MayWrap(other *PackagingType) bool {
+	if !t.CanWrap {
+		return false
 	}
-	order.Cost += p.cost
-	return nil
+	return len(t.Wraps) == 0 || slices.Contains(t.Wraps, other.Name)
 }
 
 type Wrapper interface {
 	Packaging
+	// CanWrap reports whether p may be put inside the wrapper.
+	CanWrap(p Packaging) bool
 	Wrap(p Packaging)
 }
 
-type PackageWrapper struct {
-	BasePackaging
+// CatalogPackaging is packaging built from a PackagingType.
+type CatalogPackaging struct {
+	pType   *PackagingType
 	wrapped Packaging
 }
 
-func (w *PackageWrapper) Wrap(p Packaging) {
-	w.wrapped = p
+func NewCatalogPackaging(t *PackagingType) *CatalogPackaging {
+	return &CatalogPackaging{pType: t}
 }
 
-func (w *PackageWrapper) ApplyPackaging(order *Order) error {
-	if w.wrapped != nil {
-		if err := w.wrapped.ApplyPackaging(order); err != nil {
-			return err
-		}
-	}
-	return w.BasePackaging.ApplyPackaging(order)
+func (p *CatalogPackaging) Type() *PackagingType {
+	return p.pType
 }
 
-type Bag struct {
-	PackageWrapper
+func (p *CatalogPackaging) CanWrap(other Packaging) bool {
+	o, ok := other.(*CatalogPackaging)
+	return ok && p.pType.MayWrap(o.pType)
 }
 
-func NewBag() *Bag {
-	b := &Bag{}
-	b.weightLimit = 10
-	b.cost = 5
-	return b
+func (p *CatalogPackaging) Wrap(other Packaging) {
+	p.wrapped = other
 }
 
-type Box struct {
-	PackageWrapper
+func (p *CatalogPackaging) ApplyPackaging(order *Order) error {
+	if err := p.pType.Validate(order); err != nil {
+		return err
+	}
+	order.Cost += p.pType.Cost
+	order.Packaging = append(order.Packaging, PackagingLayer{Type: p.pType.Name, Cost: p.pType.Cost})
+
+	if p.wrapped != nil {
+		return p.wrapped.ApplyPackaging(order)
+	}
+	return nil
+}
+
+// PackagingCatalog provides packaging types by name.
+type PackagingCatalog interface {
+	GetPackagingType(ctx context.Context, name string) (*PackagingType, error)
 }
 
-func NewBox() *Box {
-	b := &Box{}
-	b.weightLimit = 30
-	b.cost = 20
-	return b
+// PackagingFactory creates packaging of the types described in the catalogue.
+type PackagingFactory struct {
+	catalog PackagingCatalog
 }
 
-type Film struct {
-	BasePackaging
+func NewPackagingFactory(catalog PackagingCatalog) *PackagingFactory {
+	return &PackagingFactory{catalog: catalog}
 }
 
-func NewFilm() *Film {
-	f := &Film{}
-	f.weightLimit = math.MaxInt
-	f.cost = 1
-	return f
+func (f *PackagingFactory) New(ctx context.Context, name string) (*CatalogPackaging, error) {
+	t, err := f.catalog.GetPackagingType(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewCatalogPackaging(t), nil
 }
@@ -0,0 +1,96 @@
+package order
+
+import (
+	"slices"
+)
+
+// maxSuggestedLayers is the number of layers of the longest chain SuggestPackaging considers.
+const maxSuggestedLayers = 5
+
+// SuggestPackaging returns the cheapest chain of the given types, from the outermost layer to the innermost one,
+// the order fits into and which contains all the required types. Of equally cheap chains the shortest one wins.
+// It reports false if there is no such chain of at most maxSuggestedLayers layers.
+func SuggestPackaging(types []*PackagingType, o *Order, required []string) ([]*PackagingType, bool) {
+	var fitting []*PackagingType
+	for _, t := range types {
+		if t.Validate(o) == nil {
+			fitting = append(fitting, t)
+		}
+	}
+
+	// bit i of a mask is set for the type named required[i]
+	required = slices.Clone(required)
+	slices.Sort(required)
+	required = slices.Compact(required)
+	if len(required) > maxSuggestedLayers {
+		return nil, false
+	}
+	masks := make(map[*PackagingType]uint, len(fitting))
+	var available uint
+	for _, t := range fitting {
+		if i := slices.Index(required, t.Name); i >= 0 {
+			masks[t] = 1 << i
+			available |= 1 << i
+		}
+	}
+	all := uint(1)<<len(required) - 1
+	if available != all {
+		return nil, false
+	}
+
+	// the rest of a chain only depends on its last layer and the required types it covers,
+	// so a layer reached with the same ones covered no cheaper and no shorter isn't searched again
+	type state struct {
+		last    *PackagingType
+		covered uint
+	}
+	type reach struct {
+		cost   uint
+		layers int
+	}
+	reached := make(map[state]reach)
+
+	var (
+		chain    []*PackagingType
+		best     []*PackagingType
+		bestCost uint
+		found    bool
+	)
+	var search func(cost uint, covered uint)
+	search = func(cost uint, covered uint) {
+		if len(chain) != 0 && covered == all {
+			// more layers can't make the chain cheaper
+			best, bestCost, found = slices.Clone(chain), cost, true
+			return
+		}
+		if len(chain) == maxSuggestedLayers {
+			return
+		}
+
+		for _, t := range fitting {
+			next, layers := cost+t.Cost, len(chain)+1
+			if found && (next > bestCost || next == bestCost && layers >= len(best)) {
+				continue
+			}
+			if slices.Contains(chain, t) {
+				continue
+			}
+			if len(chain) != 0 && !chain[len(chain)-1].MayWrap(t) {
+				continue
+			}
+
+			s := state{last: t, covered: covered | masks[t]}
+			if r, ok := reached[s]; ok && r.cost <= next && r.layers <= layers {
+				continue
+			}
+			reached[s] = reach{cost: next, layers: layers}
+
+			chain = append(chain, t)
+			search(next, s.covered)
+			chain = chain[:len(chain)-1]
+		}
+	}
+	search(0, 0)
+
+	return best, found
+}
@@ -0,0 +1,129 @@
+package order
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testBox  = &PackagingType{Name: "box", WeightLimit: 30, Cost: 20, CanWrap: true, Wraps: []string{"film"}}
+	testBag  = &PackagingType{Name: "bag", WeightLimit: 10, Cost: 5, CanWrap: true}
+	testFilm = &PackagingType{Name: "film", Cost: 1}
+)
+
+func TestPackagingType_MayWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		outer *PackagingType
+		inner *PackagingType
+		want  bool
+	}{
+		{"Listed", testBox, testFilm, true},
+		{"NotListed", testBox, testBag, false},
+		{"AnyType", testBag, testBox, true},
+		{"CantWrap", testFilm, testBox, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.outer.MayWrap(tt.inner))
+		})
+	}
+}
+
+func TestCatalogPackaging_ApplyPackaging(t *testing.T) {
+	tests := []struct {
+		name       string
+		weight     uint
+		outer      *PackagingType
+		inner      *PackagingType
+		wantCost   uint
+		wantLayers []PackagingLayer
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{"Single", 10, testBox, nil, 120, []PackagingLayer{{"box", 20}}, assert.NoError},
+		{"Wrapped", 10, testBox, testFilm, 121, []PackagingLayer{{"box", 20}, {"film", 1}}, assert.NoError},
+		{"NoLimit", 1000, testFilm, nil, 101, []PackagingLayer{{"film", 1}}, assert.NoError},
+		{"TooHeavy", 31, testBox, nil, 100, nil, assert.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := &Order{Weight: tt.weight, Cost: 100, BaseCost: 100}
+			p := NewCatalogPackaging(tt.outer)
+			if tt.inner != nil {
+				p.Wrap(NewCatalogPackaging(tt.inner))
+			}
+
+			tt.wantErr(t, o.ApplyPackaging(p))
+			assert.Equal(t, tt.wantCost, o.Cost)
+			assert.Equal(t, tt.wantLayers, o.Packaging)
+			assert.Equal(t, o.Cost, o.BaseCost+o.PackagingCost())
+		})
+	}
+}
+
+func TestSuggestPackaging(t *testing.T) {
+	smallBox := &PackagingType{Name: "small-box", WeightLimit: 1000, Cost: 10, CanWrap: true, MaxLength: 20, MaxWidth: 20, MaxHeight: 20}
+	types := []*PackagingType{testBox, testBag, testFilm, smallBox}
+
+	tests := []struct {
+		name     string
+		order    *Order
+		required []string
+		want     []string
+		wantOk   bool
+	}{
+		{"Cheapest", &Order{Weight: 5}, nil, []string{"film"}, true},
+		{"RequiredLayer", &Order{Weight: 5}, []string{"bag"}, []string{"bag"}, true},
+		{"RequiredChain", &Order{Weight: 5}, []string{"bag", "box"}, []string{"bag", "box"}, true},
+		{"BySize", &Order{Weight: 15, Dimensions: Dimensions{15, 15, 15}}, []string{"small-box", "film"}, []string{"small-box", "film"}, true},
+		{"TooBig", &Order{Weight: 15, Dimensions: Dimensions{25, 15, 15}}, []string{"small-box"}, nil, false},
+		{"TooHeavy", &Order{Weight: 40}, []string{"box"}, nil, false},
+		{"UnknownType", &Order{Weight: 5}, []string{"crate"}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chain, ok := SuggestPackaging(types, tt.order, tt.required)
+			assert.Equal(t, tt.wantOk, ok)
+
+			var names []string
+			for _, pt := range chain {
+				names = append(names, pt.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestSuggestPackaging_LargeCatalogue(t *testing.T) {
+	var types []*PackagingType
+	for i := range 60 {
+		types = append(types, &PackagingType{Name: fmt.Sprintf("type-%d", i), Cost: uint(100 - i), CanWrap: true})
+	}
+	types = append(types, testFilm)
+	required := []string{"type-0", "type-1", "type-2", "film"}
+
+	chain, ok := SuggestPackaging(types, &Order{Weight: 5}, required)
+
+	assert.True(t, ok)
+	var names []string
+	for _, pt := range chain {
+		names = append(names, pt.Name)
+	}
+	assert.Len(t, names, 4)
+	assert.ElementsMatch(t, required, names)
+	assert.Equal(t, "film", names[3], "film can't wrap other types")
+
+	_, ok = SuggestPackaging(types, &Order{Weight: 5}, []string{"type-0", "type-1", "type-2", "type-3", "type-4", "type-5"})
+	assert.False(t, ok, "chains are limited to maxSuggestedLayers layers")
+}
@@ -0,0 +1,164 @@
+// Package pb converts orders to and from their protobuf messages, which are shared by the API and the cache.
+package pb
+
+import (
+	"fmt"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	desc "github.com/vlad1028/order-manager/pkg/order-service/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func ConvertOrdersFromProto(orders []*desc.Order) ([]*order.Order, error) {
+	res := make([]*order.Order, len(orders))
+
+	for i, o := range orders {
+		r, err := ConvertOrderFromProto(o)
+		if err != nil {
+			return res, err
+		}
+		res[i] = r
+	}
+
+	return res, nil
+}
+
+func ConvertOrdersToProto(orders []*order.Order) ([]*desc.Order, error) {
+	res := make([]*desc.Order, len(orders))
+
+	for i, o := range orders {
+		r, err := ConvertOrderToProto(o)
+		if err != nil {
+			return res, err
+		}
+		res[i] = r
+	}
+
+	return res, nil
+}
+
+func ConvertOrderFromProto(o *desc.Order) (*order.Order, error) {
+	res := &order.Order{}
+
+	res.ID = basetypes.ID(o.Id)
+	res.ClientID = basetypes.ID(o.ClientId)
+	res.PickupPointID = basetypes.ID(o.PickupPointId)
+
+	status, err := ConvertStatusFromProto(o.Status)
+	if err != nil {
+		return res, err
+	}
+	res.Status = status
+
+	res.StatusUpdated = o.StatusUpdated.AsTime()
+	res.Weight = uint(o.Weight)
+	res.Dimensions = ConvertDimensionsFromProto(o.Length, o.Width, o.Height)
+	res.Cost = uint(o.Cost)
+	res.BaseCost = uint(o.BaseCost)
+	res.ExpiresAt = ConvertTimestampFromProto(o.ExpiresAt)
+	res.Packaging = ConvertPackagingLayersFromProto(o.Packaging)
+	res.Version = o.Version
+
+	return res, nil
+}
+
+func ConvertOrderToProto(o *order.Order) (*desc.Order, error) {
+	res := &desc.Order{}
+
+	res.Id = uint64(o.ID)
+	res.ClientId = uint64(o.ClientID)
+	res.PickupPointId = uint64(o.PickupPointID)
+
+	status, err := ConvertStatusToProto(o.Status)
+	if err != nil {
+		return res, err
+	}
+	res.Status = status
+
+	res.StatusUpdated = timestamppb.New(o.StatusUpdated)
+	res.Weight = uint32(o.Weight)
+	res.Length = uint32(o.Length)
+	res.Width = uint32(o.Width)
+	res.Height = uint32(o.Height)
+	res.Cost = uint32(o.Cost)
+	res.BaseCost = uint32(o.BaseCost)
+	res.ExpiresAt = ConvertTimestampToProto(o.ExpiresAt)
+	res.Packaging = ConvertPackagingLayersToProto(o.Packaging)
+	res.Version = o.Version
+
+	return res, nil
+}
+
+func ConvertDimensionsFromProto(length, width, height uint32) order.Dimensions {
+	return order.Dimensions{Length: uint(length), Width: uint(width), Height: uint(height)}
+}
+
+func ConvertPackagingLayersFromProto(layers []*desc.PackagingLayer) []order.PackagingLayer {
+	if len(layers) == 0 {
+		return nil
+	}
+
+	res := make([]order.PackagingLayer, len(layers))
+	for i, l := range layers {
+		res[i] = order.PackagingLayer{Type: l.GetType(), Cost: uint(l.GetCost())}
+	}
+	return res
+}
+
+func ConvertPackagingLayersToProto(layers []order.PackagingLayer) []*desc.PackagingLayer {
+	res := make([]*desc.PackagingLayer, len(layers))
+	for i, l := range layers {
+		res[i] = &desc.PackagingLayer{Type: l.Type, Cost: uint32(l.Cost)}
+	}
+	return res
+}
+
+func ConvertTimestampFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	res := t.AsTime()
+	return &res
+}
+
+func ConvertTimestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func ConvertStatusFromProto(s desc.OrderStatus) (order.Status, error) {
+	switch s {
+	case desc.OrderStatus_ORDER_STATUS_RETURNED:
+		return order.Returned, nil
+	case desc.OrderStatus_ORDER_STATUS_STORED:
+		return order.Stored, nil
+	case desc.OrderStatus_ORDER_STATUS_REACHED_CLIENT:
+		return order.ReachedClient, nil
+	case desc.OrderStatus_ORDER_STATUS_CANCELED:
+		return order.Canceled, nil
+	case desc.OrderStatus_ORDER_STATUS_EXPIRED:
+		return order.Expired, nil
+	default:
+		return "", fmt.Errorf("unknown order status: %v", s)
+	}
+}
+
+func ConvertStatusToProto(s order.Status) (desc.OrderStatus, error) {
+	switch s {
+	case order.Canceled:
+		return desc.OrderStatus_ORDER_STATUS_CANCELED, nil
+	case order.ReachedClient:
+		return desc.OrderStatus_ORDER_STATUS_REACHED_CLIENT, nil
+	case order.Returned:
+		return desc.OrderStatus_ORDER_STATUS_RETURNED, nil
+	case order.Stored:
+		return desc.OrderStatus_ORDER_STATUS_STORED, nil
+	case order.Expired:
+		return desc.OrderStatus_ORDER_STATUS_EXPIRED, nil
+	default:
+		return desc.OrderStatus_ORDER_STATUS_UNSPECIFIED, fmt.Errorf("unknown order status: %v", s)
+	}
+}
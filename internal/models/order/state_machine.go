@@ -0,0 +1,141 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"time"
+)
+
+// New is the status of an order that has not been accepted yet.
+const New Status = ""
+
+var (
+	ErrTransitionNotAllowed = errors.New("transition is not allowed")
+	ErrStorageNotExpired    = errors.New("the storage period has not expired yet")
+	ErrStorageExpired       = errors.New("the storage period has expired")
+	ErrReturnExpired        = errors.New("the deadline for making a return has expired")
+	ErrWrongPickupPoint     = errors.New("order belongs to another Pick Up Point")
+	ErrWrongClient          = errors.New("order belongs to another client")
+)
+
+// TransitionError is returned when the state machine rejects a status transition.
+type TransitionError struct {
+	OrderID basetypes.ID
+	From    Status
+	To      Status
+	Reason  error
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("order[%v] can't be moved from %q to %q: %v", e.OrderID, e.From, e.To, e.Reason)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return e.Reason
+}
+
+// TransitionContext holds the data guards are checked against.
+type TransitionContext struct {
+	Now              time.Time
+	PickupPointID    basetypes.ID  // Pick Up Point performing the transition.
+	ClientID         basetypes.ID  // Client taking part in the transition, if any.
+	TimeToStore      time.Duration // Default duration to store an order.
+	TimeToMakeReturn time.Duration // Time window within which a customer can return an order.
+}
+
+// Guard checks a precondition of a transition and returns the reason to reject it.
+type Guard func(o *Order, c *TransitionContext) error
+
+// Transition declares an allowed status change and its preconditions.
+type Transition struct {
+	From   Status
+	To     Status
+	Guards []Guard
+}
+
+// Transitions are the status changes allowed for an order.
+var Transitions = []Transition{
+	{From: New, To: Stored},
+	{From: Stored, To: ReachedClient, Guards: []Guard{AtPickupPoint, OwnedByClient, StorageNotExpired}},
+	{From: Stored, To: Canceled, Guards: []Guard{StorageExpired}},
+	{From: Stored, To: Expired, Guards: []Guard{StorageExpired}},
+	{From: Expired, To: Canceled},
+	{From: ReachedClient, To: Returned, Guards: []Guard{AtPickupPoint, OwnedByClient, ReturnPeriodActive}},
+	{From: Returned, To: Canceled},
+}
+
+// StateMachine moves orders between statuses according to the declared transitions.
+type StateMachine struct {
+	transitions map[Status]map[Status][]Guard
+}
+
+func NewStateMachine(transitions []Transition) *StateMachine {
+	m := &StateMachine{transitions: make(map[Status]map[Status][]Guard)}
+	for _, t := range transitions {
+		if m.transitions[t.From] == nil {
+			m.transitions[t.From] = make(map[Status][]Guard)
+		}
+		m.transitions[t.From][t.To] = t.Guards
+	}
+	return m
+}
+
+// CanTransition returns a *TransitionError if the order can't be moved to the given status.
+func (m *StateMachine) CanTransition(o *Order, to Status, c *TransitionContext) error {
+	guards, ok := m.transitions[o.Status][to]
+	if !ok {
+		return &TransitionError{OrderID: o.ID, From: o.Status, To: to, Reason: ErrTransitionNotAllowed}
+	}
+
+	for _, guard := range guards {
+		if err := guard(o, c); err != nil {
+			return &TransitionError{OrderID: o.ID, From: o.Status, To: to, Reason: err}
+		}
+	}
+	return nil
+}
+
+// Transition moves the order to the given status if the transition is allowed.
+func (m *StateMachine) Transition(o *Order, to Status, c *TransitionContext) error {
+	if err := m.CanTransition(o, to, c); err != nil {
+		return err
+	}
+	o.SetStatus(to)
+	return nil
+}
+
+func AtPickupPoint(o *Order, c *TransitionContext) error {
+	if o.PickupPointID != c.PickupPointID {
+		return ErrWrongPickupPoint
+	}
+	return nil
+}
+
+func OwnedByClient(o *Order, c *TransitionContext) error {
+	if o.ClientID != c.ClientID {
+		return ErrWrongClient
+	}
+	return nil
+}
+
+func StorageExpired(o *Order, c *TransitionContext) error {
+	if !o.IsExpired(c.TimeToStore, c.Now) {
+		return ErrStorageNotExpired
+	}
+	return nil
+}
+
+func StorageNotExpired(o *Order, c *TransitionContext) error {
+	if o.IsExpired(c.TimeToStore, c.Now) {
+		return ErrStorageExpired
+	}
+	return nil
+}
+
+func ReturnPeriodActive(o *Order, c *TransitionContext) error {
+	if !o.CanBeReturned(c.TimeToMakeReturn, c.Now) {
+		return ErrReturnExpired
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package order
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateMachine_TransitionMatrix(t *testing.T) {
+	now := time.Now().UTC()
+	c := &TransitionContext{
+		Now:              now,
+		PickupPointID:    1,
+		ClientID:         1,
+		TimeToStore:      7 * 24 * time.Hour,
+		TimeToMakeReturn: 2 * 24 * time.Hour,
+	}
+
+	// statusUpdated lets the storage period expire for Stored orders while keeping the return period active.
+	statusUpdated := map[Status]time.Time{
+		New:           now,
+		Stored:        now.Add(-8 * 24 * time.Hour),
+		ReachedClient: now,
+		Returned:      now,
+		Canceled:      now,
+		Expired:       now,
+	}
+
+	allowed := map[Status][]Status{
+		New:           {Stored},
+		Stored:        {ReachedClient, Canceled, Expired},
+		ReachedClient: {Returned},
+		Returned:      {Canceled},
+		Canceled:      {},
+		Expired:       {Canceled},
+	}
+
+	statuses := []Status{New, Stored, ReachedClient, Returned, Canceled, Expired}
+	m := NewStateMachine(Transitions)
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(string(from)+"->"+string(to), func(t *testing.T) {
+				o := &Order{ID: 1, ClientID: 1, PickupPointID: 1, Status: from, StatusUpdated: statusUpdated[from]}
+				if to == ReachedClient {
+					o.StatusUpdated = now // only orders within the storage period are issued
+				}
+
+				err := m.Transition(o, to, c)
+
+				if slices.Contains(allowed[from], to) {
+					assert.NoError(t, err)
+					assert.Equal(t, to, o.Status)
+				} else {
+					assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+					assert.Equal(t, from, o.Status)
+				}
+			})
+		}
+	}
+}
+
+func TestStateMachine_Guards(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name    string
+		order   Order
+		to      Status
+		context TransitionContext
+		wantErr error
+	}{
+		{
+			"IssueFromAnotherPickupPoint",
+			Order{Status: Stored, ClientID: 1, PickupPointID: 2},
+			ReachedClient,
+			TransitionContext{Now: now, PickupPointID: 1, ClientID: 1},
+			ErrWrongPickupPoint,
+		},
+		{
+			"IssueToAnotherClient",
+			Order{Status: Stored, ClientID: 2, PickupPointID: 1},
+			ReachedClient,
+			TransitionContext{Now: now, PickupPointID: 1, ClientID: 1},
+			ErrWrongClient,
+		},
+		{
+			"IssueAfterStorageExpired",
+			Order{Status: Stored, ClientID: 1, PickupPointID: 1, StatusUpdated: now.Add(-2 * time.Hour)},
+			ReachedClient,
+			TransitionContext{Now: now, PickupPointID: 1, ClientID: 1, TimeToStore: time.Hour},
+			ErrStorageExpired,
+		},
+		{
+			"CancelBeforeStorageExpired",
+			Order{Status: Stored, StatusUpdated: now},
+			Canceled,
+			TransitionContext{Now: now, TimeToStore: time.Hour},
+			ErrStorageNotExpired,
+		},
+		{
+			"CancelBeforeOwnDeadline",
+			Order{Status: Stored, StatusUpdated: now.Add(-2 * time.Hour), ExpiresAt: ptr(now.Add(time.Hour))},
+			Canceled,
+			TransitionContext{Now: now, TimeToStore: time.Hour},
+			ErrStorageNotExpired,
+		},
+		{
+			"ExpireBeforeStorageExpired",
+			Order{Status: Stored, StatusUpdated: now},
+			Expired,
+			TransitionContext{Now: now, TimeToStore: time.Hour},
+			ErrStorageNotExpired,
+		},
+		{
+			"ReturnAfterDeadline",
+			Order{Status: ReachedClient, ClientID: 1, PickupPointID: 1, StatusUpdated: now.Add(-3 * time.Hour)},
+			Returned,
+			TransitionContext{Now: now, PickupPointID: 1, ClientID: 1, TimeToMakeReturn: time.Hour},
+			ErrReturnExpired,
+		},
+		{
+			"ReturnByAnotherClient",
+			Order{Status: ReachedClient, ClientID: 2, PickupPointID: 1, StatusUpdated: now},
+			Returned,
+			TransitionContext{Now: now, PickupPointID: 1, ClientID: 1, TimeToMakeReturn: time.Hour},
+			ErrWrongClient,
+		},
+		{
+			"ReturnToAnotherPickupPoint",
+			Order{Status: ReachedClient, ClientID: 1, PickupPointID: 2, StatusUpdated: now},
+			Returned,
+			TransitionContext{Now: now, PickupPointID: 1, ClientID: 1, TimeToMakeReturn: time.Hour},
+			ErrWrongPickupPoint,
+		},
+	}
+
+	m := NewStateMachine(Transitions)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := tt.order
+			err := m.Transition(&o, tt.to, &tt.context)
+
+			var transitionErr *TransitionError
+			assert.ErrorAs(t, err, &transitionErr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.order.Status, o.Status)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -7,4 +7,13 @@ const (
 	ReachedClient Status = "reached-client"
 	Returned      Status = "returned"
 	Canceled      Status = "canceled"
+	Expired       Status = "expired" // storage period is over, the order waits for the courier
 )
+
+func (s Status) Valid() bool {
+	switch s {
+	case Stored, ReachedClient, Returned, Canceled, Expired:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+package outbox
+
+import "time"
+
+// Message is an event stored in the outbox table until it is published to the message broker.
+type Message struct {
+	ID            uint64     `db:"id"`
+	Key           string     `db:"key"`
+	Payload       []byte     `db:"payload"`
+	CreatedAt     time.Time  `db:"created_at"`
+	SentAt        *time.Time `db:"sent_at"`
+	Attempts      int        `db:"attempts"`        // Number of failed send attempts.
+	NextAttemptAt time.Time  `db:"next_attempt_at"` // The message isn't sent before this time.
+	DeadAt        *time.Time `db:"dead_at"`         // Set when the message is given up on.
+}
@@ -0,0 +1,33 @@
+package pickuppoint
+
+import (
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+)
+
+type PickupPoint struct {
+	ID           basetypes.ID `db:"id"`
+	Name         string       `db:"name"`
+	Address      string       `db:"address"`
+	WorkingHours string       `db:"working_hours"`
+	MaxOrders    uint         `db:"max_orders"` // 0 means no limit
+	MaxWeight    uint         `db:"max_weight"` // 0 means no limit
+	Occupancy    Occupancy    `db:"-"`
+}
+
+// Occupancy is the load of a pickup point by the orders kept at it, stored and expired ones.
+type Occupancy struct {
+	Orders uint `db:"orders"`
+	Weight uint `db:"weight"`
+}
+
+// CanStore reports whether one more order of the given weight fits into the pickup point
+// in addition to the current occupancy.
+func (p *PickupPoint) CanStore(current Occupancy, weight uint) bool {
+	if p.MaxOrders != 0 && current.Orders+1 > p.MaxOrders {
+		return false
+	}
+	if p.MaxWeight != 0 && current.Weight+weight > p.MaxWeight {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,30 @@
+package pickuppoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickupPoint_CanStore(t *testing.T) {
+	tests := []struct {
+		name      string
+		point     PickupPoint
+		occupancy Occupancy
+		weight    uint
+		want      bool
+	}{
+		{"Unlimited", PickupPoint{}, Occupancy{Orders: 1000, Weight: 1000}, 1000, true},
+		{"FitsBoth", PickupPoint{MaxOrders: 2, MaxWeight: 10}, Occupancy{Orders: 1, Weight: 5}, 5, true},
+		{"TooManyOrders", PickupPoint{MaxOrders: 2, MaxWeight: 10}, Occupancy{Orders: 2, Weight: 0}, 1, false},
+		{"TooHeavy", PickupPoint{MaxOrders: 2, MaxWeight: 10}, Occupancy{Orders: 0, Weight: 6}, 5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.point.CanStore(tt.occupancy, tt.weight))
+		})
+	}
+}
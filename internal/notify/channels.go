@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+)
+
+// LogNotifier writes messages to the standard logger.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(_ context.Context, msg *Message) error {
+	log.Printf("notification to client %d: %s: %s", msg.ClientID, msg.Subject, msg.Body)
+	return nil
+}
+
+// WriterNotifier writes messages to w, one per line.
+type WriterNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+// NewFileNotifier returns a WriterNotifier appending messages to the file at path.
+func NewFileNotifier(path string) (*WriterNotifier, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterNotifier(f), nil
+}
+
+func (n *WriterNotifier) Notify(_ context.Context, msg *Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, err := fmt.Fprintf(n.w, "client %d: %s: %s\n", msg.ClientID, msg.Subject, msg.Body)
+	return err
+}
+
+// SMTPNotifier sends messages by email through an SMTP server without authentication,
+// such as a local mail catcher. Clients have no addresses yet, so they are built from client IDs.
+type SMTPNotifier struct {
+	addr   string // host:port of the server
+	from   string
+	domain string // domain of the client addresses
+}
+
+func NewSMTPNotifier(addr, from, domain string) *SMTPNotifier {
+	return &SMTPNotifier{addr: addr, from: from, domain: domain}
+}
+
+func (n *SMTPNotifier) Notify(_ context.Context, msg *Message) error {
+	to := fmt.Sprintf("client-%d@%s", msg.ClientID, n.domain)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(msg.Body)
+	b.WriteString("\r\n")
+
+	return smtp.SendMail(n.addr, nil, n.from, []string{to}, []byte(b.String()))
+}
@@ -0,0 +1,114 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+// Dispatcher periodically delivers pending notifications.
+// Only the replica holding the lock delivers, so clients don't get a message once per replica.
+// A failed notification is retried with exponential backoff until maxAttempts attempts fail.
+// Delivery is at-least-once: a notification may be resent if marking it as sent fails.
+type Dispatcher struct {
+	storage      Storage
+	notifier     Notifier
+	lock         Lock
+	templates    Templates
+	interval     time.Duration // Pause between dispatch passes.
+	batchSize    int           // Maximum number of notifications fetched per pass.
+	maxAttempts  uint          // Attempts after which a notification is marked as failed.
+	retryBackoff time.Duration // Pause before the first retry, doubled after each failure.
+}
+
+func NewDispatcher(storage Storage, notifier Notifier, lock Lock, interval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		storage:      storage,
+		notifier:     notifier,
+		lock:         lock,
+		templates:    DefaultTemplates(),
+		interval:     interval,
+		batchSize:    100,
+		maxAttempts:  5,
+		retryBackoff: time.Minute,
+	}
+}
+
+// Run delivers pending notifications until ctx is done and releases the lock afterwards.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	defer func() {
+		if err := d.lock.Unlock(context.Background()); err != nil {
+			log.Printf("Failed to release the notification dispatcher lock: %v", err)
+		}
+	}()
+
+	for {
+		if err := d.DeliverPending(ctx); err != nil {
+			log.Printf("Failed to deliver notifications: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverPending makes a single pass over the notifications due for delivery if the lock is held by this replica.
+func (d *Dispatcher) DeliverPending(ctx context.Context) error {
+	leader, err := d.lock.TryLock(ctx)
+	if err != nil || !leader {
+		return err
+	}
+
+	notifications, err := d.storage.GetPendingNotifications(ctx, d.batchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range notifications {
+		if n.Kind == KindExpiryReminder && n.OrderStatus != order.Stored {
+			if err = d.storage.CancelNotification(ctx, n.ID, "the order is no longer stored"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = d.deliver(ctx, n); err != nil {
+			log.Printf("Failed to deliver notification %d: %v", n.ID, err)
+			if err = d.storage.MarkNotificationFailed(ctx, n.ID, d.retryAt(n, err), err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = d.storage.MarkNotificationSent(ctx, n.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, n *Notification) error {
+	msg, err := d.templates.Render(n)
+	if err != nil {
+		return err
+	}
+	return d.notifier.Notify(ctx, msg)
+}
+
+// retryAt returns the time of the next attempt to deliver n or nil if it shouldn't be retried.
+func (d *Dispatcher) retryAt(n *Notification, err error) *time.Time {
+	if errors.Is(err, ErrNoTemplate) || n.Attempts+1 >= d.maxAttempts {
+		return nil
+	}
+
+	at := time.Now().Add(d.retryBackoff << n.Attempts)
+	return &at
+}
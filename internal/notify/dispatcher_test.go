@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+type memoryStorage struct {
+	pending   []*Notification
+	sent      []uint64
+	failed    map[uint64]*time.Time
+	cancelled []uint64
+}
+
+func (s *memoryStorage) GetPendingNotifications(_ context.Context, limit int) ([]*Notification, error) {
+	return s.pending[:min(limit, len(s.pending))], nil
+}
+
+func (s *memoryStorage) MarkNotificationSent(_ context.Context, id uint64) error {
+	s.sent = append(s.sent, id)
+	return nil
+}
+
+func (s *memoryStorage) MarkNotificationFailed(_ context.Context, id uint64, retryAt *time.Time, _ string) error {
+	s.failed[id] = retryAt
+	return nil
+}
+
+func (s *memoryStorage) CancelNotification(_ context.Context, id uint64, _ string) error {
+	s.cancelled = append(s.cancelled, id)
+	return nil
+}
+
+func (s *memoryStorage) ScheduleExpiryReminders(context.Context, time.Duration, time.Time) (int, error) {
+	return 0, nil
+}
+
+type fakeLock struct {
+	held bool
+}
+
+func (l *fakeLock) TryLock(context.Context) (bool, error) {
+	return l.held, nil
+}
+
+func (l *fakeLock) Unlock(context.Context) error {
+	l.held = false
+	return nil
+}
+
+type failingNotifier struct {
+	Notifier
+	failClient uint64
+}
+
+func (n *failingNotifier) Notify(ctx context.Context, msg *Message) error {
+	if uint64(msg.ClientID) == n.failClient {
+		return errors.New("mail server is unavailable")
+	}
+	return n.Notifier.Notify(ctx, msg)
+}
+
+func TestDispatcher_DeliverPending(t *testing.T) {
+	storage := &memoryStorage{
+		pending: []*Notification{
+			{ID: 1, OrderID: 10, ClientID: 1, Kind: KindAccept, OrderStatus: order.Stored},
+			{ID: 2, OrderID: 11, ClientID: 2, Kind: KindAccept, OrderStatus: order.Stored},
+			{ID: 3, OrderID: 12, ClientID: 2, Kind: KindAccept, Attempts: 4, OrderStatus: order.Stored},
+			{ID: 4, OrderID: 13, ClientID: 1, Kind: KindExpiryReminder, OrderStatus: order.ReachedClient},
+			{ID: 5, OrderID: 14, ClientID: 1, Kind: "unknown", OrderStatus: order.Stored},
+			{ID: 6, OrderID: 15, ClientID: 1, Kind: KindExpiryReminder, OrderStatus: order.Stored},
+		},
+		failed: make(map[uint64]*time.Time),
+	}
+	out := new(bytes.Buffer)
+	d := NewDispatcher(storage, &failingNotifier{Notifier: NewWriterNotifier(out), failClient: 2}, &fakeLock{held: true}, 0)
+
+	assert.NoError(t, d.DeliverPending(context.Background()))
+
+	assert.Equal(t, []uint64{1, 6}, storage.sent)
+	assert.Equal(t, []uint64{4}, storage.cancelled)
+	assert.Len(t, storage.failed, 3)
+	assert.NotNil(t, storage.failed[2])
+	assert.Nil(t, storage.failed[3])
+	assert.Nil(t, storage.failed[5])
+
+	assert.Contains(t, out.String(), "Order 10 has arrived")
+	assert.Contains(t, out.String(), "Order 15 expires soon")
+}
+
+func TestDispatcher_DeliverPendingFollower(t *testing.T) {
+	storage := &memoryStorage{
+		pending: []*Notification{{ID: 1, OrderID: 10, ClientID: 1, Kind: KindAccept, OrderStatus: order.Stored}},
+		failed:  make(map[uint64]*time.Time),
+	}
+	out := new(bytes.Buffer)
+	d := NewDispatcher(storage, NewWriterNotifier(out), &fakeLock{}, 0)
+
+	assert.NoError(t, d.DeliverPending(context.Background()))
+
+	assert.Empty(t, storage.sent)
+	assert.Empty(t, out.String())
+}
+
+func TestTemplates_Render(t *testing.T) {
+	expiresAt := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	msg, err := DefaultTemplates().Render(&Notification{OrderID: 1, ClientID: 2, PickupPointID: 3, Kind: KindAccept, ExpiresAt: &expiresAt})
+	assert.NoError(t, err)
+	assert.Equal(t, "Order 1 has arrived", msg.Subject)
+	assert.Equal(t, "Your order 1 is waiting for you at pickup point 3. It will be kept until 20.10.2024.", msg.Body)
+
+	msg, err = DefaultTemplates().Render(&Notification{OrderID: 1, ClientID: 2, PickupPointID: 3, Kind: KindAccept})
+	assert.NoError(t, err)
+	assert.Equal(t, "Your order 1 is waiting for you at pickup point 3.", msg.Body)
+
+	_, err = DefaultTemplates().Render(&Notification{Kind: "unknown"})
+	assert.ErrorIs(t, err, ErrNoTemplate)
+}
@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+// Kinds of notifications. The event kinds match the operations of order.Event.
+const (
+	KindAccept         = "accept"
+	KindIssue          = "issue"
+	KindReturn         = "return"
+	KindExpire         = "expire"
+	KindHandover       = "handover"
+	KindExpiryReminder = "expiry_reminder"
+)
+
+// Delivery statuses of notifications.
+const (
+	StatusPending   = "pending"
+	StatusSent      = "sent"
+	StatusFailed    = "failed"    // all attempts failed
+	StatusCancelled = "cancelled" // no longer relevant, e.g. a reminder for an issued order
+)
+
+// Notification is a message to a client about one of their orders waiting for delivery.
+type Notification struct {
+	ID            uint64       `db:"id"`
+	OrderID       basetypes.ID `db:"order_id"`
+	ClientID      basetypes.ID `db:"client_id"`
+	PickupPointID basetypes.ID `db:"pickup_point_id"`
+	Kind          string       `db:"kind"`
+	ExpiresAt     *time.Time   `db:"expires_at"` // storage deadline of the order, nil if not known
+	Attempts      uint         `db:"attempts"`   // failed delivery attempts
+	OrderStatus   order.Status `db:"order_status"`
+}
+
+// Message is a rendered notification.
+type Message struct {
+	ClientID basetypes.ID
+	Subject  string
+	Body     string
+}
+
+// Notifier delivers messages to clients over some channel.
+type Notifier interface {
+	Notify(ctx context.Context, msg *Message) error
+}
+
+// Lock elects the replica that runs the dispatcher or the scheduler.
+type Lock interface {
+	// TryLock acquires the lock if it is free and reports whether it is held by the caller.
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// Storage defines the interface for the notifications delivery table.
+// Notifications of order events are added together with the events.
+type Storage interface {
+	GetPendingNotifications(ctx context.Context, limit int) ([]*Notification, error)
+	MarkNotificationSent(ctx context.Context, id uint64) error
+	// MarkNotificationFailed records a failed attempt. The notification is retried at retryAt or,
+	// if retryAt is nil, is given up on.
+	MarkNotificationFailed(ctx context.Context, id uint64, retryAt *time.Time, reason string) error
+	CancelNotification(ctx context.Context, id uint64, reason string) error
+	// ScheduleExpiryReminders adds a reminder for every stored order whose storage deadline is between now and before.
+	// The deadline of orders without their own one is the status update time plus timeToStore.
+	// An order gets at most one reminder. It returns the number of added reminders.
+	ScheduleExpiryReminders(ctx context.Context, timeToStore time.Duration, before time.Time) (int, error)
+}
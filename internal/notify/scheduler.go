@@ -0,0 +1,60 @@
+package notify
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Scheduler periodically schedules reminders for orders whose storage deadline is near.
+// Only the replica holding the lock schedules.
+type Scheduler struct {
+	storage     Storage
+	lock        Lock
+	timeToStore time.Duration // Default duration to store an order, as in the order service.
+	notice      time.Duration // How long before the deadline clients are reminded.
+	interval    time.Duration // Pause between scheduling passes.
+}
+
+func NewScheduler(storage Storage, lock Lock, timeToStore, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		storage:     storage,
+		lock:        lock,
+		timeToStore: timeToStore,
+		notice:      24 * time.Hour,
+		interval:    interval,
+	}
+}
+
+// Run schedules reminders until ctx is done and releases the lock afterwards.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	defer func() {
+		if err := s.lock.Unlock(context.Background()); err != nil {
+			log.Printf("Failed to release the reminder scheduler lock: %v", err)
+		}
+	}()
+
+	for {
+		if _, err := s.ScheduleReminders(ctx); err != nil {
+			log.Printf("Failed to schedule expiry reminders: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScheduleReminders makes a single scheduling pass if the lock is held by this replica
+// and returns the number of scheduled reminders.
+func (s *Scheduler) ScheduleReminders(ctx context.Context) (int, error) {
+	leader, err := s.lock.TryLock(ctx)
+	if err != nil || !leader {
+		return 0, err
+	}
+	return s.storage.ScheduleExpiryReminders(ctx, s.timeToStore, time.Now().Add(s.notice))
+}
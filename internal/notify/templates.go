@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var ErrNoTemplate = errors.New("no template for the notification kind")
+
+// Template renders notifications of one kind. Both parts are executed with the Notification as data.
+type Template struct {
+	Subject *template.Template
+	Body    *template.Template
+}
+
+// NewTemplate parses the subject and the body of a template, it panics if they are invalid.
+func NewTemplate(subject, body string) *Template {
+	return &Template{
+		Subject: template.Must(template.New("subject").Funcs(funcs).Parse(subject)),
+		Body:    template.Must(template.New("body").Funcs(funcs).Parse(body)),
+	}
+}
+
+var funcs = template.FuncMap{
+	"date": func(t time.Time) string { return t.Format("02.01.2006") },
+}
+
+// Templates maps notification kinds to their templates.
+type Templates map[string]*Template
+
+// DefaultTemplates returns the templates of all the notification kinds.
+func DefaultTemplates() Templates {
+	return Templates{
+		KindAccept: NewTemplate(
+			"Order {{.OrderID}} has arrived",
+			"Your order {{.OrderID}} is waiting for you at pickup point {{.PickupPointID}}."+
+				"{{with .ExpiresAt}} It will be kept until {{date .}}.{{end}}",
+		),
+		KindIssue: NewTemplate(
+			"Order {{.OrderID}} received",
+			"You have received order {{.OrderID}} at pickup point {{.PickupPointID}}. Thank you!",
+		),
+		KindReturn: NewTemplate(
+			"Return of order {{.OrderID}} accepted",
+			"Your return of order {{.OrderID}} has been accepted at pickup point {{.PickupPointID}}.",
+		),
+		KindExpire: NewTemplate(
+			"Order {{.OrderID}} expired",
+			"The storage period of your order {{.OrderID}} at pickup point {{.PickupPointID}} is over, "+
+				"it will be returned to the sender.",
+		),
+		KindHandover: NewTemplate(
+			"Order {{.OrderID}} sent back",
+			"Your order {{.OrderID}} has been handed over to the courier at pickup point {{.PickupPointID}} "+
+				"and is on its way back to the sender.",
+		),
+		KindExpiryReminder: NewTemplate(
+			"Order {{.OrderID}} expires soon",
+			"Your order {{.OrderID}} at pickup point {{.PickupPointID}} will be returned to the sender"+
+				"{{with .ExpiresAt}} after {{date .}}{{else}} soon{{end}}. Please pick it up.",
+		),
+	}
+}
+
+// Render returns the message of the notification.
+func (t Templates) Render(n *Notification) (*Message, error) {
+	tmpl, ok := t[n.Kind]
+	if !ok {
+		return nil, ErrNoTemplate
+	}
+
+	var subject, body strings.Builder
+	if err := tmpl.Subject.Execute(&subject, n); err != nil {
+		return nil, err
+	}
+	if err := tmpl.Body.Execute(&body, n); err != nil {
+		return nil, err
+	}
+
+	return &Message{ClientID: n.ClientID, Subject: subject.String(), Body: body.String()}, nil
+}
@@ -2,16 +2,35 @@ package order
 
 import (
 	"errors"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/verification"
 )
 
 var (
 	ErrOrderNotFound            = errors.New("order not found")
 	ErrOrderExists              = errors.New("order already exists")
 	ErrOrderNotIssued           = errors.New("order is not issued")
-	ErrWrongPickupPoint         = errors.New("order was issued from another Pick Up Point")
-	ErrWrongClientID            = errors.New("wrong clientID")
-	ErrReturnExpired            = errors.New("the deadline for making a return has expired")
+	ErrPickupPointNotFound      = errors.New("pickup point not found")
+	ErrPickupPointExists        = errors.New("pickup point already exists")
+	ErrPickupPointFull          = errors.New("pickup point capacity exceeded")
+	ErrWrongPickupPoint         = order.ErrWrongPickupPoint
+	ErrWrongClientID            = order.ErrWrongClient
+	ErrReturnExpired            = order.ErrReturnExpired
+	ErrExpiresInPast            = errors.New("storage deadline must be in the future")
+	ErrIssueRejected            = errors.New("some orders can't be issued, none were issued")
+	ErrInvalidPickupCode        = verification.ErrInvalidPickupCode
+	ErrPickupCodeLocked         = verification.ErrPickupCodeLocked
 	ErrCantCancel               = errors.New("order cannot be cancelled")
+	ErrConcurrentModification   = errors.New("order was modified concurrently, try again")
+	ErrCantHandOver             = errors.New("order can't be handed over to the courier")
+	ErrNothingToHandOver        = errors.New("no orders to hand over")
+	ErrHandoverNotFound         = errors.New("handover not found")
+	ErrInvalidPageToken         = order.ErrInvalidPageToken
+	ErrInvalidSortField         = order.ErrInvalidSortField
+	ErrPackagingNotFound        = errors.New("packaging type not found")
+	ErrPackagingLimitExceeded   = order.ErrPackagingLimitExceeded
+	ErrNoSuitablePackaging      = errors.New("no suitable packaging found")
+	ErrPackagingInUse           = errors.New("packaging type is wrapped by another type")
 	ErrNoPrimaryPack            = errors.New("you need to provide primary packaging to use additional packaging")
 	ErrAdditionalPackNotAllowed = errors.New("you can't add additional packaging to that primary packaging")
 )
@@ -4,22 +4,91 @@ import (
 	"context"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/pickuppoint"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"time"
 )
 
 type BasicRepository interface {
+	// Get returns the order or ErrOrderNotFound if there is no such order or it's deleted.
 	Get(context.Context, basetypes.ID) (*order.Order, error)
-	Delete(context.Context, basetypes.ID) error
-	AddOrUpdate(context.Context, *order.Order) (exists bool, err error)
-	AddOrUpdateList(context.Context, []*order.Order) error
+	// Delete marks the order as deleted and returns it, so that the caller can invalidate its cached copies.
+	Delete(context.Context, basetypes.ID) (*order.Order, error)
+	// AddOrUpdate stores the order and puts the given events into the outbox within the same transaction.
+	// An order without a version is added unless its ID is taken, otherwise it's updated like Update.
+	AddOrUpdate(context.Context, *order.Order, ...order.Event) (exists bool, err error)
+	// Update stores the changed status and storage deadline of the order together with the events
+	// if the order still has the version it was read with, and increments the version of o.
+	// It fails with ErrConcurrentModification if the order was modified since then.
+	Update(context.Context, *order.Order, ...order.Event) error
+	// AddOrUpdateList stores the orders and puts the given events into the outbox within the same transaction.
+	AddOrUpdateList(context.Context, []*order.Order, ...order.Event) error
+	// UpdateList locks the orders with the given IDs and stores the ones update returns together with its events,
+	// all within one serializable transaction. Missing IDs are left out of the orders passed to update.
+	// Nothing is stored if update fails. The transaction is retried if it conflicts with concurrent ones,
+	// so update may be called again, and it fails with ErrConcurrentModification if the conflicts persist.
+	UpdateList(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error
+	// IssueList works like UpdateList but first calls redeem with the pickup codes of the same transaction,
+	// which lock the codes they read. If redeem fails, update isn't called, the transaction is committed
+	// to keep the failed attempts redeem recorded and the error of redeem is returned.
+	IssueList(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error
 }
 
 type RepositoryWithFilters interface {
+	// GetBy returns the orders matching the filter ordered by the status update time and then by ID.
 	GetBy(context.Context, *order.Filter) ([]*order.Order, error)
-	GetByPaginated(ctx context.Context, filter *order.Filter, offset uint, limit int) ([]*order.Order, error)
-	DeleteBy(context.Context, *order.Filter) error
+	// GetPage returns up to limit orders matching the filter which follow the cursor after, sorted by the keys
+	// followed by ID, or in the order of GetBy without keys. A nil cursor starts from the first order
+	// and a non-positive limit returns all of them.
+	GetPage(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) ([]*order.Order, error)
+	// DeleteBy marks the orders matching the filter as deleted like Delete and returns them.
+	DeleteBy(context.Context, *order.Filter) ([]*order.Order, error)
+	// GetExpiredIDs returns IDs of stored orders whose storage deadline is before now, the oldest deadlines first.
+	// The deadline of orders without their own one is the status update time plus timeToStore.
+	GetExpiredIDs(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) ([]basetypes.ID, error)
+}
+
+type HistoryRepository interface {
+	// GetHistory returns status changes of the order from the oldest to the newest.
+	// An order without any, including an archived one, has an empty history,
+	// and a missing order fails with ErrOrderNotFound.
+	GetHistory(context.Context, basetypes.ID) ([]*order.StatusChange, error)
+}
+
+type PickupPointRepository interface {
+	// GetPickupPoint returns the pickup point with its current occupancy.
+	GetPickupPoint(context.Context, basetypes.ID) (*pickuppoint.PickupPoint, error)
+	// PickupPointExists reports whether the pickup point is registered without computing its occupancy.
+	PickupPointExists(context.Context, basetypes.ID) (bool, error)
+	// ListPickupPoints returns all pickup points with their current occupancy.
+	ListPickupPoints(context.Context) ([]*pickuppoint.PickupPoint, error)
+	CreatePickupPoint(context.Context, *pickuppoint.PickupPoint) error
+	UpdatePickupPoint(context.Context, *pickuppoint.PickupPoint) error
+	// AddOrUpdateWithinCapacity works like AddOrUpdate but fails with ErrPickupPointFull
+	// if the order doesn't fit into its pickup point. A new order whose ID is taken is reported
+	// as existing before the capacity is checked.
+	AddOrUpdateWithinCapacity(context.Context, *order.Order, ...order.Event) (exists bool, err error)
+}
+
+type PackagingRepository interface {
+	GetPackagingType(ctx context.Context, name string) (*order.PackagingType, error)
+	ListPackagingTypes(context.Context) ([]*order.PackagingType, error)
+	AddOrUpdatePackagingType(context.Context, *order.PackagingType) (exists bool, err error)
+	DeletePackagingType(ctx context.Context, name string) error
+}
+
+type HandoverRepository interface {
+	// AddHandover works like UpdateList for h.OrderIDs and records the handover of the orders update returns
+	// within the same transaction. It sets h.ID and replaces h.OrderIDs with the IDs of the handed over orders.
+	AddHandover(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error
+	GetHandover(ctx context.Context, id uint64) (*order.Handover, error)
 }
 
 type Repository interface {
 	BasicRepository
 	RepositoryWithFilters
+	HistoryRepository
+	PickupPointRepository
+	PackagingRepository
+	HandoverRepository
 }
@@ -8,11 +8,14 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/pickuppoint"
+	"github.com/vlad1028/order-manager/internal/verification"
 )
 
 // OrderRepositoryMock implements mm_order.Repository
@@ -20,34 +23,69 @@ type OrderRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddOrUpdate          func(ctx context.Context, op1 *order.Order) (exists bool, err error)
+	funcAddHandover          func(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error)
+	funcAddHandoverOrigin    string
+	inspectFuncAddHandover   func(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error))
+	afterAddHandoverCounter  uint64
+	beforeAddHandoverCounter uint64
+	AddHandoverMock          mOrderRepositoryMockAddHandover
+
+	funcAddOrUpdate          func(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (exists bool, err error)
 	funcAddOrUpdateOrigin    string
-	inspectFuncAddOrUpdate   func(ctx context.Context, op1 *order.Order)
+	inspectFuncAddOrUpdate   func(ctx context.Context, op1 *order.Order, ea1 ...order.Event)
 	afterAddOrUpdateCounter  uint64
 	beforeAddOrUpdateCounter uint64
 	AddOrUpdateMock          mOrderRepositoryMockAddOrUpdate
 
-	funcAddOrUpdateList          func(ctx context.Context, opa1 []*order.Order) (err error)
+	funcAddOrUpdateList          func(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event) (err error)
 	funcAddOrUpdateListOrigin    string
-	inspectFuncAddOrUpdateList   func(ctx context.Context, opa1 []*order.Order)
+	inspectFuncAddOrUpdateList   func(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event)
 	afterAddOrUpdateListCounter  uint64
 	beforeAddOrUpdateListCounter uint64
 	AddOrUpdateListMock          mOrderRepositoryMockAddOrUpdateList
 
-	funcDelete          func(ctx context.Context, i1 basetypes.ID) (err error)
+	funcAddOrUpdatePackagingType          func(ctx context.Context, pp1 *order.PackagingType) (exists bool, err error)
+	funcAddOrUpdatePackagingTypeOrigin    string
+	inspectFuncAddOrUpdatePackagingType   func(ctx context.Context, pp1 *order.PackagingType)
+	afterAddOrUpdatePackagingTypeCounter  uint64
+	beforeAddOrUpdatePackagingTypeCounter uint64
+	AddOrUpdatePackagingTypeMock          mOrderRepositoryMockAddOrUpdatePackagingType
+
+	funcAddOrUpdateWithinCapacity          func(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (exists bool, err error)
+	funcAddOrUpdateWithinCapacityOrigin    string
+	inspectFuncAddOrUpdateWithinCapacity   func(ctx context.Context, op1 *order.Order, ea1 ...order.Event)
+	afterAddOrUpdateWithinCapacityCounter  uint64
+	beforeAddOrUpdateWithinCapacityCounter uint64
+	AddOrUpdateWithinCapacityMock          mOrderRepositoryMockAddOrUpdateWithinCapacity
+
+	funcCreatePickupPoint          func(ctx context.Context, pp1 *pickuppoint.PickupPoint) (err error)
+	funcCreatePickupPointOrigin    string
+	inspectFuncCreatePickupPoint   func(ctx context.Context, pp1 *pickuppoint.PickupPoint)
+	afterCreatePickupPointCounter  uint64
+	beforeCreatePickupPointCounter uint64
+	CreatePickupPointMock          mOrderRepositoryMockCreatePickupPoint
+
+	funcDelete          func(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error)
 	funcDeleteOrigin    string
 	inspectFuncDelete   func(ctx context.Context, i1 basetypes.ID)
 	afterDeleteCounter  uint64
 	beforeDeleteCounter uint64
 	DeleteMock          mOrderRepositoryMockDelete
 
-	funcDeleteBy          func(ctx context.Context, fp1 *order.Filter) (err error)
+	funcDeleteBy          func(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error)
 	funcDeleteByOrigin    string
 	inspectFuncDeleteBy   func(ctx context.Context, fp1 *order.Filter)
 	afterDeleteByCounter  uint64
 	beforeDeleteByCounter uint64
 	DeleteByMock          mOrderRepositoryMockDeleteBy
 
+	funcDeletePackagingType          func(ctx context.Context, name string) (err error)
+	funcDeletePackagingTypeOrigin    string
+	inspectFuncDeletePackagingType   func(ctx context.Context, name string)
+	afterDeletePackagingTypeCounter  uint64
+	beforeDeletePackagingTypeCounter uint64
+	DeletePackagingTypeMock          mOrderRepositoryMockDeletePackagingType
+
 	funcGet          func(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error)
 	funcGetOrigin    string
 	inspectFuncGet   func(ctx context.Context, i1 basetypes.ID)
@@ -62,12 +100,96 @@ type OrderRepositoryMock struct {
 	beforeGetByCounter uint64
 	GetByMock          mOrderRepositoryMockGetBy
 
-	funcGetByPaginated          func(ctx context.Context, filter *order.Filter, offset uint, limit int) (opa1 []*order.Order, err error)
-	funcGetByPaginatedOrigin    string
-	inspectFuncGetByPaginated   func(ctx context.Context, filter *order.Filter, offset uint, limit int)
-	afterGetByPaginatedCounter  uint64
-	beforeGetByPaginatedCounter uint64
-	GetByPaginatedMock          mOrderRepositoryMockGetByPaginated
+	funcGetExpiredIDs          func(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) (ia1 []basetypes.ID, err error)
+	funcGetExpiredIDsOrigin    string
+	inspectFuncGetExpiredIDs   func(ctx context.Context, timeToStore time.Duration, now time.Time, limit int)
+	afterGetExpiredIDsCounter  uint64
+	beforeGetExpiredIDsCounter uint64
+	GetExpiredIDsMock          mOrderRepositoryMockGetExpiredIDs
+
+	funcGetHandover          func(ctx context.Context, id uint64) (hp1 *order.Handover, err error)
+	funcGetHandoverOrigin    string
+	inspectFuncGetHandover   func(ctx context.Context, id uint64)
+	afterGetHandoverCounter  uint64
+	beforeGetHandoverCounter uint64
+	GetHandoverMock          mOrderRepositoryMockGetHandover
+
+	funcGetHistory          func(ctx context.Context, i1 basetypes.ID) (spa1 []*order.StatusChange, err error)
+	funcGetHistoryOrigin    string
+	inspectFuncGetHistory   func(ctx context.Context, i1 basetypes.ID)
+	afterGetHistoryCounter  uint64
+	beforeGetHistoryCounter uint64
+	GetHistoryMock          mOrderRepositoryMockGetHistory
+
+	funcGetPackagingType          func(ctx context.Context, name string) (pp1 *order.PackagingType, err error)
+	funcGetPackagingTypeOrigin    string
+	inspectFuncGetPackagingType   func(ctx context.Context, name string)
+	afterGetPackagingTypeCounter  uint64
+	beforeGetPackagingTypeCounter uint64
+	GetPackagingTypeMock          mOrderRepositoryMockGetPackagingType
+
+	funcGetPage          func(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) (opa1 []*order.Order, err error)
+	funcGetPageOrigin    string
+	inspectFuncGetPage   func(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int)
+	afterGetPageCounter  uint64
+	beforeGetPageCounter uint64
+	GetPageMock          mOrderRepositoryMockGetPage
+
+	funcGetPickupPoint          func(ctx context.Context, i1 basetypes.ID) (pp1 *pickuppoint.PickupPoint, err error)
+	funcGetPickupPointOrigin    string
+	inspectFuncGetPickupPoint   func(ctx context.Context, i1 basetypes.ID)
+	afterGetPickupPointCounter  uint64
+	beforeGetPickupPointCounter uint64
+	GetPickupPointMock          mOrderRepositoryMockGetPickupPoint
+
+	funcIssueList          func(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error)
+	funcIssueListOrigin    string
+	inspectFuncIssueList   func(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error))
+	afterIssueListCounter  uint64
+	beforeIssueListCounter uint64
+	IssueListMock          mOrderRepositoryMockIssueList
+
+	funcListPackagingTypes          func(ctx context.Context) (ppa1 []*order.PackagingType, err error)
+	funcListPackagingTypesOrigin    string
+	inspectFuncListPackagingTypes   func(ctx context.Context)
+	afterListPackagingTypesCounter  uint64
+	beforeListPackagingTypesCounter uint64
+	ListPackagingTypesMock          mOrderRepositoryMockListPackagingTypes
+
+	funcListPickupPoints          func(ctx context.Context) (ppa1 []*pickuppoint.PickupPoint, err error)
+	funcListPickupPointsOrigin    string
+	inspectFuncListPickupPoints   func(ctx context.Context)
+	afterListPickupPointsCounter  uint64
+	beforeListPickupPointsCounter uint64
+	ListPickupPointsMock          mOrderRepositoryMockListPickupPoints
+
+	funcPickupPointExists          func(ctx context.Context, i1 basetypes.ID) (b1 bool, err error)
+	funcPickupPointExistsOrigin    string
+	inspectFuncPickupPointExists   func(ctx context.Context, i1 basetypes.ID)
+	afterPickupPointExistsCounter  uint64
+	beforePickupPointExistsCounter uint64
+	PickupPointExistsMock          mOrderRepositoryMockPickupPointExists
+
+	funcUpdate          func(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, op1 *order.Order, ea1 ...order.Event)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mOrderRepositoryMockUpdate
+
+	funcUpdateList          func(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error)
+	funcUpdateListOrigin    string
+	inspectFuncUpdateList   func(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error))
+	afterUpdateListCounter  uint64
+	beforeUpdateListCounter uint64
+	UpdateListMock          mOrderRepositoryMockUpdateList
+
+	funcUpdatePickupPoint          func(ctx context.Context, pp1 *pickuppoint.PickupPoint) (err error)
+	funcUpdatePickupPointOrigin    string
+	inspectFuncUpdatePickupPoint   func(ctx context.Context, pp1 *pickuppoint.PickupPoint)
+	afterUpdatePickupPointCounter  uint64
+	beforeUpdatePickupPointCounter uint64
+	UpdatePickupPointMock          mOrderRepositoryMockUpdatePickupPoint
 }
 
 // NewOrderRepositoryMock returns a mock for mm_order.Repository
@@ -78,32 +200,456 @@ func NewOrderRepositoryMock(t minimock.Tester) *OrderRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddHandoverMock = mOrderRepositoryMockAddHandover{mock: m}
+	m.AddHandoverMock.callArgs = []*OrderRepositoryMockAddHandoverParams{}
+
 	m.AddOrUpdateMock = mOrderRepositoryMockAddOrUpdate{mock: m}
 	m.AddOrUpdateMock.callArgs = []*OrderRepositoryMockAddOrUpdateParams{}
 
 	m.AddOrUpdateListMock = mOrderRepositoryMockAddOrUpdateList{mock: m}
 	m.AddOrUpdateListMock.callArgs = []*OrderRepositoryMockAddOrUpdateListParams{}
 
+	m.AddOrUpdatePackagingTypeMock = mOrderRepositoryMockAddOrUpdatePackagingType{mock: m}
+	m.AddOrUpdatePackagingTypeMock.callArgs = []*OrderRepositoryMockAddOrUpdatePackagingTypeParams{}
+
+	m.AddOrUpdateWithinCapacityMock = mOrderRepositoryMockAddOrUpdateWithinCapacity{mock: m}
+	m.AddOrUpdateWithinCapacityMock.callArgs = []*OrderRepositoryMockAddOrUpdateWithinCapacityParams{}
+
+	m.CreatePickupPointMock = mOrderRepositoryMockCreatePickupPoint{mock: m}
+	m.CreatePickupPointMock.callArgs = []*OrderRepositoryMockCreatePickupPointParams{}
+
 	m.DeleteMock = mOrderRepositoryMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*OrderRepositoryMockDeleteParams{}
 
 	m.DeleteByMock = mOrderRepositoryMockDeleteBy{mock: m}
 	m.DeleteByMock.callArgs = []*OrderRepositoryMockDeleteByParams{}
 
+	m.DeletePackagingTypeMock = mOrderRepositoryMockDeletePackagingType{mock: m}
+	m.DeletePackagingTypeMock.callArgs = []*OrderRepositoryMockDeletePackagingTypeParams{}
+
 	m.GetMock = mOrderRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*OrderRepositoryMockGetParams{}
 
 	m.GetByMock = mOrderRepositoryMockGetBy{mock: m}
 	m.GetByMock.callArgs = []*OrderRepositoryMockGetByParams{}
 
-	m.GetByPaginatedMock = mOrderRepositoryMockGetByPaginated{mock: m}
-	m.GetByPaginatedMock.callArgs = []*OrderRepositoryMockGetByPaginatedParams{}
+	m.GetExpiredIDsMock = mOrderRepositoryMockGetExpiredIDs{mock: m}
+	m.GetExpiredIDsMock.callArgs = []*OrderRepositoryMockGetExpiredIDsParams{}
+
+	m.GetHandoverMock = mOrderRepositoryMockGetHandover{mock: m}
+	m.GetHandoverMock.callArgs = []*OrderRepositoryMockGetHandoverParams{}
+
+	m.GetHistoryMock = mOrderRepositoryMockGetHistory{mock: m}
+	m.GetHistoryMock.callArgs = []*OrderRepositoryMockGetHistoryParams{}
+
+	m.GetPackagingTypeMock = mOrderRepositoryMockGetPackagingType{mock: m}
+	m.GetPackagingTypeMock.callArgs = []*OrderRepositoryMockGetPackagingTypeParams{}
+
+	m.GetPageMock = mOrderRepositoryMockGetPage{mock: m}
+	m.GetPageMock.callArgs = []*OrderRepositoryMockGetPageParams{}
+
+	m.GetPickupPointMock = mOrderRepositoryMockGetPickupPoint{mock: m}
+	m.GetPickupPointMock.callArgs = []*OrderRepositoryMockGetPickupPointParams{}
+
+	m.IssueListMock = mOrderRepositoryMockIssueList{mock: m}
+	m.IssueListMock.callArgs = []*OrderRepositoryMockIssueListParams{}
+
+	m.ListPackagingTypesMock = mOrderRepositoryMockListPackagingTypes{mock: m}
+	m.ListPackagingTypesMock.callArgs = []*OrderRepositoryMockListPackagingTypesParams{}
+
+	m.ListPickupPointsMock = mOrderRepositoryMockListPickupPoints{mock: m}
+	m.ListPickupPointsMock.callArgs = []*OrderRepositoryMockListPickupPointsParams{}
+
+	m.PickupPointExistsMock = mOrderRepositoryMockPickupPointExists{mock: m}
+	m.PickupPointExistsMock.callArgs = []*OrderRepositoryMockPickupPointExistsParams{}
+
+	m.UpdateMock = mOrderRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*OrderRepositoryMockUpdateParams{}
+
+	m.UpdateListMock = mOrderRepositoryMockUpdateList{mock: m}
+	m.UpdateListMock.callArgs = []*OrderRepositoryMockUpdateListParams{}
+
+	m.UpdatePickupPointMock = mOrderRepositoryMockUpdatePickupPoint{mock: m}
+	m.UpdatePickupPointMock.callArgs = []*OrderRepositoryMockUpdatePickupPointParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mOrderRepositoryMockAddHandover struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockAddHandoverExpectation
+	expectations       []*OrderRepositoryMockAddHandoverExpectation
+
+	callArgs []*OrderRepositoryMockAddHandoverParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockAddHandoverExpectation specifies expectation struct of the Repository.AddHandover
+type OrderRepositoryMockAddHandoverExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockAddHandoverParams
+	paramPtrs          *OrderRepositoryMockAddHandoverParamPtrs
+	expectationOrigins OrderRepositoryMockAddHandoverExpectationOrigins
+	results            *OrderRepositoryMockAddHandoverResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockAddHandoverParams contains parameters of the Repository.AddHandover
+type OrderRepositoryMockAddHandoverParams struct {
+	ctx    context.Context
+	h      *order.Handover
+	update func([]*order.Order) ([]*order.Order, []order.Event, error)
+}
+
+// OrderRepositoryMockAddHandoverParamPtrs contains pointers to parameters of the Repository.AddHandover
+type OrderRepositoryMockAddHandoverParamPtrs struct {
+	ctx    *context.Context
+	h      **order.Handover
+	update *func([]*order.Order) ([]*order.Order, []order.Event, error)
+}
+
+// OrderRepositoryMockAddHandoverResults contains results of the Repository.AddHandover
+type OrderRepositoryMockAddHandoverResults struct {
+	err error
+}
+
+// OrderRepositoryMockAddHandoverOrigins contains origins of expectations of the Repository.AddHandover
+type OrderRepositoryMockAddHandoverExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originH      string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Optional() *mOrderRepositoryMockAddHandover {
+	mmAddHandover.optional = true
+	return mmAddHandover
+}
+
+// Expect sets up expected params for Repository.AddHandover
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Expect(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) *mOrderRepositoryMockAddHandover {
+	if mmAddHandover.mock.funcAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Set")
+	}
+
+	if mmAddHandover.defaultExpectation == nil {
+		mmAddHandover.defaultExpectation = &OrderRepositoryMockAddHandoverExpectation{}
+	}
+
+	if mmAddHandover.defaultExpectation.paramPtrs != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by ExpectParams functions")
+	}
+
+	mmAddHandover.defaultExpectation.params = &OrderRepositoryMockAddHandoverParams{ctx, h, update}
+	mmAddHandover.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddHandover.expectations {
+		if minimock.Equal(e.params, mmAddHandover.defaultExpectation.params) {
+			mmAddHandover.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddHandover.defaultExpectation.params)
+		}
+	}
+
+	return mmAddHandover
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddHandover
+func (mmAddHandover *mOrderRepositoryMockAddHandover) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockAddHandover {
+	if mmAddHandover.mock.funcAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Set")
+	}
+
+	if mmAddHandover.defaultExpectation == nil {
+		mmAddHandover.defaultExpectation = &OrderRepositoryMockAddHandoverExpectation{}
+	}
+
+	if mmAddHandover.defaultExpectation.params != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Expect")
+	}
+
+	if mmAddHandover.defaultExpectation.paramPtrs == nil {
+		mmAddHandover.defaultExpectation.paramPtrs = &OrderRepositoryMockAddHandoverParamPtrs{}
+	}
+	mmAddHandover.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddHandover.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddHandover
+}
+
+// ExpectHParam2 sets up expected param h for Repository.AddHandover
+func (mmAddHandover *mOrderRepositoryMockAddHandover) ExpectHParam2(h *order.Handover) *mOrderRepositoryMockAddHandover {
+	if mmAddHandover.mock.funcAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Set")
+	}
+
+	if mmAddHandover.defaultExpectation == nil {
+		mmAddHandover.defaultExpectation = &OrderRepositoryMockAddHandoverExpectation{}
+	}
+
+	if mmAddHandover.defaultExpectation.params != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Expect")
+	}
+
+	if mmAddHandover.defaultExpectation.paramPtrs == nil {
+		mmAddHandover.defaultExpectation.paramPtrs = &OrderRepositoryMockAddHandoverParamPtrs{}
+	}
+	mmAddHandover.defaultExpectation.paramPtrs.h = &h
+	mmAddHandover.defaultExpectation.expectationOrigins.originH = minimock.CallerInfo(1)
+
+	return mmAddHandover
+}
+
+// ExpectUpdateParam3 sets up expected param update for Repository.AddHandover
+func (mmAddHandover *mOrderRepositoryMockAddHandover) ExpectUpdateParam3(update func([]*order.Order) ([]*order.Order, []order.Event, error)) *mOrderRepositoryMockAddHandover {
+	if mmAddHandover.mock.funcAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Set")
+	}
+
+	if mmAddHandover.defaultExpectation == nil {
+		mmAddHandover.defaultExpectation = &OrderRepositoryMockAddHandoverExpectation{}
+	}
+
+	if mmAddHandover.defaultExpectation.params != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Expect")
+	}
+
+	if mmAddHandover.defaultExpectation.paramPtrs == nil {
+		mmAddHandover.defaultExpectation.paramPtrs = &OrderRepositoryMockAddHandoverParamPtrs{}
+	}
+	mmAddHandover.defaultExpectation.paramPtrs.update = &update
+	mmAddHandover.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmAddHandover
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.AddHandover
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Inspect(f func(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error))) *mOrderRepositoryMockAddHandover {
+	if mmAddHandover.mock.inspectFuncAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.AddHandover")
+	}
+
+	mmAddHandover.mock.inspectFuncAddHandover = f
+
+	return mmAddHandover
+}
+
+// Return sets up results that will be returned by Repository.AddHandover
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Return(err error) *OrderRepositoryMock {
+	if mmAddHandover.mock.funcAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Set")
+	}
+
+	if mmAddHandover.defaultExpectation == nil {
+		mmAddHandover.defaultExpectation = &OrderRepositoryMockAddHandoverExpectation{mock: mmAddHandover.mock}
+	}
+	mmAddHandover.defaultExpectation.results = &OrderRepositoryMockAddHandoverResults{err}
+	mmAddHandover.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddHandover.mock
+}
+
+// Set uses given function f to mock the Repository.AddHandover method
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Set(f func(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error)) *OrderRepositoryMock {
+	if mmAddHandover.defaultExpectation != nil {
+		mmAddHandover.mock.t.Fatalf("Default expectation is already set for the Repository.AddHandover method")
+	}
+
+	if len(mmAddHandover.expectations) > 0 {
+		mmAddHandover.mock.t.Fatalf("Some expectations are already set for the Repository.AddHandover method")
+	}
+
+	mmAddHandover.mock.funcAddHandover = f
+	mmAddHandover.mock.funcAddHandoverOrigin = minimock.CallerInfo(1)
+	return mmAddHandover.mock
+}
+
+// When sets expectation for the Repository.AddHandover which will trigger the result defined by the following
+// Then helper
+func (mmAddHandover *mOrderRepositoryMockAddHandover) When(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) *OrderRepositoryMockAddHandoverExpectation {
+	if mmAddHandover.mock.funcAddHandover != nil {
+		mmAddHandover.mock.t.Fatalf("OrderRepositoryMock.AddHandover mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockAddHandoverExpectation{
+		mock:               mmAddHandover.mock,
+		params:             &OrderRepositoryMockAddHandoverParams{ctx, h, update},
+		expectationOrigins: OrderRepositoryMockAddHandoverExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddHandover.expectations = append(mmAddHandover.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.AddHandover return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockAddHandoverExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockAddHandoverResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.AddHandover should be invoked
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Times(n uint64) *mOrderRepositoryMockAddHandover {
+	if n == 0 {
+		mmAddHandover.mock.t.Fatalf("Times of OrderRepositoryMock.AddHandover mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddHandover.expectedInvocations, n)
+	mmAddHandover.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddHandover
+}
+
+func (mmAddHandover *mOrderRepositoryMockAddHandover) invocationsDone() bool {
+	if len(mmAddHandover.expectations) == 0 && mmAddHandover.defaultExpectation == nil && mmAddHandover.mock.funcAddHandover == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddHandover.mock.afterAddHandoverCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddHandover.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddHandover implements mm_order.Repository
+func (mmAddHandover *OrderRepositoryMock) AddHandover(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error) {
+	mm_atomic.AddUint64(&mmAddHandover.beforeAddHandoverCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddHandover.afterAddHandoverCounter, 1)
+
+	mmAddHandover.t.Helper()
+
+	if mmAddHandover.inspectFuncAddHandover != nil {
+		mmAddHandover.inspectFuncAddHandover(ctx, h, update)
+	}
+
+	mm_params := OrderRepositoryMockAddHandoverParams{ctx, h, update}
+
+	// Record call args
+	mmAddHandover.AddHandoverMock.mutex.Lock()
+	mmAddHandover.AddHandoverMock.callArgs = append(mmAddHandover.AddHandoverMock.callArgs, &mm_params)
+	mmAddHandover.AddHandoverMock.mutex.Unlock()
+
+	for _, e := range mmAddHandover.AddHandoverMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddHandover.AddHandoverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddHandover.AddHandoverMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddHandover.AddHandoverMock.defaultExpectation.params
+		mm_want_ptrs := mmAddHandover.AddHandoverMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockAddHandoverParams{ctx, h, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddHandover.t.Errorf("OrderRepositoryMock.AddHandover got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddHandover.AddHandoverMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.h != nil && !minimock.Equal(*mm_want_ptrs.h, mm_got.h) {
+				mmAddHandover.t.Errorf("OrderRepositoryMock.AddHandover got unexpected parameter h, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddHandover.AddHandoverMock.defaultExpectation.expectationOrigins.originH, *mm_want_ptrs.h, mm_got.h, minimock.Diff(*mm_want_ptrs.h, mm_got.h))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmAddHandover.t.Errorf("OrderRepositoryMock.AddHandover got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddHandover.AddHandoverMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddHandover.t.Errorf("OrderRepositoryMock.AddHandover got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddHandover.AddHandoverMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddHandover.AddHandoverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddHandover.t.Fatal("No results are set for the OrderRepositoryMock.AddHandover")
+		}
+		return (*mm_results).err
+	}
+	if mmAddHandover.funcAddHandover != nil {
+		return mmAddHandover.funcAddHandover(ctx, h, update)
+	}
+	mmAddHandover.t.Fatalf("Unexpected call to OrderRepositoryMock.AddHandover. %v %v %v", ctx, h, update)
+	return
+}
+
+// AddHandoverAfterCounter returns a count of finished OrderRepositoryMock.AddHandover invocations
+func (mmAddHandover *OrderRepositoryMock) AddHandoverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddHandover.afterAddHandoverCounter)
+}
+
+// AddHandoverBeforeCounter returns a count of OrderRepositoryMock.AddHandover invocations
+func (mmAddHandover *OrderRepositoryMock) AddHandoverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddHandover.beforeAddHandoverCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.AddHandover.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddHandover *mOrderRepositoryMockAddHandover) Calls() []*OrderRepositoryMockAddHandoverParams {
+	mmAddHandover.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockAddHandoverParams, len(mmAddHandover.callArgs))
+	copy(argCopy, mmAddHandover.callArgs)
+
+	mmAddHandover.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddHandoverDone returns true if the count of the AddHandover invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockAddHandoverDone() bool {
+	if m.AddHandoverMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddHandoverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddHandoverMock.invocationsDone()
+}
+
+// MinimockAddHandoverInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockAddHandoverInspect() {
+	for _, e := range m.AddHandoverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddHandover at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddHandoverCounter := mm_atomic.LoadUint64(&m.afterAddHandoverCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddHandoverMock.defaultExpectation != nil && afterAddHandoverCounter < 1 {
+		if m.AddHandoverMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddHandover at\n%s", m.AddHandoverMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddHandover at\n%s with params: %#v", m.AddHandoverMock.defaultExpectation.expectationOrigins.origin, *m.AddHandoverMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddHandover != nil && afterAddHandoverCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.AddHandover at\n%s", m.funcAddHandoverOrigin)
+	}
+
+	if !m.AddHandoverMock.invocationsDone() && afterAddHandoverCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.AddHandover at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddHandoverMock.expectedInvocations), m.AddHandoverMock.expectedInvocationsOrigin, afterAddHandoverCounter)
+	}
+}
+
 type mOrderRepositoryMockAddOrUpdate struct {
 	optional           bool
 	mock               *OrderRepositoryMock
@@ -132,12 +678,14 @@ type OrderRepositoryMockAddOrUpdateExpectation struct {
 type OrderRepositoryMockAddOrUpdateParams struct {
 	ctx context.Context
 	op1 *order.Order
+	ea1 []order.Event
 }
 
 // OrderRepositoryMockAddOrUpdateParamPtrs contains pointers to parameters of the Repository.AddOrUpdate
 type OrderRepositoryMockAddOrUpdateParamPtrs struct {
 	ctx *context.Context
 	op1 **order.Order
+	ea1 *[]order.Event
 }
 
 // OrderRepositoryMockAddOrUpdateResults contains results of the Repository.AddOrUpdate
@@ -151,6 +699,7 @@ type OrderRepositoryMockAddOrUpdateExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originOp1 string
+	originEa1 string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -164,7 +713,7 @@ func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Optional() *mOrderReposito
 }
 
 // Expect sets up expected params for Repository.AddOrUpdate
-func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Expect(ctx context.Context, op1 *order.Order) *mOrderRepositoryMockAddOrUpdate {
+func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Expect(ctx context.Context, op1 *order.Order, ea1 ...order.Event) *mOrderRepositoryMockAddOrUpdate {
 	if mmAddOrUpdate.mock.funcAddOrUpdate != nil {
 		mmAddOrUpdate.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdate mock is already set by Set")
 	}
@@ -177,7 +726,7 @@ func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Expect(ctx context.Context
 		mmAddOrUpdate.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdate mock is already set by ExpectParams functions")
 	}
 
-	mmAddOrUpdate.defaultExpectation.params = &OrderRepositoryMockAddOrUpdateParams{ctx, op1}
+	mmAddOrUpdate.defaultExpectation.params = &OrderRepositoryMockAddOrUpdateParams{ctx, op1, ea1}
 	mmAddOrUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddOrUpdate.expectations {
 		if minimock.Equal(e.params, mmAddOrUpdate.defaultExpectation.params) {
@@ -234,8 +783,31 @@ func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) ExpectOp1Param2(op1 *order
 	return mmAddOrUpdate
 }
 
+// ExpectEa1Param3 sets up expected param ea1 for Repository.AddOrUpdate
+func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) ExpectEa1Param3(ea1 []order.Event) *mOrderRepositoryMockAddOrUpdate {
+	if mmAddOrUpdate.mock.funcAddOrUpdate != nil {
+		mmAddOrUpdate.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdate mock is already set by Set")
+	}
+
+	if mmAddOrUpdate.defaultExpectation == nil {
+		mmAddOrUpdate.defaultExpectation = &OrderRepositoryMockAddOrUpdateExpectation{}
+	}
+
+	if mmAddOrUpdate.defaultExpectation.params != nil {
+		mmAddOrUpdate.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdate mock is already set by Expect")
+	}
+
+	if mmAddOrUpdate.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdate.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdateParamPtrs{}
+	}
+	mmAddOrUpdate.defaultExpectation.paramPtrs.ea1 = &ea1
+	mmAddOrUpdate.defaultExpectation.expectationOrigins.originEa1 = minimock.CallerInfo(1)
+
+	return mmAddOrUpdate
+}
+
 // Inspect accepts an inspector function that has same arguments as the Repository.AddOrUpdate
-func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Inspect(f func(ctx context.Context, op1 *order.Order)) *mOrderRepositoryMockAddOrUpdate {
+func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Inspect(f func(ctx context.Context, op1 *order.Order, ea1 ...order.Event)) *mOrderRepositoryMockAddOrUpdate {
 	if mmAddOrUpdate.mock.inspectFuncAddOrUpdate != nil {
 		mmAddOrUpdate.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.AddOrUpdate")
 	}
@@ -260,7 +832,7 @@ func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Return(exists bool, err er
 }
 
 // Set uses given function f to mock the Repository.AddOrUpdate method
-func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Set(f func(ctx context.Context, op1 *order.Order) (exists bool, err error)) *OrderRepositoryMock {
+func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Set(f func(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (exists bool, err error)) *OrderRepositoryMock {
 	if mmAddOrUpdate.defaultExpectation != nil {
 		mmAddOrUpdate.mock.t.Fatalf("Default expectation is already set for the Repository.AddOrUpdate method")
 	}
@@ -276,14 +848,14 @@ func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) Set(f func(ctx context.Con
 
 // When sets expectation for the Repository.AddOrUpdate which will trigger the result defined by the following
 // Then helper
-func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) When(ctx context.Context, op1 *order.Order) *OrderRepositoryMockAddOrUpdateExpectation {
+func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) When(ctx context.Context, op1 *order.Order, ea1 ...order.Event) *OrderRepositoryMockAddOrUpdateExpectation {
 	if mmAddOrUpdate.mock.funcAddOrUpdate != nil {
 		mmAddOrUpdate.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdate mock is already set by Set")
 	}
 
 	expectation := &OrderRepositoryMockAddOrUpdateExpectation{
 		mock:               mmAddOrUpdate.mock,
-		params:             &OrderRepositoryMockAddOrUpdateParams{ctx, op1},
+		params:             &OrderRepositoryMockAddOrUpdateParams{ctx, op1, ea1},
 		expectationOrigins: OrderRepositoryMockAddOrUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddOrUpdate.expectations = append(mmAddOrUpdate.expectations, expectation)
@@ -318,17 +890,17 @@ func (mmAddOrUpdate *mOrderRepositoryMockAddOrUpdate) invocationsDone() bool {
 }
 
 // AddOrUpdate implements mm_order.Repository
-func (mmAddOrUpdate *OrderRepositoryMock) AddOrUpdate(ctx context.Context, op1 *order.Order) (exists bool, err error) {
+func (mmAddOrUpdate *OrderRepositoryMock) AddOrUpdate(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (exists bool, err error) {
 	mm_atomic.AddUint64(&mmAddOrUpdate.beforeAddOrUpdateCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddOrUpdate.afterAddOrUpdateCounter, 1)
 
 	mmAddOrUpdate.t.Helper()
 
 	if mmAddOrUpdate.inspectFuncAddOrUpdate != nil {
-		mmAddOrUpdate.inspectFuncAddOrUpdate(ctx, op1)
+		mmAddOrUpdate.inspectFuncAddOrUpdate(ctx, op1, ea1...)
 	}
 
-	mm_params := OrderRepositoryMockAddOrUpdateParams{ctx, op1}
+	mm_params := OrderRepositoryMockAddOrUpdateParams{ctx, op1, ea1}
 
 	// Record call args
 	mmAddOrUpdate.AddOrUpdateMock.mutex.Lock()
@@ -347,7 +919,7 @@ func (mmAddOrUpdate *OrderRepositoryMock) AddOrUpdate(ctx context.Context, op1 *
 		mm_want := mmAddOrUpdate.AddOrUpdateMock.defaultExpectation.params
 		mm_want_ptrs := mmAddOrUpdate.AddOrUpdateMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockAddOrUpdateParams{ctx, op1}
+		mm_got := OrderRepositoryMockAddOrUpdateParams{ctx, op1, ea1}
 
 		if mm_want_ptrs != nil {
 
@@ -361,6 +933,11 @@ func (mmAddOrUpdate *OrderRepositoryMock) AddOrUpdate(ctx context.Context, op1 *
 					mmAddOrUpdate.AddOrUpdateMock.defaultExpectation.expectationOrigins.originOp1, *mm_want_ptrs.op1, mm_got.op1, minimock.Diff(*mm_want_ptrs.op1, mm_got.op1))
 			}
 
+			if mm_want_ptrs.ea1 != nil && !minimock.Equal(*mm_want_ptrs.ea1, mm_got.ea1) {
+				mmAddOrUpdate.t.Errorf("OrderRepositoryMock.AddOrUpdate got unexpected parameter ea1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdate.AddOrUpdateMock.defaultExpectation.expectationOrigins.originEa1, *mm_want_ptrs.ea1, mm_got.ea1, minimock.Diff(*mm_want_ptrs.ea1, mm_got.ea1))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddOrUpdate.t.Errorf("OrderRepositoryMock.AddOrUpdate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmAddOrUpdate.AddOrUpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -373,9 +950,9 @@ func (mmAddOrUpdate *OrderRepositoryMock) AddOrUpdate(ctx context.Context, op1 *
 		return (*mm_results).exists, (*mm_results).err
 	}
 	if mmAddOrUpdate.funcAddOrUpdate != nil {
-		return mmAddOrUpdate.funcAddOrUpdate(ctx, op1)
+		return mmAddOrUpdate.funcAddOrUpdate(ctx, op1, ea1...)
 	}
-	mmAddOrUpdate.t.Fatalf("Unexpected call to OrderRepositoryMock.AddOrUpdate. %v %v", ctx, op1)
+	mmAddOrUpdate.t.Fatalf("Unexpected call to OrderRepositoryMock.AddOrUpdate. %v %v %v", ctx, op1, ea1)
 	return
 }
 
@@ -475,12 +1052,14 @@ type OrderRepositoryMockAddOrUpdateListExpectation struct {
 type OrderRepositoryMockAddOrUpdateListParams struct {
 	ctx  context.Context
 	opa1 []*order.Order
+	ea1  []order.Event
 }
 
 // OrderRepositoryMockAddOrUpdateListParamPtrs contains pointers to parameters of the Repository.AddOrUpdateList
 type OrderRepositoryMockAddOrUpdateListParamPtrs struct {
 	ctx  *context.Context
 	opa1 *[]*order.Order
+	ea1  *[]order.Event
 }
 
 // OrderRepositoryMockAddOrUpdateListResults contains results of the Repository.AddOrUpdateList
@@ -493,6 +1072,7 @@ type OrderRepositoryMockAddOrUpdateListExpectationOrigins struct {
 	origin     string
 	originCtx  string
 	originOpa1 string
+	originEa1  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -506,7 +1086,7 @@ func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Optional() *mOrder
 }
 
 // Expect sets up expected params for Repository.AddOrUpdateList
-func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Expect(ctx context.Context, opa1 []*order.Order) *mOrderRepositoryMockAddOrUpdateList {
+func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Expect(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event) *mOrderRepositoryMockAddOrUpdateList {
 	if mmAddOrUpdateList.mock.funcAddOrUpdateList != nil {
 		mmAddOrUpdateList.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateList mock is already set by Set")
 	}
@@ -519,7 +1099,7 @@ func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Expect(ctx context
 		mmAddOrUpdateList.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateList mock is already set by ExpectParams functions")
 	}
 
-	mmAddOrUpdateList.defaultExpectation.params = &OrderRepositoryMockAddOrUpdateListParams{ctx, opa1}
+	mmAddOrUpdateList.defaultExpectation.params = &OrderRepositoryMockAddOrUpdateListParams{ctx, opa1, ea1}
 	mmAddOrUpdateList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddOrUpdateList.expectations {
 		if minimock.Equal(e.params, mmAddOrUpdateList.defaultExpectation.params) {
@@ -576,8 +1156,31 @@ func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) ExpectOpa1Param2(o
 	return mmAddOrUpdateList
 }
 
+// ExpectEa1Param3 sets up expected param ea1 for Repository.AddOrUpdateList
+func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) ExpectEa1Param3(ea1 []order.Event) *mOrderRepositoryMockAddOrUpdateList {
+	if mmAddOrUpdateList.mock.funcAddOrUpdateList != nil {
+		mmAddOrUpdateList.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateList mock is already set by Set")
+	}
+
+	if mmAddOrUpdateList.defaultExpectation == nil {
+		mmAddOrUpdateList.defaultExpectation = &OrderRepositoryMockAddOrUpdateListExpectation{}
+	}
+
+	if mmAddOrUpdateList.defaultExpectation.params != nil {
+		mmAddOrUpdateList.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateList mock is already set by Expect")
+	}
+
+	if mmAddOrUpdateList.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdateList.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdateListParamPtrs{}
+	}
+	mmAddOrUpdateList.defaultExpectation.paramPtrs.ea1 = &ea1
+	mmAddOrUpdateList.defaultExpectation.expectationOrigins.originEa1 = minimock.CallerInfo(1)
+
+	return mmAddOrUpdateList
+}
+
 // Inspect accepts an inspector function that has same arguments as the Repository.AddOrUpdateList
-func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Inspect(f func(ctx context.Context, opa1 []*order.Order)) *mOrderRepositoryMockAddOrUpdateList {
+func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Inspect(f func(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event)) *mOrderRepositoryMockAddOrUpdateList {
 	if mmAddOrUpdateList.mock.inspectFuncAddOrUpdateList != nil {
 		mmAddOrUpdateList.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.AddOrUpdateList")
 	}
@@ -602,7 +1205,7 @@ func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Return(err error)
 }
 
 // Set uses given function f to mock the Repository.AddOrUpdateList method
-func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Set(f func(ctx context.Context, opa1 []*order.Order) (err error)) *OrderRepositoryMock {
+func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Set(f func(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event) (err error)) *OrderRepositoryMock {
 	if mmAddOrUpdateList.defaultExpectation != nil {
 		mmAddOrUpdateList.mock.t.Fatalf("Default expectation is already set for the Repository.AddOrUpdateList method")
 	}
@@ -618,14 +1221,14 @@ func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) Set(f func(ctx con
 
 // When sets expectation for the Repository.AddOrUpdateList which will trigger the result defined by the following
 // Then helper
-func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) When(ctx context.Context, opa1 []*order.Order) *OrderRepositoryMockAddOrUpdateListExpectation {
+func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) When(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event) *OrderRepositoryMockAddOrUpdateListExpectation {
 	if mmAddOrUpdateList.mock.funcAddOrUpdateList != nil {
 		mmAddOrUpdateList.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateList mock is already set by Set")
 	}
 
 	expectation := &OrderRepositoryMockAddOrUpdateListExpectation{
 		mock:               mmAddOrUpdateList.mock,
-		params:             &OrderRepositoryMockAddOrUpdateListParams{ctx, opa1},
+		params:             &OrderRepositoryMockAddOrUpdateListParams{ctx, opa1, ea1},
 		expectationOrigins: OrderRepositoryMockAddOrUpdateListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddOrUpdateList.expectations = append(mmAddOrUpdateList.expectations, expectation)
@@ -660,17 +1263,17 @@ func (mmAddOrUpdateList *mOrderRepositoryMockAddOrUpdateList) invocationsDone()
 }
 
 // AddOrUpdateList implements mm_order.Repository
-func (mmAddOrUpdateList *OrderRepositoryMock) AddOrUpdateList(ctx context.Context, opa1 []*order.Order) (err error) {
+func (mmAddOrUpdateList *OrderRepositoryMock) AddOrUpdateList(ctx context.Context, opa1 []*order.Order, ea1 ...order.Event) (err error) {
 	mm_atomic.AddUint64(&mmAddOrUpdateList.beforeAddOrUpdateListCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddOrUpdateList.afterAddOrUpdateListCounter, 1)
 
 	mmAddOrUpdateList.t.Helper()
 
 	if mmAddOrUpdateList.inspectFuncAddOrUpdateList != nil {
-		mmAddOrUpdateList.inspectFuncAddOrUpdateList(ctx, opa1)
+		mmAddOrUpdateList.inspectFuncAddOrUpdateList(ctx, opa1, ea1...)
 	}
 
-	mm_params := OrderRepositoryMockAddOrUpdateListParams{ctx, opa1}
+	mm_params := OrderRepositoryMockAddOrUpdateListParams{ctx, opa1, ea1}
 
 	// Record call args
 	mmAddOrUpdateList.AddOrUpdateListMock.mutex.Lock()
@@ -689,7 +1292,7 @@ func (mmAddOrUpdateList *OrderRepositoryMock) AddOrUpdateList(ctx context.Contex
 		mm_want := mmAddOrUpdateList.AddOrUpdateListMock.defaultExpectation.params
 		mm_want_ptrs := mmAddOrUpdateList.AddOrUpdateListMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockAddOrUpdateListParams{ctx, opa1}
+		mm_got := OrderRepositoryMockAddOrUpdateListParams{ctx, opa1, ea1}
 
 		if mm_want_ptrs != nil {
 
@@ -703,6 +1306,11 @@ func (mmAddOrUpdateList *OrderRepositoryMock) AddOrUpdateList(ctx context.Contex
 					mmAddOrUpdateList.AddOrUpdateListMock.defaultExpectation.expectationOrigins.originOpa1, *mm_want_ptrs.opa1, mm_got.opa1, minimock.Diff(*mm_want_ptrs.opa1, mm_got.opa1))
 			}
 
+			if mm_want_ptrs.ea1 != nil && !minimock.Equal(*mm_want_ptrs.ea1, mm_got.ea1) {
+				mmAddOrUpdateList.t.Errorf("OrderRepositoryMock.AddOrUpdateList got unexpected parameter ea1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdateList.AddOrUpdateListMock.defaultExpectation.expectationOrigins.originEa1, *mm_want_ptrs.ea1, mm_got.ea1, minimock.Diff(*mm_want_ptrs.ea1, mm_got.ea1))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddOrUpdateList.t.Errorf("OrderRepositoryMock.AddOrUpdateList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmAddOrUpdateList.AddOrUpdateListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -715,9 +1323,9 @@ func (mmAddOrUpdateList *OrderRepositoryMock) AddOrUpdateList(ctx context.Contex
 		return (*mm_results).err
 	}
 	if mmAddOrUpdateList.funcAddOrUpdateList != nil {
-		return mmAddOrUpdateList.funcAddOrUpdateList(ctx, opa1)
+		return mmAddOrUpdateList.funcAddOrUpdateList(ctx, opa1, ea1...)
 	}
-	mmAddOrUpdateList.t.Fatalf("Unexpected call to OrderRepositoryMock.AddOrUpdateList. %v %v", ctx, opa1)
+	mmAddOrUpdateList.t.Fatalf("Unexpected call to OrderRepositoryMock.AddOrUpdateList. %v %v %v", ctx, opa1, ea1)
 	return
 }
 
@@ -789,52 +1397,53 @@ func (m *OrderRepositoryMock) MinimockAddOrUpdateListInspect() {
 	}
 }
 
-type mOrderRepositoryMockDelete struct {
+type mOrderRepositoryMockAddOrUpdatePackagingType struct {
 	optional           bool
 	mock               *OrderRepositoryMock
-	defaultExpectation *OrderRepositoryMockDeleteExpectation
-	expectations       []*OrderRepositoryMockDeleteExpectation
+	defaultExpectation *OrderRepositoryMockAddOrUpdatePackagingTypeExpectation
+	expectations       []*OrderRepositoryMockAddOrUpdatePackagingTypeExpectation
 
-	callArgs []*OrderRepositoryMockDeleteParams
+	callArgs []*OrderRepositoryMockAddOrUpdatePackagingTypeParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrderRepositoryMockDeleteExpectation specifies expectation struct of the Repository.Delete
-type OrderRepositoryMockDeleteExpectation struct {
+// OrderRepositoryMockAddOrUpdatePackagingTypeExpectation specifies expectation struct of the Repository.AddOrUpdatePackagingType
+type OrderRepositoryMockAddOrUpdatePackagingTypeExpectation struct {
 	mock               *OrderRepositoryMock
-	params             *OrderRepositoryMockDeleteParams
-	paramPtrs          *OrderRepositoryMockDeleteParamPtrs
-	expectationOrigins OrderRepositoryMockDeleteExpectationOrigins
-	results            *OrderRepositoryMockDeleteResults
+	params             *OrderRepositoryMockAddOrUpdatePackagingTypeParams
+	paramPtrs          *OrderRepositoryMockAddOrUpdatePackagingTypeParamPtrs
+	expectationOrigins OrderRepositoryMockAddOrUpdatePackagingTypeExpectationOrigins
+	results            *OrderRepositoryMockAddOrUpdatePackagingTypeResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepositoryMockDeleteParams contains parameters of the Repository.Delete
-type OrderRepositoryMockDeleteParams struct {
+// OrderRepositoryMockAddOrUpdatePackagingTypeParams contains parameters of the Repository.AddOrUpdatePackagingType
+type OrderRepositoryMockAddOrUpdatePackagingTypeParams struct {
 	ctx context.Context
-	i1  basetypes.ID
+	pp1 *order.PackagingType
 }
 
-// OrderRepositoryMockDeleteParamPtrs contains pointers to parameters of the Repository.Delete
-type OrderRepositoryMockDeleteParamPtrs struct {
+// OrderRepositoryMockAddOrUpdatePackagingTypeParamPtrs contains pointers to parameters of the Repository.AddOrUpdatePackagingType
+type OrderRepositoryMockAddOrUpdatePackagingTypeParamPtrs struct {
 	ctx *context.Context
-	i1  *basetypes.ID
+	pp1 **order.PackagingType
 }
 
-// OrderRepositoryMockDeleteResults contains results of the Repository.Delete
-type OrderRepositoryMockDeleteResults struct {
-	err error
+// OrderRepositoryMockAddOrUpdatePackagingTypeResults contains results of the Repository.AddOrUpdatePackagingType
+type OrderRepositoryMockAddOrUpdatePackagingTypeResults struct {
+	exists bool
+	err    error
 }
 
-// OrderRepositoryMockDeleteOrigins contains origins of expectations of the Repository.Delete
-type OrderRepositoryMockDeleteExpectationOrigins struct {
+// OrderRepositoryMockAddOrUpdatePackagingTypeOrigins contains origins of expectations of the Repository.AddOrUpdatePackagingType
+type OrderRepositoryMockAddOrUpdatePackagingTypeExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originI1  string
+	originPp1 string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -842,341 +1451,345 @@ type OrderRepositoryMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mOrderRepositoryMockDelete) Optional() *mOrderRepositoryMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Optional() *mOrderRepositoryMockAddOrUpdatePackagingType {
+	mmAddOrUpdatePackagingType.optional = true
+	return mmAddOrUpdatePackagingType
 }
 
-// Expect sets up expected params for Repository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+// Expect sets up expected params for Repository.AddOrUpdatePackagingType
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Expect(ctx context.Context, pp1 *order.PackagingType) *mOrderRepositoryMockAddOrUpdatePackagingType {
+	if mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	if mmAddOrUpdatePackagingType.defaultExpectation == nil {
+		mmAddOrUpdatePackagingType.defaultExpectation = &OrderRepositoryMockAddOrUpdatePackagingTypeExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by ExpectParams functions")
+	if mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &OrderRepositoryMockDeleteParams{ctx, i1}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmAddOrUpdatePackagingType.defaultExpectation.params = &OrderRepositoryMockAddOrUpdatePackagingTypeParams{ctx, pp1}
+	mmAddOrUpdatePackagingType.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddOrUpdatePackagingType.expectations {
+		if minimock.Equal(e.params, mmAddOrUpdatePackagingType.defaultExpectation.params) {
+			mmAddOrUpdatePackagingType.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddOrUpdatePackagingType.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmAddOrUpdatePackagingType
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddOrUpdatePackagingType
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockAddOrUpdatePackagingType {
+	if mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	if mmAddOrUpdatePackagingType.defaultExpectation == nil {
+		mmAddOrUpdatePackagingType.defaultExpectation = &OrderRepositoryMockAddOrUpdatePackagingTypeExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Expect")
+	if mmAddOrUpdatePackagingType.defaultExpectation.params != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteParamPtrs{}
+	if mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdatePackagingTypeParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddOrUpdatePackagingType.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmAddOrUpdatePackagingType
 }
 
-// ExpectI1Param2 sets up expected param i1 for Repository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+// ExpectPp1Param2 sets up expected param pp1 for Repository.AddOrUpdatePackagingType
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) ExpectPp1Param2(pp1 *order.PackagingType) *mOrderRepositoryMockAddOrUpdatePackagingType {
+	if mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	if mmAddOrUpdatePackagingType.defaultExpectation == nil {
+		mmAddOrUpdatePackagingType.defaultExpectation = &OrderRepositoryMockAddOrUpdatePackagingTypeExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Expect")
+	if mmAddOrUpdatePackagingType.defaultExpectation.params != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteParamPtrs{}
+	if mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdatePackagingTypeParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.i1 = &i1
-	mmDelete.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+	mmAddOrUpdatePackagingType.defaultExpectation.paramPtrs.pp1 = &pp1
+	mmAddOrUpdatePackagingType.defaultExpectation.expectationOrigins.originPp1 = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmAddOrUpdatePackagingType
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Delete")
+// Inspect accepts an inspector function that has same arguments as the Repository.AddOrUpdatePackagingType
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Inspect(f func(ctx context.Context, pp1 *order.PackagingType)) *mOrderRepositoryMockAddOrUpdatePackagingType {
+	if mmAddOrUpdatePackagingType.mock.inspectFuncAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.AddOrUpdatePackagingType")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	mmAddOrUpdatePackagingType.mock.inspectFuncAddOrUpdatePackagingType = f
 
-	return mmDelete
+	return mmAddOrUpdatePackagingType
 }
 
-// Return sets up results that will be returned by Repository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) Return(err error) *OrderRepositoryMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+// Return sets up results that will be returned by Repository.AddOrUpdatePackagingType
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Return(exists bool, err error) *OrderRepositoryMock {
+	if mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	if mmAddOrUpdatePackagingType.defaultExpectation == nil {
+		mmAddOrUpdatePackagingType.defaultExpectation = &OrderRepositoryMockAddOrUpdatePackagingTypeExpectation{mock: mmAddOrUpdatePackagingType.mock}
 	}
-	mmDelete.defaultExpectation.results = &OrderRepositoryMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmAddOrUpdatePackagingType.defaultExpectation.results = &OrderRepositoryMockAddOrUpdatePackagingTypeResults{exists, err}
+	mmAddOrUpdatePackagingType.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddOrUpdatePackagingType.mock
 }
 
-// Set uses given function f to mock the Repository.Delete method
-func (mmDelete *mOrderRepositoryMockDelete) Set(f func(ctx context.Context, i1 basetypes.ID) (err error)) *OrderRepositoryMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the Repository.Delete method")
+// Set uses given function f to mock the Repository.AddOrUpdatePackagingType method
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Set(f func(ctx context.Context, pp1 *order.PackagingType) (exists bool, err error)) *OrderRepositoryMock {
+	if mmAddOrUpdatePackagingType.defaultExpectation != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("Default expectation is already set for the Repository.AddOrUpdatePackagingType method")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the Repository.Delete method")
+	if len(mmAddOrUpdatePackagingType.expectations) > 0 {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("Some expectations are already set for the Repository.AddOrUpdatePackagingType method")
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType = f
+	mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingTypeOrigin = minimock.CallerInfo(1)
+	return mmAddOrUpdatePackagingType.mock
 }
 
-// When sets expectation for the Repository.Delete which will trigger the result defined by the following
+// When sets expectation for the Repository.AddOrUpdatePackagingType which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mOrderRepositoryMockDelete) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) When(ctx context.Context, pp1 *order.PackagingType) *OrderRepositoryMockAddOrUpdatePackagingTypeExpectation {
+	if mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdatePackagingType mock is already set by Set")
 	}
 
-	expectation := &OrderRepositoryMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &OrderRepositoryMockDeleteParams{ctx, i1},
-		expectationOrigins: OrderRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderRepositoryMockAddOrUpdatePackagingTypeExpectation{
+		mock:               mmAddOrUpdatePackagingType.mock,
+		params:             &OrderRepositoryMockAddOrUpdatePackagingTypeParams{ctx, pp1},
+		expectationOrigins: OrderRepositoryMockAddOrUpdatePackagingTypeExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmAddOrUpdatePackagingType.expectations = append(mmAddOrUpdatePackagingType.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.Delete return parameters for the expectation previously defined by the When method
-func (e *OrderRepositoryMockDeleteExpectation) Then(err error) *OrderRepositoryMock {
-	e.results = &OrderRepositoryMockDeleteResults{err}
+// Then sets up Repository.AddOrUpdatePackagingType return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockAddOrUpdatePackagingTypeExpectation) Then(exists bool, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockAddOrUpdatePackagingTypeResults{exists, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.Delete should be invoked
-func (mmDelete *mOrderRepositoryMockDelete) Times(n uint64) *mOrderRepositoryMockDelete {
+// Times sets number of times Repository.AddOrUpdatePackagingType should be invoked
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Times(n uint64) *mOrderRepositoryMockAddOrUpdatePackagingType {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of OrderRepositoryMock.Delete mock can not be zero")
+		mmAddOrUpdatePackagingType.mock.t.Fatalf("Times of OrderRepositoryMock.AddOrUpdatePackagingType mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmAddOrUpdatePackagingType.expectedInvocations, n)
+	mmAddOrUpdatePackagingType.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddOrUpdatePackagingType
 }
 
-func (mmDelete *mOrderRepositoryMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) invocationsDone() bool {
+	if len(mmAddOrUpdatePackagingType.expectations) == 0 && mmAddOrUpdatePackagingType.defaultExpectation == nil && mmAddOrUpdatePackagingType.mock.funcAddOrUpdatePackagingType == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddOrUpdatePackagingType.mock.afterAddOrUpdatePackagingTypeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddOrUpdatePackagingType.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_order.Repository
-func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, i1 basetypes.ID) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// AddOrUpdatePackagingType implements mm_order.Repository
+func (mmAddOrUpdatePackagingType *OrderRepositoryMock) AddOrUpdatePackagingType(ctx context.Context, pp1 *order.PackagingType) (exists bool, err error) {
+	mm_atomic.AddUint64(&mmAddOrUpdatePackagingType.beforeAddOrUpdatePackagingTypeCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddOrUpdatePackagingType.afterAddOrUpdatePackagingTypeCounter, 1)
 
-	mmDelete.t.Helper()
+	mmAddOrUpdatePackagingType.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, i1)
+	if mmAddOrUpdatePackagingType.inspectFuncAddOrUpdatePackagingType != nil {
+		mmAddOrUpdatePackagingType.inspectFuncAddOrUpdatePackagingType(ctx, pp1)
 	}
 
-	mm_params := OrderRepositoryMockDeleteParams{ctx, i1}
+	mm_params := OrderRepositoryMockAddOrUpdatePackagingTypeParams{ctx, pp1}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.mutex.Lock()
+	mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.callArgs = append(mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.callArgs, &mm_params)
+	mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.exists, e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.params
+		mm_want_ptrs := mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockDeleteParams{ctx, i1}
+		mm_got := OrderRepositoryMockAddOrUpdatePackagingTypeParams{ctx, pp1}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddOrUpdatePackagingType.t.Errorf("OrderRepositoryMock.AddOrUpdatePackagingType got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
-				mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
+			if mm_want_ptrs.pp1 != nil && !minimock.Equal(*mm_want_ptrs.pp1, mm_got.pp1) {
+				mmAddOrUpdatePackagingType.t.Errorf("OrderRepositoryMock.AddOrUpdatePackagingType got unexpected parameter pp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.expectationOrigins.originPp1, *mm_want_ptrs.pp1, mm_got.pp1, minimock.Diff(*mm_want_ptrs.pp1, mm_got.pp1))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddOrUpdatePackagingType.t.Errorf("OrderRepositoryMock.AddOrUpdatePackagingType got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmAddOrUpdatePackagingType.AddOrUpdatePackagingTypeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the OrderRepositoryMock.Delete")
+			mmAddOrUpdatePackagingType.t.Fatal("No results are set for the OrderRepositoryMock.AddOrUpdatePackagingType")
 		}
-		return (*mm_results).err
+		return (*mm_results).exists, (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, i1)
+	if mmAddOrUpdatePackagingType.funcAddOrUpdatePackagingType != nil {
+		return mmAddOrUpdatePackagingType.funcAddOrUpdatePackagingType(ctx, pp1)
 	}
-	mmDelete.t.Fatalf("Unexpected call to OrderRepositoryMock.Delete. %v %v", ctx, i1)
+	mmAddOrUpdatePackagingType.t.Fatalf("Unexpected call to OrderRepositoryMock.AddOrUpdatePackagingType. %v %v", ctx, pp1)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished OrderRepositoryMock.Delete invocations
-func (mmDelete *OrderRepositoryMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// AddOrUpdatePackagingTypeAfterCounter returns a count of finished OrderRepositoryMock.AddOrUpdatePackagingType invocations
+func (mmAddOrUpdatePackagingType *OrderRepositoryMock) AddOrUpdatePackagingTypeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddOrUpdatePackagingType.afterAddOrUpdatePackagingTypeCounter)
 }
 
-// DeleteBeforeCounter returns a count of OrderRepositoryMock.Delete invocations
-func (mmDelete *OrderRepositoryMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// AddOrUpdatePackagingTypeBeforeCounter returns a count of OrderRepositoryMock.AddOrUpdatePackagingType invocations
+func (mmAddOrUpdatePackagingType *OrderRepositoryMock) AddOrUpdatePackagingTypeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddOrUpdatePackagingType.beforeAddOrUpdatePackagingTypeCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderRepositoryMock.Delete.
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.AddOrUpdatePackagingType.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mOrderRepositoryMockDelete) Calls() []*OrderRepositoryMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmAddOrUpdatePackagingType *mOrderRepositoryMockAddOrUpdatePackagingType) Calls() []*OrderRepositoryMockAddOrUpdatePackagingTypeParams {
+	mmAddOrUpdatePackagingType.mutex.RLock()
 
-	argCopy := make([]*OrderRepositoryMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*OrderRepositoryMockAddOrUpdatePackagingTypeParams, len(mmAddOrUpdatePackagingType.callArgs))
+	copy(argCopy, mmAddOrUpdatePackagingType.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmAddOrUpdatePackagingType.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockAddOrUpdatePackagingTypeDone returns true if the count of the AddOrUpdatePackagingType invocations corresponds
 // the number of defined expectations
-func (m *OrderRepositoryMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *OrderRepositoryMock) MinimockAddOrUpdatePackagingTypeDone() bool {
+	if m.AddOrUpdatePackagingTypeMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.AddOrUpdatePackagingTypeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.AddOrUpdatePackagingTypeMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *OrderRepositoryMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockAddOrUpdatePackagingTypeInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockAddOrUpdatePackagingTypeInspect() {
+	for _, e := range m.AddOrUpdatePackagingTypeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdatePackagingType at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterAddOrUpdatePackagingTypeCounter := mm_atomic.LoadUint64(&m.afterAddOrUpdatePackagingTypeCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.AddOrUpdatePackagingTypeMock.defaultExpectation != nil && afterAddOrUpdatePackagingTypeCounter < 1 {
+		if m.AddOrUpdatePackagingTypeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdatePackagingType at\n%s", m.AddOrUpdatePackagingTypeMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdatePackagingType at\n%s with params: %#v", m.AddOrUpdatePackagingTypeMock.defaultExpectation.expectationOrigins.origin, *m.AddOrUpdatePackagingTypeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcAddOrUpdatePackagingType != nil && afterAddOrUpdatePackagingTypeCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdatePackagingType at\n%s", m.funcAddOrUpdatePackagingTypeOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderRepositoryMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.AddOrUpdatePackagingTypeMock.invocationsDone() && afterAddOrUpdatePackagingTypeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.AddOrUpdatePackagingType at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddOrUpdatePackagingTypeMock.expectedInvocations), m.AddOrUpdatePackagingTypeMock.expectedInvocationsOrigin, afterAddOrUpdatePackagingTypeCounter)
 	}
 }
 
-type mOrderRepositoryMockDeleteBy struct {
+type mOrderRepositoryMockAddOrUpdateWithinCapacity struct {
 	optional           bool
 	mock               *OrderRepositoryMock
-	defaultExpectation *OrderRepositoryMockDeleteByExpectation
-	expectations       []*OrderRepositoryMockDeleteByExpectation
+	defaultExpectation *OrderRepositoryMockAddOrUpdateWithinCapacityExpectation
+	expectations       []*OrderRepositoryMockAddOrUpdateWithinCapacityExpectation
 
-	callArgs []*OrderRepositoryMockDeleteByParams
+	callArgs []*OrderRepositoryMockAddOrUpdateWithinCapacityParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrderRepositoryMockDeleteByExpectation specifies expectation struct of the Repository.DeleteBy
-type OrderRepositoryMockDeleteByExpectation struct {
+// OrderRepositoryMockAddOrUpdateWithinCapacityExpectation specifies expectation struct of the Repository.AddOrUpdateWithinCapacity
+type OrderRepositoryMockAddOrUpdateWithinCapacityExpectation struct {
 	mock               *OrderRepositoryMock
-	params             *OrderRepositoryMockDeleteByParams
-	paramPtrs          *OrderRepositoryMockDeleteByParamPtrs
-	expectationOrigins OrderRepositoryMockDeleteByExpectationOrigins
-	results            *OrderRepositoryMockDeleteByResults
+	params             *OrderRepositoryMockAddOrUpdateWithinCapacityParams
+	paramPtrs          *OrderRepositoryMockAddOrUpdateWithinCapacityParamPtrs
+	expectationOrigins OrderRepositoryMockAddOrUpdateWithinCapacityExpectationOrigins
+	results            *OrderRepositoryMockAddOrUpdateWithinCapacityResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepositoryMockDeleteByParams contains parameters of the Repository.DeleteBy
-type OrderRepositoryMockDeleteByParams struct {
+// OrderRepositoryMockAddOrUpdateWithinCapacityParams contains parameters of the Repository.AddOrUpdateWithinCapacity
+type OrderRepositoryMockAddOrUpdateWithinCapacityParams struct {
 	ctx context.Context
-	fp1 *order.Filter
+	op1 *order.Order
+	ea1 []order.Event
 }
 
-// OrderRepositoryMockDeleteByParamPtrs contains pointers to parameters of the Repository.DeleteBy
-type OrderRepositoryMockDeleteByParamPtrs struct {
+// OrderRepositoryMockAddOrUpdateWithinCapacityParamPtrs contains pointers to parameters of the Repository.AddOrUpdateWithinCapacity
+type OrderRepositoryMockAddOrUpdateWithinCapacityParamPtrs struct {
 	ctx *context.Context
-	fp1 **order.Filter
+	op1 **order.Order
+	ea1 *[]order.Event
 }
 
-// OrderRepositoryMockDeleteByResults contains results of the Repository.DeleteBy
-type OrderRepositoryMockDeleteByResults struct {
-	err error
+// OrderRepositoryMockAddOrUpdateWithinCapacityResults contains results of the Repository.AddOrUpdateWithinCapacity
+type OrderRepositoryMockAddOrUpdateWithinCapacityResults struct {
+	exists bool
+	err    error
 }
 
-// OrderRepositoryMockDeleteByOrigins contains origins of expectations of the Repository.DeleteBy
-type OrderRepositoryMockDeleteByExpectationOrigins struct {
+// OrderRepositoryMockAddOrUpdateWithinCapacityOrigins contains origins of expectations of the Repository.AddOrUpdateWithinCapacity
+type OrderRepositoryMockAddOrUpdateWithinCapacityExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originFp1 string
+	originOp1 string
+	originEa1 string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1184,342 +1797,6012 @@ type OrderRepositoryMockDeleteByExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Optional() *mOrderRepositoryMockDeleteBy {
-	mmDeleteBy.optional = true
-	return mmDeleteBy
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Optional() *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	mmAddOrUpdateWithinCapacity.optional = true
+	return mmAddOrUpdateWithinCapacity
 }
 
-// Expect sets up expected params for Repository.DeleteBy
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Expect(ctx context.Context, fp1 *order.Filter) *mOrderRepositoryMockDeleteBy {
-	if mmDeleteBy.mock.funcDeleteBy != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+// Expect sets up expected params for Repository.AddOrUpdateWithinCapacity
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Expect(ctx context.Context, op1 *order.Order, ea1 ...order.Event) *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	if mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Set")
 	}
 
-	if mmDeleteBy.defaultExpectation == nil {
-		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation = &OrderRepositoryMockAddOrUpdateWithinCapacityExpectation{}
 	}
 
-	if mmDeleteBy.defaultExpectation.paramPtrs != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by ExpectParams functions")
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteBy.defaultExpectation.params = &OrderRepositoryMockDeleteByParams{ctx, fp1}
-	mmDeleteBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteBy.expectations {
-		if minimock.Equal(e.params, mmDeleteBy.defaultExpectation.params) {
-			mmDeleteBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteBy.defaultExpectation.params)
+	mmAddOrUpdateWithinCapacity.defaultExpectation.params = &OrderRepositoryMockAddOrUpdateWithinCapacityParams{ctx, op1, ea1}
+	mmAddOrUpdateWithinCapacity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddOrUpdateWithinCapacity.expectations {
+		if minimock.Equal(e.params, mmAddOrUpdateWithinCapacity.defaultExpectation.params) {
+			mmAddOrUpdateWithinCapacity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddOrUpdateWithinCapacity.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteBy
+	return mmAddOrUpdateWithinCapacity
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteBy
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockDeleteBy {
-	if mmDeleteBy.mock.funcDeleteBy != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddOrUpdateWithinCapacity
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	if mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Set")
 	}
 
-	if mmDeleteBy.defaultExpectation == nil {
-		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation = &OrderRepositoryMockAddOrUpdateWithinCapacityExpectation{}
 	}
 
-	if mmDeleteBy.defaultExpectation.params != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Expect")
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.params != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Expect")
 	}
 
-	if mmDeleteBy.defaultExpectation.paramPtrs == nil {
-		mmDeleteBy.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteByParamPtrs{}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdateWithinCapacityParamPtrs{}
 	}
-	mmDeleteBy.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddOrUpdateWithinCapacity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteBy
+	return mmAddOrUpdateWithinCapacity
 }
 
-// ExpectFp1Param2 sets up expected param fp1 for Repository.DeleteBy
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) ExpectFp1Param2(fp1 *order.Filter) *mOrderRepositoryMockDeleteBy {
-	if mmDeleteBy.mock.funcDeleteBy != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+// ExpectOp1Param2 sets up expected param op1 for Repository.AddOrUpdateWithinCapacity
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) ExpectOp1Param2(op1 *order.Order) *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	if mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Set")
 	}
 
-	if mmDeleteBy.defaultExpectation == nil {
-		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation = &OrderRepositoryMockAddOrUpdateWithinCapacityExpectation{}
 	}
 
-	if mmDeleteBy.defaultExpectation.params != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Expect")
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.params != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Expect")
 	}
 
-	if mmDeleteBy.defaultExpectation.paramPtrs == nil {
-		mmDeleteBy.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteByParamPtrs{}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdateWithinCapacityParamPtrs{}
 	}
-	mmDeleteBy.defaultExpectation.paramPtrs.fp1 = &fp1
-	mmDeleteBy.defaultExpectation.expectationOrigins.originFp1 = minimock.CallerInfo(1)
+	mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs.op1 = &op1
+	mmAddOrUpdateWithinCapacity.defaultExpectation.expectationOrigins.originOp1 = minimock.CallerInfo(1)
 
-	return mmDeleteBy
+	return mmAddOrUpdateWithinCapacity
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.DeleteBy
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Inspect(f func(ctx context.Context, fp1 *order.Filter)) *mOrderRepositoryMockDeleteBy {
-	if mmDeleteBy.mock.inspectFuncDeleteBy != nil {
-		mmDeleteBy.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.DeleteBy")
+// ExpectEa1Param3 sets up expected param ea1 for Repository.AddOrUpdateWithinCapacity
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) ExpectEa1Param3(ea1 []order.Event) *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	if mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Set")
 	}
 
-	mmDeleteBy.mock.inspectFuncDeleteBy = f
-
-	return mmDeleteBy
-}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation = &OrderRepositoryMockAddOrUpdateWithinCapacityExpectation{}
+	}
 
-// Return sets up results that will be returned by Repository.DeleteBy
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Return(err error) *OrderRepositoryMock {
-	if mmDeleteBy.mock.funcDeleteBy != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.params != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Expect")
 	}
 
-	if mmDeleteBy.defaultExpectation == nil {
-		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{mock: mmDeleteBy.mock}
+	if mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs = &OrderRepositoryMockAddOrUpdateWithinCapacityParamPtrs{}
 	}
-	mmDeleteBy.defaultExpectation.results = &OrderRepositoryMockDeleteByResults{err}
-	mmDeleteBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteBy.mock
+	mmAddOrUpdateWithinCapacity.defaultExpectation.paramPtrs.ea1 = &ea1
+	mmAddOrUpdateWithinCapacity.defaultExpectation.expectationOrigins.originEa1 = minimock.CallerInfo(1)
+
+	return mmAddOrUpdateWithinCapacity
 }
 
-// Set uses given function f to mock the Repository.DeleteBy method
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Set(f func(ctx context.Context, fp1 *order.Filter) (err error)) *OrderRepositoryMock {
-	if mmDeleteBy.defaultExpectation != nil {
-		mmDeleteBy.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteBy method")
+// Inspect accepts an inspector function that has same arguments as the Repository.AddOrUpdateWithinCapacity
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Inspect(f func(ctx context.Context, op1 *order.Order, ea1 ...order.Event)) *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	if mmAddOrUpdateWithinCapacity.mock.inspectFuncAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.AddOrUpdateWithinCapacity")
 	}
 
-	if len(mmDeleteBy.expectations) > 0 {
-		mmDeleteBy.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteBy method")
-	}
+	mmAddOrUpdateWithinCapacity.mock.inspectFuncAddOrUpdateWithinCapacity = f
 
-	mmDeleteBy.mock.funcDeleteBy = f
-	mmDeleteBy.mock.funcDeleteByOrigin = minimock.CallerInfo(1)
-	return mmDeleteBy.mock
+	return mmAddOrUpdateWithinCapacity
+}
+
+// Return sets up results that will be returned by Repository.AddOrUpdateWithinCapacity
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Return(exists bool, err error) *OrderRepositoryMock {
+	if mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Set")
+	}
+
+	if mmAddOrUpdateWithinCapacity.defaultExpectation == nil {
+		mmAddOrUpdateWithinCapacity.defaultExpectation = &OrderRepositoryMockAddOrUpdateWithinCapacityExpectation{mock: mmAddOrUpdateWithinCapacity.mock}
+	}
+	mmAddOrUpdateWithinCapacity.defaultExpectation.results = &OrderRepositoryMockAddOrUpdateWithinCapacityResults{exists, err}
+	mmAddOrUpdateWithinCapacity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddOrUpdateWithinCapacity.mock
+}
+
+// Set uses given function f to mock the Repository.AddOrUpdateWithinCapacity method
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Set(f func(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (exists bool, err error)) *OrderRepositoryMock {
+	if mmAddOrUpdateWithinCapacity.defaultExpectation != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("Default expectation is already set for the Repository.AddOrUpdateWithinCapacity method")
+	}
+
+	if len(mmAddOrUpdateWithinCapacity.expectations) > 0 {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("Some expectations are already set for the Repository.AddOrUpdateWithinCapacity method")
+	}
+
+	mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity = f
+	mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacityOrigin = minimock.CallerInfo(1)
+	return mmAddOrUpdateWithinCapacity.mock
+}
+
+// When sets expectation for the Repository.AddOrUpdateWithinCapacity which will trigger the result defined by the following
+// Then helper
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) When(ctx context.Context, op1 *order.Order, ea1 ...order.Event) *OrderRepositoryMockAddOrUpdateWithinCapacityExpectation {
+	if mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("OrderRepositoryMock.AddOrUpdateWithinCapacity mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockAddOrUpdateWithinCapacityExpectation{
+		mock:               mmAddOrUpdateWithinCapacity.mock,
+		params:             &OrderRepositoryMockAddOrUpdateWithinCapacityParams{ctx, op1, ea1},
+		expectationOrigins: OrderRepositoryMockAddOrUpdateWithinCapacityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddOrUpdateWithinCapacity.expectations = append(mmAddOrUpdateWithinCapacity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.AddOrUpdateWithinCapacity return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockAddOrUpdateWithinCapacityExpectation) Then(exists bool, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockAddOrUpdateWithinCapacityResults{exists, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.AddOrUpdateWithinCapacity should be invoked
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Times(n uint64) *mOrderRepositoryMockAddOrUpdateWithinCapacity {
+	if n == 0 {
+		mmAddOrUpdateWithinCapacity.mock.t.Fatalf("Times of OrderRepositoryMock.AddOrUpdateWithinCapacity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddOrUpdateWithinCapacity.expectedInvocations, n)
+	mmAddOrUpdateWithinCapacity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddOrUpdateWithinCapacity
+}
+
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) invocationsDone() bool {
+	if len(mmAddOrUpdateWithinCapacity.expectations) == 0 && mmAddOrUpdateWithinCapacity.defaultExpectation == nil && mmAddOrUpdateWithinCapacity.mock.funcAddOrUpdateWithinCapacity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddOrUpdateWithinCapacity.mock.afterAddOrUpdateWithinCapacityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddOrUpdateWithinCapacity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddOrUpdateWithinCapacity implements mm_order.Repository
+func (mmAddOrUpdateWithinCapacity *OrderRepositoryMock) AddOrUpdateWithinCapacity(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (exists bool, err error) {
+	mm_atomic.AddUint64(&mmAddOrUpdateWithinCapacity.beforeAddOrUpdateWithinCapacityCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddOrUpdateWithinCapacity.afterAddOrUpdateWithinCapacityCounter, 1)
+
+	mmAddOrUpdateWithinCapacity.t.Helper()
+
+	if mmAddOrUpdateWithinCapacity.inspectFuncAddOrUpdateWithinCapacity != nil {
+		mmAddOrUpdateWithinCapacity.inspectFuncAddOrUpdateWithinCapacity(ctx, op1, ea1...)
+	}
+
+	mm_params := OrderRepositoryMockAddOrUpdateWithinCapacityParams{ctx, op1, ea1}
+
+	// Record call args
+	mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.mutex.Lock()
+	mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.callArgs = append(mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.callArgs, &mm_params)
+	mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.mutex.Unlock()
+
+	for _, e := range mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.exists, e.results.err
+		}
+	}
+
+	if mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.params
+		mm_want_ptrs := mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockAddOrUpdateWithinCapacityParams{ctx, op1, ea1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddOrUpdateWithinCapacity.t.Errorf("OrderRepositoryMock.AddOrUpdateWithinCapacity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.op1 != nil && !minimock.Equal(*mm_want_ptrs.op1, mm_got.op1) {
+				mmAddOrUpdateWithinCapacity.t.Errorf("OrderRepositoryMock.AddOrUpdateWithinCapacity got unexpected parameter op1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.expectationOrigins.originOp1, *mm_want_ptrs.op1, mm_got.op1, minimock.Diff(*mm_want_ptrs.op1, mm_got.op1))
+			}
+
+			if mm_want_ptrs.ea1 != nil && !minimock.Equal(*mm_want_ptrs.ea1, mm_got.ea1) {
+				mmAddOrUpdateWithinCapacity.t.Errorf("OrderRepositoryMock.AddOrUpdateWithinCapacity got unexpected parameter ea1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.expectationOrigins.originEa1, *mm_want_ptrs.ea1, mm_got.ea1, minimock.Diff(*mm_want_ptrs.ea1, mm_got.ea1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddOrUpdateWithinCapacity.t.Errorf("OrderRepositoryMock.AddOrUpdateWithinCapacity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddOrUpdateWithinCapacity.AddOrUpdateWithinCapacityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddOrUpdateWithinCapacity.t.Fatal("No results are set for the OrderRepositoryMock.AddOrUpdateWithinCapacity")
+		}
+		return (*mm_results).exists, (*mm_results).err
+	}
+	if mmAddOrUpdateWithinCapacity.funcAddOrUpdateWithinCapacity != nil {
+		return mmAddOrUpdateWithinCapacity.funcAddOrUpdateWithinCapacity(ctx, op1, ea1...)
+	}
+	mmAddOrUpdateWithinCapacity.t.Fatalf("Unexpected call to OrderRepositoryMock.AddOrUpdateWithinCapacity. %v %v %v", ctx, op1, ea1)
+	return
+}
+
+// AddOrUpdateWithinCapacityAfterCounter returns a count of finished OrderRepositoryMock.AddOrUpdateWithinCapacity invocations
+func (mmAddOrUpdateWithinCapacity *OrderRepositoryMock) AddOrUpdateWithinCapacityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddOrUpdateWithinCapacity.afterAddOrUpdateWithinCapacityCounter)
+}
+
+// AddOrUpdateWithinCapacityBeforeCounter returns a count of OrderRepositoryMock.AddOrUpdateWithinCapacity invocations
+func (mmAddOrUpdateWithinCapacity *OrderRepositoryMock) AddOrUpdateWithinCapacityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddOrUpdateWithinCapacity.beforeAddOrUpdateWithinCapacityCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.AddOrUpdateWithinCapacity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddOrUpdateWithinCapacity *mOrderRepositoryMockAddOrUpdateWithinCapacity) Calls() []*OrderRepositoryMockAddOrUpdateWithinCapacityParams {
+	mmAddOrUpdateWithinCapacity.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockAddOrUpdateWithinCapacityParams, len(mmAddOrUpdateWithinCapacity.callArgs))
+	copy(argCopy, mmAddOrUpdateWithinCapacity.callArgs)
+
+	mmAddOrUpdateWithinCapacity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddOrUpdateWithinCapacityDone returns true if the count of the AddOrUpdateWithinCapacity invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockAddOrUpdateWithinCapacityDone() bool {
+	if m.AddOrUpdateWithinCapacityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddOrUpdateWithinCapacityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddOrUpdateWithinCapacityMock.invocationsDone()
+}
+
+// MinimockAddOrUpdateWithinCapacityInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockAddOrUpdateWithinCapacityInspect() {
+	for _, e := range m.AddOrUpdateWithinCapacityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdateWithinCapacity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddOrUpdateWithinCapacityCounter := mm_atomic.LoadUint64(&m.afterAddOrUpdateWithinCapacityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddOrUpdateWithinCapacityMock.defaultExpectation != nil && afterAddOrUpdateWithinCapacityCounter < 1 {
+		if m.AddOrUpdateWithinCapacityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdateWithinCapacity at\n%s", m.AddOrUpdateWithinCapacityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdateWithinCapacity at\n%s with params: %#v", m.AddOrUpdateWithinCapacityMock.defaultExpectation.expectationOrigins.origin, *m.AddOrUpdateWithinCapacityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddOrUpdateWithinCapacity != nil && afterAddOrUpdateWithinCapacityCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.AddOrUpdateWithinCapacity at\n%s", m.funcAddOrUpdateWithinCapacityOrigin)
+	}
+
+	if !m.AddOrUpdateWithinCapacityMock.invocationsDone() && afterAddOrUpdateWithinCapacityCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.AddOrUpdateWithinCapacity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddOrUpdateWithinCapacityMock.expectedInvocations), m.AddOrUpdateWithinCapacityMock.expectedInvocationsOrigin, afterAddOrUpdateWithinCapacityCounter)
+	}
+}
+
+type mOrderRepositoryMockCreatePickupPoint struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockCreatePickupPointExpectation
+	expectations       []*OrderRepositoryMockCreatePickupPointExpectation
+
+	callArgs []*OrderRepositoryMockCreatePickupPointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockCreatePickupPointExpectation specifies expectation struct of the Repository.CreatePickupPoint
+type OrderRepositoryMockCreatePickupPointExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockCreatePickupPointParams
+	paramPtrs          *OrderRepositoryMockCreatePickupPointParamPtrs
+	expectationOrigins OrderRepositoryMockCreatePickupPointExpectationOrigins
+	results            *OrderRepositoryMockCreatePickupPointResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockCreatePickupPointParams contains parameters of the Repository.CreatePickupPoint
+type OrderRepositoryMockCreatePickupPointParams struct {
+	ctx context.Context
+	pp1 *pickuppoint.PickupPoint
+}
+
+// OrderRepositoryMockCreatePickupPointParamPtrs contains pointers to parameters of the Repository.CreatePickupPoint
+type OrderRepositoryMockCreatePickupPointParamPtrs struct {
+	ctx *context.Context
+	pp1 **pickuppoint.PickupPoint
+}
+
+// OrderRepositoryMockCreatePickupPointResults contains results of the Repository.CreatePickupPoint
+type OrderRepositoryMockCreatePickupPointResults struct {
+	err error
+}
+
+// OrderRepositoryMockCreatePickupPointOrigins contains origins of expectations of the Repository.CreatePickupPoint
+type OrderRepositoryMockCreatePickupPointExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originPp1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Optional() *mOrderRepositoryMockCreatePickupPoint {
+	mmCreatePickupPoint.optional = true
+	return mmCreatePickupPoint
+}
+
+// Expect sets up expected params for Repository.CreatePickupPoint
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Expect(ctx context.Context, pp1 *pickuppoint.PickupPoint) *mOrderRepositoryMockCreatePickupPoint {
+	if mmCreatePickupPoint.mock.funcCreatePickupPoint != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Set")
+	}
+
+	if mmCreatePickupPoint.defaultExpectation == nil {
+		mmCreatePickupPoint.defaultExpectation = &OrderRepositoryMockCreatePickupPointExpectation{}
+	}
+
+	if mmCreatePickupPoint.defaultExpectation.paramPtrs != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePickupPoint.defaultExpectation.params = &OrderRepositoryMockCreatePickupPointParams{ctx, pp1}
+	mmCreatePickupPoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePickupPoint.expectations {
+		if minimock.Equal(e.params, mmCreatePickupPoint.defaultExpectation.params) {
+			mmCreatePickupPoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePickupPoint.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePickupPoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreatePickupPoint
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockCreatePickupPoint {
+	if mmCreatePickupPoint.mock.funcCreatePickupPoint != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Set")
+	}
+
+	if mmCreatePickupPoint.defaultExpectation == nil {
+		mmCreatePickupPoint.defaultExpectation = &OrderRepositoryMockCreatePickupPointExpectation{}
+	}
+
+	if mmCreatePickupPoint.defaultExpectation.params != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Expect")
+	}
+
+	if mmCreatePickupPoint.defaultExpectation.paramPtrs == nil {
+		mmCreatePickupPoint.defaultExpectation.paramPtrs = &OrderRepositoryMockCreatePickupPointParamPtrs{}
+	}
+	mmCreatePickupPoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePickupPoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePickupPoint
+}
+
+// ExpectPp1Param2 sets up expected param pp1 for Repository.CreatePickupPoint
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) ExpectPp1Param2(pp1 *pickuppoint.PickupPoint) *mOrderRepositoryMockCreatePickupPoint {
+	if mmCreatePickupPoint.mock.funcCreatePickupPoint != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Set")
+	}
+
+	if mmCreatePickupPoint.defaultExpectation == nil {
+		mmCreatePickupPoint.defaultExpectation = &OrderRepositoryMockCreatePickupPointExpectation{}
+	}
+
+	if mmCreatePickupPoint.defaultExpectation.params != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Expect")
+	}
+
+	if mmCreatePickupPoint.defaultExpectation.paramPtrs == nil {
+		mmCreatePickupPoint.defaultExpectation.paramPtrs = &OrderRepositoryMockCreatePickupPointParamPtrs{}
+	}
+	mmCreatePickupPoint.defaultExpectation.paramPtrs.pp1 = &pp1
+	mmCreatePickupPoint.defaultExpectation.expectationOrigins.originPp1 = minimock.CallerInfo(1)
+
+	return mmCreatePickupPoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreatePickupPoint
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Inspect(f func(ctx context.Context, pp1 *pickuppoint.PickupPoint)) *mOrderRepositoryMockCreatePickupPoint {
+	if mmCreatePickupPoint.mock.inspectFuncCreatePickupPoint != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.CreatePickupPoint")
+	}
+
+	mmCreatePickupPoint.mock.inspectFuncCreatePickupPoint = f
+
+	return mmCreatePickupPoint
+}
+
+// Return sets up results that will be returned by Repository.CreatePickupPoint
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Return(err error) *OrderRepositoryMock {
+	if mmCreatePickupPoint.mock.funcCreatePickupPoint != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Set")
+	}
+
+	if mmCreatePickupPoint.defaultExpectation == nil {
+		mmCreatePickupPoint.defaultExpectation = &OrderRepositoryMockCreatePickupPointExpectation{mock: mmCreatePickupPoint.mock}
+	}
+	mmCreatePickupPoint.defaultExpectation.results = &OrderRepositoryMockCreatePickupPointResults{err}
+	mmCreatePickupPoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePickupPoint.mock
+}
+
+// Set uses given function f to mock the Repository.CreatePickupPoint method
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Set(f func(ctx context.Context, pp1 *pickuppoint.PickupPoint) (err error)) *OrderRepositoryMock {
+	if mmCreatePickupPoint.defaultExpectation != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("Default expectation is already set for the Repository.CreatePickupPoint method")
+	}
+
+	if len(mmCreatePickupPoint.expectations) > 0 {
+		mmCreatePickupPoint.mock.t.Fatalf("Some expectations are already set for the Repository.CreatePickupPoint method")
+	}
+
+	mmCreatePickupPoint.mock.funcCreatePickupPoint = f
+	mmCreatePickupPoint.mock.funcCreatePickupPointOrigin = minimock.CallerInfo(1)
+	return mmCreatePickupPoint.mock
+}
+
+// When sets expectation for the Repository.CreatePickupPoint which will trigger the result defined by the following
+// Then helper
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) When(ctx context.Context, pp1 *pickuppoint.PickupPoint) *OrderRepositoryMockCreatePickupPointExpectation {
+	if mmCreatePickupPoint.mock.funcCreatePickupPoint != nil {
+		mmCreatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.CreatePickupPoint mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockCreatePickupPointExpectation{
+		mock:               mmCreatePickupPoint.mock,
+		params:             &OrderRepositoryMockCreatePickupPointParams{ctx, pp1},
+		expectationOrigins: OrderRepositoryMockCreatePickupPointExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePickupPoint.expectations = append(mmCreatePickupPoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreatePickupPoint return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockCreatePickupPointExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockCreatePickupPointResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreatePickupPoint should be invoked
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Times(n uint64) *mOrderRepositoryMockCreatePickupPoint {
+	if n == 0 {
+		mmCreatePickupPoint.mock.t.Fatalf("Times of OrderRepositoryMock.CreatePickupPoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePickupPoint.expectedInvocations, n)
+	mmCreatePickupPoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePickupPoint
+}
+
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) invocationsDone() bool {
+	if len(mmCreatePickupPoint.expectations) == 0 && mmCreatePickupPoint.defaultExpectation == nil && mmCreatePickupPoint.mock.funcCreatePickupPoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePickupPoint.mock.afterCreatePickupPointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePickupPoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePickupPoint implements mm_order.Repository
+func (mmCreatePickupPoint *OrderRepositoryMock) CreatePickupPoint(ctx context.Context, pp1 *pickuppoint.PickupPoint) (err error) {
+	mm_atomic.AddUint64(&mmCreatePickupPoint.beforeCreatePickupPointCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePickupPoint.afterCreatePickupPointCounter, 1)
+
+	mmCreatePickupPoint.t.Helper()
+
+	if mmCreatePickupPoint.inspectFuncCreatePickupPoint != nil {
+		mmCreatePickupPoint.inspectFuncCreatePickupPoint(ctx, pp1)
+	}
+
+	mm_params := OrderRepositoryMockCreatePickupPointParams{ctx, pp1}
+
+	// Record call args
+	mmCreatePickupPoint.CreatePickupPointMock.mutex.Lock()
+	mmCreatePickupPoint.CreatePickupPointMock.callArgs = append(mmCreatePickupPoint.CreatePickupPointMock.callArgs, &mm_params)
+	mmCreatePickupPoint.CreatePickupPointMock.mutex.Unlock()
+
+	for _, e := range mmCreatePickupPoint.CreatePickupPointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockCreatePickupPointParams{ctx, pp1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePickupPoint.t.Errorf("OrderRepositoryMock.CreatePickupPoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pp1 != nil && !minimock.Equal(*mm_want_ptrs.pp1, mm_got.pp1) {
+				mmCreatePickupPoint.t.Errorf("OrderRepositoryMock.CreatePickupPoint got unexpected parameter pp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.expectationOrigins.originPp1, *mm_want_ptrs.pp1, mm_got.pp1, minimock.Diff(*mm_want_ptrs.pp1, mm_got.pp1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePickupPoint.t.Errorf("OrderRepositoryMock.CreatePickupPoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePickupPoint.CreatePickupPointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePickupPoint.t.Fatal("No results are set for the OrderRepositoryMock.CreatePickupPoint")
+		}
+		return (*mm_results).err
+	}
+	if mmCreatePickupPoint.funcCreatePickupPoint != nil {
+		return mmCreatePickupPoint.funcCreatePickupPoint(ctx, pp1)
+	}
+	mmCreatePickupPoint.t.Fatalf("Unexpected call to OrderRepositoryMock.CreatePickupPoint. %v %v", ctx, pp1)
+	return
+}
+
+// CreatePickupPointAfterCounter returns a count of finished OrderRepositoryMock.CreatePickupPoint invocations
+func (mmCreatePickupPoint *OrderRepositoryMock) CreatePickupPointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePickupPoint.afterCreatePickupPointCounter)
+}
+
+// CreatePickupPointBeforeCounter returns a count of OrderRepositoryMock.CreatePickupPoint invocations
+func (mmCreatePickupPoint *OrderRepositoryMock) CreatePickupPointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePickupPoint.beforeCreatePickupPointCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.CreatePickupPoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePickupPoint *mOrderRepositoryMockCreatePickupPoint) Calls() []*OrderRepositoryMockCreatePickupPointParams {
+	mmCreatePickupPoint.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockCreatePickupPointParams, len(mmCreatePickupPoint.callArgs))
+	copy(argCopy, mmCreatePickupPoint.callArgs)
+
+	mmCreatePickupPoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePickupPointDone returns true if the count of the CreatePickupPoint invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockCreatePickupPointDone() bool {
+	if m.CreatePickupPointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePickupPointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePickupPointMock.invocationsDone()
+}
+
+// MinimockCreatePickupPointInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockCreatePickupPointInspect() {
+	for _, e := range m.CreatePickupPointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.CreatePickupPoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePickupPointCounter := mm_atomic.LoadUint64(&m.afterCreatePickupPointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePickupPointMock.defaultExpectation != nil && afterCreatePickupPointCounter < 1 {
+		if m.CreatePickupPointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.CreatePickupPoint at\n%s", m.CreatePickupPointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.CreatePickupPoint at\n%s with params: %#v", m.CreatePickupPointMock.defaultExpectation.expectationOrigins.origin, *m.CreatePickupPointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePickupPoint != nil && afterCreatePickupPointCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.CreatePickupPoint at\n%s", m.funcCreatePickupPointOrigin)
+	}
+
+	if !m.CreatePickupPointMock.invocationsDone() && afterCreatePickupPointCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.CreatePickupPoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePickupPointMock.expectedInvocations), m.CreatePickupPointMock.expectedInvocationsOrigin, afterCreatePickupPointCounter)
+	}
+}
+
+type mOrderRepositoryMockDelete struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockDeleteExpectation
+	expectations       []*OrderRepositoryMockDeleteExpectation
+
+	callArgs []*OrderRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockDeleteExpectation specifies expectation struct of the Repository.Delete
+type OrderRepositoryMockDeleteExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockDeleteParams
+	paramPtrs          *OrderRepositoryMockDeleteParamPtrs
+	expectationOrigins OrderRepositoryMockDeleteExpectationOrigins
+	results            *OrderRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockDeleteParams contains parameters of the Repository.Delete
+type OrderRepositoryMockDeleteParams struct {
+	ctx context.Context
+	i1  basetypes.ID
+}
+
+// OrderRepositoryMockDeleteParamPtrs contains pointers to parameters of the Repository.Delete
+type OrderRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	i1  *basetypes.ID
+}
+
+// OrderRepositoryMockDeleteResults contains results of the Repository.Delete
+type OrderRepositoryMockDeleteResults struct {
+	op1 *order.Order
+	err error
+}
+
+// OrderRepositoryMockDeleteOrigins contains origins of expectations of the Repository.Delete
+type OrderRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originI1  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mOrderRepositoryMockDelete) Optional() *mOrderRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for Repository.Delete
+func (mmDelete *mOrderRepositoryMockDelete) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &OrderRepositoryMockDeleteParams{ctx, i1}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Delete
+func (mmDelete *mOrderRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectI1Param2 sets up expected param i1 for Repository.Delete
+func (mmDelete *mOrderRepositoryMockDelete) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.i1 = &i1
+	mmDelete.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Delete
+func (mmDelete *mOrderRepositoryMockDelete) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by Repository.Delete
+func (mmDelete *mOrderRepositoryMockDelete) Return(op1 *order.Order, err error) *OrderRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &OrderRepositoryMockDeleteResults{op1, err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the Repository.Delete method
+func (mmDelete *mOrderRepositoryMockDelete) Set(f func(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error)) *OrderRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the Repository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the Repository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the Repository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mOrderRepositoryMockDelete) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &OrderRepositoryMockDeleteParams{ctx, i1},
+		expectationOrigins: OrderRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Delete return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockDeleteExpectation) Then(op1 *order.Order, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockDeleteResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Delete should be invoked
+func (mmDelete *mOrderRepositoryMockDelete) Times(n uint64) *mOrderRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of OrderRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mOrderRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_order.Repository
+func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, i1)
+	}
+
+	mm_params := OrderRepositoryMockDeleteParams{ctx, i1}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockDeleteParams{ctx, i1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
+				mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the OrderRepositoryMock.Delete")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, i1)
+	}
+	mmDelete.t.Fatalf("Unexpected call to OrderRepositoryMock.Delete. %v %v", ctx, i1)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished OrderRepositoryMock.Delete invocations
+func (mmDelete *OrderRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of OrderRepositoryMock.Delete invocations
+func (mmDelete *OrderRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mOrderRepositoryMockDelete) Calls() []*OrderRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mOrderRepositoryMockDeleteBy struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockDeleteByExpectation
+	expectations       []*OrderRepositoryMockDeleteByExpectation
+
+	callArgs []*OrderRepositoryMockDeleteByParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockDeleteByExpectation specifies expectation struct of the Repository.DeleteBy
+type OrderRepositoryMockDeleteByExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockDeleteByParams
+	paramPtrs          *OrderRepositoryMockDeleteByParamPtrs
+	expectationOrigins OrderRepositoryMockDeleteByExpectationOrigins
+	results            *OrderRepositoryMockDeleteByResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockDeleteByParams contains parameters of the Repository.DeleteBy
+type OrderRepositoryMockDeleteByParams struct {
+	ctx context.Context
+	fp1 *order.Filter
+}
+
+// OrderRepositoryMockDeleteByParamPtrs contains pointers to parameters of the Repository.DeleteBy
+type OrderRepositoryMockDeleteByParamPtrs struct {
+	ctx *context.Context
+	fp1 **order.Filter
+}
+
+// OrderRepositoryMockDeleteByResults contains results of the Repository.DeleteBy
+type OrderRepositoryMockDeleteByResults struct {
+	opa1 []*order.Order
+	err  error
+}
+
+// OrderRepositoryMockDeleteByOrigins contains origins of expectations of the Repository.DeleteBy
+type OrderRepositoryMockDeleteByExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFp1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Optional() *mOrderRepositoryMockDeleteBy {
+	mmDeleteBy.optional = true
+	return mmDeleteBy
+}
+
+// Expect sets up expected params for Repository.DeleteBy
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Expect(ctx context.Context, fp1 *order.Filter) *mOrderRepositoryMockDeleteBy {
+	if mmDeleteBy.mock.funcDeleteBy != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+	}
+
+	if mmDeleteBy.defaultExpectation == nil {
+		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{}
+	}
+
+	if mmDeleteBy.defaultExpectation.paramPtrs != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteBy.defaultExpectation.params = &OrderRepositoryMockDeleteByParams{ctx, fp1}
+	mmDeleteBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteBy.expectations {
+		if minimock.Equal(e.params, mmDeleteBy.defaultExpectation.params) {
+			mmDeleteBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteBy.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteBy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteBy
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockDeleteBy {
+	if mmDeleteBy.mock.funcDeleteBy != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+	}
+
+	if mmDeleteBy.defaultExpectation == nil {
+		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{}
+	}
+
+	if mmDeleteBy.defaultExpectation.params != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Expect")
+	}
+
+	if mmDeleteBy.defaultExpectation.paramPtrs == nil {
+		mmDeleteBy.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteByParamPtrs{}
+	}
+	mmDeleteBy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteBy
+}
+
+// ExpectFp1Param2 sets up expected param fp1 for Repository.DeleteBy
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) ExpectFp1Param2(fp1 *order.Filter) *mOrderRepositoryMockDeleteBy {
+	if mmDeleteBy.mock.funcDeleteBy != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+	}
+
+	if mmDeleteBy.defaultExpectation == nil {
+		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{}
+	}
+
+	if mmDeleteBy.defaultExpectation.params != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Expect")
+	}
+
+	if mmDeleteBy.defaultExpectation.paramPtrs == nil {
+		mmDeleteBy.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteByParamPtrs{}
+	}
+	mmDeleteBy.defaultExpectation.paramPtrs.fp1 = &fp1
+	mmDeleteBy.defaultExpectation.expectationOrigins.originFp1 = minimock.CallerInfo(1)
+
+	return mmDeleteBy
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteBy
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Inspect(f func(ctx context.Context, fp1 *order.Filter)) *mOrderRepositoryMockDeleteBy {
+	if mmDeleteBy.mock.inspectFuncDeleteBy != nil {
+		mmDeleteBy.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.DeleteBy")
+	}
+
+	mmDeleteBy.mock.inspectFuncDeleteBy = f
+
+	return mmDeleteBy
+}
+
+// Return sets up results that will be returned by Repository.DeleteBy
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Return(opa1 []*order.Order, err error) *OrderRepositoryMock {
+	if mmDeleteBy.mock.funcDeleteBy != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+	}
+
+	if mmDeleteBy.defaultExpectation == nil {
+		mmDeleteBy.defaultExpectation = &OrderRepositoryMockDeleteByExpectation{mock: mmDeleteBy.mock}
+	}
+	mmDeleteBy.defaultExpectation.results = &OrderRepositoryMockDeleteByResults{opa1, err}
+	mmDeleteBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteBy.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteBy method
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Set(f func(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error)) *OrderRepositoryMock {
+	if mmDeleteBy.defaultExpectation != nil {
+		mmDeleteBy.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteBy method")
+	}
+
+	if len(mmDeleteBy.expectations) > 0 {
+		mmDeleteBy.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteBy method")
+	}
+
+	mmDeleteBy.mock.funcDeleteBy = f
+	mmDeleteBy.mock.funcDeleteByOrigin = minimock.CallerInfo(1)
+	return mmDeleteBy.mock
 }
 
 // When sets expectation for the Repository.DeleteBy which will trigger the result defined by the following
 // Then helper
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) When(ctx context.Context, fp1 *order.Filter) *OrderRepositoryMockDeleteByExpectation {
-	if mmDeleteBy.mock.funcDeleteBy != nil {
-		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) When(ctx context.Context, fp1 *order.Filter) *OrderRepositoryMockDeleteByExpectation {
+	if mmDeleteBy.mock.funcDeleteBy != nil {
+		mmDeleteBy.mock.t.Fatalf("OrderRepositoryMock.DeleteBy mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockDeleteByExpectation{
+		mock:               mmDeleteBy.mock,
+		params:             &OrderRepositoryMockDeleteByParams{ctx, fp1},
+		expectationOrigins: OrderRepositoryMockDeleteByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteBy.expectations = append(mmDeleteBy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteBy return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockDeleteByExpectation) Then(opa1 []*order.Order, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockDeleteByResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteBy should be invoked
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Times(n uint64) *mOrderRepositoryMockDeleteBy {
+	if n == 0 {
+		mmDeleteBy.mock.t.Fatalf("Times of OrderRepositoryMock.DeleteBy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteBy.expectedInvocations, n)
+	mmDeleteBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteBy
+}
+
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) invocationsDone() bool {
+	if len(mmDeleteBy.expectations) == 0 && mmDeleteBy.defaultExpectation == nil && mmDeleteBy.mock.funcDeleteBy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteBy.mock.afterDeleteByCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteBy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteBy implements mm_order.Repository
+func (mmDeleteBy *OrderRepositoryMock) DeleteBy(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error) {
+	mm_atomic.AddUint64(&mmDeleteBy.beforeDeleteByCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteBy.afterDeleteByCounter, 1)
+
+	mmDeleteBy.t.Helper()
+
+	if mmDeleteBy.inspectFuncDeleteBy != nil {
+		mmDeleteBy.inspectFuncDeleteBy(ctx, fp1)
+	}
+
+	mm_params := OrderRepositoryMockDeleteByParams{ctx, fp1}
+
+	// Record call args
+	mmDeleteBy.DeleteByMock.mutex.Lock()
+	mmDeleteBy.DeleteByMock.callArgs = append(mmDeleteBy.DeleteByMock.callArgs, &mm_params)
+	mmDeleteBy.DeleteByMock.mutex.Unlock()
+
+	for _, e := range mmDeleteBy.DeleteByMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmDeleteBy.DeleteByMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteBy.DeleteByMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteBy.DeleteByMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteBy.DeleteByMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockDeleteByParams{ctx, fp1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteBy.t.Errorf("OrderRepositoryMock.DeleteBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBy.DeleteByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fp1 != nil && !minimock.Equal(*mm_want_ptrs.fp1, mm_got.fp1) {
+				mmDeleteBy.t.Errorf("OrderRepositoryMock.DeleteBy got unexpected parameter fp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBy.DeleteByMock.defaultExpectation.expectationOrigins.originFp1, *mm_want_ptrs.fp1, mm_got.fp1, minimock.Diff(*mm_want_ptrs.fp1, mm_got.fp1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteBy.t.Errorf("OrderRepositoryMock.DeleteBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteBy.DeleteByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteBy.DeleteByMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteBy.t.Fatal("No results are set for the OrderRepositoryMock.DeleteBy")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmDeleteBy.funcDeleteBy != nil {
+		return mmDeleteBy.funcDeleteBy(ctx, fp1)
+	}
+	mmDeleteBy.t.Fatalf("Unexpected call to OrderRepositoryMock.DeleteBy. %v %v", ctx, fp1)
+	return
+}
+
+// DeleteByAfterCounter returns a count of finished OrderRepositoryMock.DeleteBy invocations
+func (mmDeleteBy *OrderRepositoryMock) DeleteByAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBy.afterDeleteByCounter)
+}
+
+// DeleteByBeforeCounter returns a count of OrderRepositoryMock.DeleteBy invocations
+func (mmDeleteBy *OrderRepositoryMock) DeleteByBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBy.beforeDeleteByCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.DeleteBy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Calls() []*OrderRepositoryMockDeleteByParams {
+	mmDeleteBy.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockDeleteByParams, len(mmDeleteBy.callArgs))
+	copy(argCopy, mmDeleteBy.callArgs)
+
+	mmDeleteBy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteByDone returns true if the count of the DeleteBy invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockDeleteByDone() bool {
+	if m.DeleteByMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteByMock.invocationsDone()
+}
+
+// MinimockDeleteByInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockDeleteByInspect() {
+	for _, e := range m.DeleteByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteByCounter := mm_atomic.LoadUint64(&m.afterDeleteByCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteByMock.defaultExpectation != nil && afterDeleteByCounter < 1 {
+		if m.DeleteByMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s", m.DeleteByMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s with params: %#v", m.DeleteByMock.defaultExpectation.expectationOrigins.origin, *m.DeleteByMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteBy != nil && afterDeleteByCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s", m.funcDeleteByOrigin)
+	}
+
+	if !m.DeleteByMock.invocationsDone() && afterDeleteByCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.DeleteBy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteByMock.expectedInvocations), m.DeleteByMock.expectedInvocationsOrigin, afterDeleteByCounter)
+	}
+}
+
+type mOrderRepositoryMockDeletePackagingType struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockDeletePackagingTypeExpectation
+	expectations       []*OrderRepositoryMockDeletePackagingTypeExpectation
+
+	callArgs []*OrderRepositoryMockDeletePackagingTypeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockDeletePackagingTypeExpectation specifies expectation struct of the Repository.DeletePackagingType
+type OrderRepositoryMockDeletePackagingTypeExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockDeletePackagingTypeParams
+	paramPtrs          *OrderRepositoryMockDeletePackagingTypeParamPtrs
+	expectationOrigins OrderRepositoryMockDeletePackagingTypeExpectationOrigins
+	results            *OrderRepositoryMockDeletePackagingTypeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockDeletePackagingTypeParams contains parameters of the Repository.DeletePackagingType
+type OrderRepositoryMockDeletePackagingTypeParams struct {
+	ctx  context.Context
+	name string
+}
+
+// OrderRepositoryMockDeletePackagingTypeParamPtrs contains pointers to parameters of the Repository.DeletePackagingType
+type OrderRepositoryMockDeletePackagingTypeParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// OrderRepositoryMockDeletePackagingTypeResults contains results of the Repository.DeletePackagingType
+type OrderRepositoryMockDeletePackagingTypeResults struct {
+	err error
+}
+
+// OrderRepositoryMockDeletePackagingTypeOrigins contains origins of expectations of the Repository.DeletePackagingType
+type OrderRepositoryMockDeletePackagingTypeExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Optional() *mOrderRepositoryMockDeletePackagingType {
+	mmDeletePackagingType.optional = true
+	return mmDeletePackagingType
+}
+
+// Expect sets up expected params for Repository.DeletePackagingType
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Expect(ctx context.Context, name string) *mOrderRepositoryMockDeletePackagingType {
+	if mmDeletePackagingType.mock.funcDeletePackagingType != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Set")
+	}
+
+	if mmDeletePackagingType.defaultExpectation == nil {
+		mmDeletePackagingType.defaultExpectation = &OrderRepositoryMockDeletePackagingTypeExpectation{}
+	}
+
+	if mmDeletePackagingType.defaultExpectation.paramPtrs != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePackagingType.defaultExpectation.params = &OrderRepositoryMockDeletePackagingTypeParams{ctx, name}
+	mmDeletePackagingType.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePackagingType.expectations {
+		if minimock.Equal(e.params, mmDeletePackagingType.defaultExpectation.params) {
+			mmDeletePackagingType.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePackagingType.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePackagingType
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeletePackagingType
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockDeletePackagingType {
+	if mmDeletePackagingType.mock.funcDeletePackagingType != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Set")
+	}
+
+	if mmDeletePackagingType.defaultExpectation == nil {
+		mmDeletePackagingType.defaultExpectation = &OrderRepositoryMockDeletePackagingTypeExpectation{}
+	}
+
+	if mmDeletePackagingType.defaultExpectation.params != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Expect")
+	}
+
+	if mmDeletePackagingType.defaultExpectation.paramPtrs == nil {
+		mmDeletePackagingType.defaultExpectation.paramPtrs = &OrderRepositoryMockDeletePackagingTypeParamPtrs{}
+	}
+	mmDeletePackagingType.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePackagingType.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePackagingType
+}
+
+// ExpectNameParam2 sets up expected param name for Repository.DeletePackagingType
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) ExpectNameParam2(name string) *mOrderRepositoryMockDeletePackagingType {
+	if mmDeletePackagingType.mock.funcDeletePackagingType != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Set")
+	}
+
+	if mmDeletePackagingType.defaultExpectation == nil {
+		mmDeletePackagingType.defaultExpectation = &OrderRepositoryMockDeletePackagingTypeExpectation{}
+	}
+
+	if mmDeletePackagingType.defaultExpectation.params != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Expect")
+	}
+
+	if mmDeletePackagingType.defaultExpectation.paramPtrs == nil {
+		mmDeletePackagingType.defaultExpectation.paramPtrs = &OrderRepositoryMockDeletePackagingTypeParamPtrs{}
+	}
+	mmDeletePackagingType.defaultExpectation.paramPtrs.name = &name
+	mmDeletePackagingType.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeletePackagingType
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeletePackagingType
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Inspect(f func(ctx context.Context, name string)) *mOrderRepositoryMockDeletePackagingType {
+	if mmDeletePackagingType.mock.inspectFuncDeletePackagingType != nil {
+		mmDeletePackagingType.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.DeletePackagingType")
+	}
+
+	mmDeletePackagingType.mock.inspectFuncDeletePackagingType = f
+
+	return mmDeletePackagingType
+}
+
+// Return sets up results that will be returned by Repository.DeletePackagingType
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Return(err error) *OrderRepositoryMock {
+	if mmDeletePackagingType.mock.funcDeletePackagingType != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Set")
+	}
+
+	if mmDeletePackagingType.defaultExpectation == nil {
+		mmDeletePackagingType.defaultExpectation = &OrderRepositoryMockDeletePackagingTypeExpectation{mock: mmDeletePackagingType.mock}
+	}
+	mmDeletePackagingType.defaultExpectation.results = &OrderRepositoryMockDeletePackagingTypeResults{err}
+	mmDeletePackagingType.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePackagingType.mock
+}
+
+// Set uses given function f to mock the Repository.DeletePackagingType method
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Set(f func(ctx context.Context, name string) (err error)) *OrderRepositoryMock {
+	if mmDeletePackagingType.defaultExpectation != nil {
+		mmDeletePackagingType.mock.t.Fatalf("Default expectation is already set for the Repository.DeletePackagingType method")
+	}
+
+	if len(mmDeletePackagingType.expectations) > 0 {
+		mmDeletePackagingType.mock.t.Fatalf("Some expectations are already set for the Repository.DeletePackagingType method")
+	}
+
+	mmDeletePackagingType.mock.funcDeletePackagingType = f
+	mmDeletePackagingType.mock.funcDeletePackagingTypeOrigin = minimock.CallerInfo(1)
+	return mmDeletePackagingType.mock
+}
+
+// When sets expectation for the Repository.DeletePackagingType which will trigger the result defined by the following
+// Then helper
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) When(ctx context.Context, name string) *OrderRepositoryMockDeletePackagingTypeExpectation {
+	if mmDeletePackagingType.mock.funcDeletePackagingType != nil {
+		mmDeletePackagingType.mock.t.Fatalf("OrderRepositoryMock.DeletePackagingType mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockDeletePackagingTypeExpectation{
+		mock:               mmDeletePackagingType.mock,
+		params:             &OrderRepositoryMockDeletePackagingTypeParams{ctx, name},
+		expectationOrigins: OrderRepositoryMockDeletePackagingTypeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePackagingType.expectations = append(mmDeletePackagingType.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeletePackagingType return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockDeletePackagingTypeExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockDeletePackagingTypeResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeletePackagingType should be invoked
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Times(n uint64) *mOrderRepositoryMockDeletePackagingType {
+	if n == 0 {
+		mmDeletePackagingType.mock.t.Fatalf("Times of OrderRepositoryMock.DeletePackagingType mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePackagingType.expectedInvocations, n)
+	mmDeletePackagingType.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePackagingType
+}
+
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) invocationsDone() bool {
+	if len(mmDeletePackagingType.expectations) == 0 && mmDeletePackagingType.defaultExpectation == nil && mmDeletePackagingType.mock.funcDeletePackagingType == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePackagingType.mock.afterDeletePackagingTypeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePackagingType.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePackagingType implements mm_order.Repository
+func (mmDeletePackagingType *OrderRepositoryMock) DeletePackagingType(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeletePackagingType.beforeDeletePackagingTypeCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePackagingType.afterDeletePackagingTypeCounter, 1)
+
+	mmDeletePackagingType.t.Helper()
+
+	if mmDeletePackagingType.inspectFuncDeletePackagingType != nil {
+		mmDeletePackagingType.inspectFuncDeletePackagingType(ctx, name)
+	}
+
+	mm_params := OrderRepositoryMockDeletePackagingTypeParams{ctx, name}
+
+	// Record call args
+	mmDeletePackagingType.DeletePackagingTypeMock.mutex.Lock()
+	mmDeletePackagingType.DeletePackagingTypeMock.callArgs = append(mmDeletePackagingType.DeletePackagingTypeMock.callArgs, &mm_params)
+	mmDeletePackagingType.DeletePackagingTypeMock.mutex.Unlock()
+
+	for _, e := range mmDeletePackagingType.DeletePackagingTypeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockDeletePackagingTypeParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePackagingType.t.Errorf("OrderRepositoryMock.DeletePackagingType got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeletePackagingType.t.Errorf("OrderRepositoryMock.DeletePackagingType got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePackagingType.t.Errorf("OrderRepositoryMock.DeletePackagingType got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePackagingType.DeletePackagingTypeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePackagingType.t.Fatal("No results are set for the OrderRepositoryMock.DeletePackagingType")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePackagingType.funcDeletePackagingType != nil {
+		return mmDeletePackagingType.funcDeletePackagingType(ctx, name)
+	}
+	mmDeletePackagingType.t.Fatalf("Unexpected call to OrderRepositoryMock.DeletePackagingType. %v %v", ctx, name)
+	return
+}
+
+// DeletePackagingTypeAfterCounter returns a count of finished OrderRepositoryMock.DeletePackagingType invocations
+func (mmDeletePackagingType *OrderRepositoryMock) DeletePackagingTypeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePackagingType.afterDeletePackagingTypeCounter)
+}
+
+// DeletePackagingTypeBeforeCounter returns a count of OrderRepositoryMock.DeletePackagingType invocations
+func (mmDeletePackagingType *OrderRepositoryMock) DeletePackagingTypeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePackagingType.beforeDeletePackagingTypeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.DeletePackagingType.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePackagingType *mOrderRepositoryMockDeletePackagingType) Calls() []*OrderRepositoryMockDeletePackagingTypeParams {
+	mmDeletePackagingType.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockDeletePackagingTypeParams, len(mmDeletePackagingType.callArgs))
+	copy(argCopy, mmDeletePackagingType.callArgs)
+
+	mmDeletePackagingType.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePackagingTypeDone returns true if the count of the DeletePackagingType invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockDeletePackagingTypeDone() bool {
+	if m.DeletePackagingTypeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePackagingTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePackagingTypeMock.invocationsDone()
+}
+
+// MinimockDeletePackagingTypeInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockDeletePackagingTypeInspect() {
+	for _, e := range m.DeletePackagingTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.DeletePackagingType at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePackagingTypeCounter := mm_atomic.LoadUint64(&m.afterDeletePackagingTypeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePackagingTypeMock.defaultExpectation != nil && afterDeletePackagingTypeCounter < 1 {
+		if m.DeletePackagingTypeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.DeletePackagingType at\n%s", m.DeletePackagingTypeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.DeletePackagingType at\n%s with params: %#v", m.DeletePackagingTypeMock.defaultExpectation.expectationOrigins.origin, *m.DeletePackagingTypeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePackagingType != nil && afterDeletePackagingTypeCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.DeletePackagingType at\n%s", m.funcDeletePackagingTypeOrigin)
+	}
+
+	if !m.DeletePackagingTypeMock.invocationsDone() && afterDeletePackagingTypeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.DeletePackagingType at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePackagingTypeMock.expectedInvocations), m.DeletePackagingTypeMock.expectedInvocationsOrigin, afterDeletePackagingTypeCounter)
+	}
+}
+
+type mOrderRepositoryMockGet struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetExpectation
+	expectations       []*OrderRepositoryMockGetExpectation
+
+	callArgs []*OrderRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetExpectation specifies expectation struct of the Repository.Get
+type OrderRepositoryMockGetExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetParams
+	paramPtrs          *OrderRepositoryMockGetParamPtrs
+	expectationOrigins OrderRepositoryMockGetExpectationOrigins
+	results            *OrderRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetParams contains parameters of the Repository.Get
+type OrderRepositoryMockGetParams struct {
+	ctx context.Context
+	i1  basetypes.ID
+}
+
+// OrderRepositoryMockGetParamPtrs contains pointers to parameters of the Repository.Get
+type OrderRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	i1  *basetypes.ID
+}
+
+// OrderRepositoryMockGetResults contains results of the Repository.Get
+type OrderRepositoryMockGetResults struct {
+	op1 *order.Order
+	err error
+}
+
+// OrderRepositoryMockGetOrigins contains origins of expectations of the Repository.Get
+type OrderRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originI1  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mOrderRepositoryMockGet) Optional() *mOrderRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for Repository.Get
+func (mmGet *mOrderRepositoryMockGet) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &OrderRepositoryMockGetParams{ctx, i1}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Get
+func (mmGet *mOrderRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &OrderRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectI1Param2 sets up expected param i1 for Repository.Get
+func (mmGet *mOrderRepositoryMockGet) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &OrderRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.i1 = &i1
+	mmGet.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Get
+func (mmGet *mOrderRepositoryMockGet) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by Repository.Get
+func (mmGet *mOrderRepositoryMockGet) Return(op1 *order.Order, err error) *OrderRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &OrderRepositoryMockGetResults{op1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the Repository.Get method
+func (mmGet *mOrderRepositoryMockGet) Set(f func(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error)) *OrderRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the Repository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the Repository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the Repository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mOrderRepositoryMockGet) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &OrderRepositoryMockGetParams{ctx, i1},
+		expectationOrigins: OrderRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Get return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetExpectation) Then(op1 *order.Order, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Get should be invoked
+func (mmGet *mOrderRepositoryMockGet) Times(n uint64) *mOrderRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of OrderRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mOrderRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_order.Repository
+func (mmGet *OrderRepositoryMock) Get(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, i1)
+	}
+
+	mm_params := OrderRepositoryMockGetParams{ctx, i1}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetParams{ctx, i1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("OrderRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
+				mmGet.t.Errorf("OrderRepositoryMock.Get got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("OrderRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the OrderRepositoryMock.Get")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, i1)
+	}
+	mmGet.t.Fatalf("Unexpected call to OrderRepositoryMock.Get. %v %v", ctx, i1)
+	return
+}
+
+// GetAfterCounter returns a count of finished OrderRepositoryMock.Get invocations
+func (mmGet *OrderRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of OrderRepositoryMock.Get invocations
+func (mmGet *OrderRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mOrderRepositoryMockGet) Calls() []*OrderRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mOrderRepositoryMockGetBy struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetByExpectation
+	expectations       []*OrderRepositoryMockGetByExpectation
+
+	callArgs []*OrderRepositoryMockGetByParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetByExpectation specifies expectation struct of the Repository.GetBy
+type OrderRepositoryMockGetByExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetByParams
+	paramPtrs          *OrderRepositoryMockGetByParamPtrs
+	expectationOrigins OrderRepositoryMockGetByExpectationOrigins
+	results            *OrderRepositoryMockGetByResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetByParams contains parameters of the Repository.GetBy
+type OrderRepositoryMockGetByParams struct {
+	ctx context.Context
+	fp1 *order.Filter
+}
+
+// OrderRepositoryMockGetByParamPtrs contains pointers to parameters of the Repository.GetBy
+type OrderRepositoryMockGetByParamPtrs struct {
+	ctx *context.Context
+	fp1 **order.Filter
+}
+
+// OrderRepositoryMockGetByResults contains results of the Repository.GetBy
+type OrderRepositoryMockGetByResults struct {
+	opa1 []*order.Order
+	err  error
+}
+
+// OrderRepositoryMockGetByOrigins contains origins of expectations of the Repository.GetBy
+type OrderRepositoryMockGetByExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFp1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBy *mOrderRepositoryMockGetBy) Optional() *mOrderRepositoryMockGetBy {
+	mmGetBy.optional = true
+	return mmGetBy
+}
+
+// Expect sets up expected params for Repository.GetBy
+func (mmGetBy *mOrderRepositoryMockGetBy) Expect(ctx context.Context, fp1 *order.Filter) *mOrderRepositoryMockGetBy {
+	if mmGetBy.mock.funcGetBy != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+	}
+
+	if mmGetBy.defaultExpectation == nil {
+		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{}
+	}
+
+	if mmGetBy.defaultExpectation.paramPtrs != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by ExpectParams functions")
+	}
+
+	mmGetBy.defaultExpectation.params = &OrderRepositoryMockGetByParams{ctx, fp1}
+	mmGetBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBy.expectations {
+		if minimock.Equal(e.params, mmGetBy.defaultExpectation.params) {
+			mmGetBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetBy
+func (mmGetBy *mOrderRepositoryMockGetBy) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetBy {
+	if mmGetBy.mock.funcGetBy != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+	}
+
+	if mmGetBy.defaultExpectation == nil {
+		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{}
+	}
+
+	if mmGetBy.defaultExpectation.params != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Expect")
+	}
+
+	if mmGetBy.defaultExpectation.paramPtrs == nil {
+		mmGetBy.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByParamPtrs{}
+	}
+	mmGetBy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBy
+}
+
+// ExpectFp1Param2 sets up expected param fp1 for Repository.GetBy
+func (mmGetBy *mOrderRepositoryMockGetBy) ExpectFp1Param2(fp1 *order.Filter) *mOrderRepositoryMockGetBy {
+	if mmGetBy.mock.funcGetBy != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+	}
+
+	if mmGetBy.defaultExpectation == nil {
+		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{}
+	}
+
+	if mmGetBy.defaultExpectation.params != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Expect")
+	}
+
+	if mmGetBy.defaultExpectation.paramPtrs == nil {
+		mmGetBy.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByParamPtrs{}
+	}
+	mmGetBy.defaultExpectation.paramPtrs.fp1 = &fp1
+	mmGetBy.defaultExpectation.expectationOrigins.originFp1 = minimock.CallerInfo(1)
+
+	return mmGetBy
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetBy
+func (mmGetBy *mOrderRepositoryMockGetBy) Inspect(f func(ctx context.Context, fp1 *order.Filter)) *mOrderRepositoryMockGetBy {
+	if mmGetBy.mock.inspectFuncGetBy != nil {
+		mmGetBy.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetBy")
+	}
+
+	mmGetBy.mock.inspectFuncGetBy = f
+
+	return mmGetBy
+}
+
+// Return sets up results that will be returned by Repository.GetBy
+func (mmGetBy *mOrderRepositoryMockGetBy) Return(opa1 []*order.Order, err error) *OrderRepositoryMock {
+	if mmGetBy.mock.funcGetBy != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+	}
+
+	if mmGetBy.defaultExpectation == nil {
+		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{mock: mmGetBy.mock}
+	}
+	mmGetBy.defaultExpectation.results = &OrderRepositoryMockGetByResults{opa1, err}
+	mmGetBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBy.mock
+}
+
+// Set uses given function f to mock the Repository.GetBy method
+func (mmGetBy *mOrderRepositoryMockGetBy) Set(f func(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error)) *OrderRepositoryMock {
+	if mmGetBy.defaultExpectation != nil {
+		mmGetBy.mock.t.Fatalf("Default expectation is already set for the Repository.GetBy method")
+	}
+
+	if len(mmGetBy.expectations) > 0 {
+		mmGetBy.mock.t.Fatalf("Some expectations are already set for the Repository.GetBy method")
+	}
+
+	mmGetBy.mock.funcGetBy = f
+	mmGetBy.mock.funcGetByOrigin = minimock.CallerInfo(1)
+	return mmGetBy.mock
+}
+
+// When sets expectation for the Repository.GetBy which will trigger the result defined by the following
+// Then helper
+func (mmGetBy *mOrderRepositoryMockGetBy) When(ctx context.Context, fp1 *order.Filter) *OrderRepositoryMockGetByExpectation {
+	if mmGetBy.mock.funcGetBy != nil {
+		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetByExpectation{
+		mock:               mmGetBy.mock,
+		params:             &OrderRepositoryMockGetByParams{ctx, fp1},
+		expectationOrigins: OrderRepositoryMockGetByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBy.expectations = append(mmGetBy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetBy return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetByExpectation) Then(opa1 []*order.Order, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetByResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetBy should be invoked
+func (mmGetBy *mOrderRepositoryMockGetBy) Times(n uint64) *mOrderRepositoryMockGetBy {
+	if n == 0 {
+		mmGetBy.mock.t.Fatalf("Times of OrderRepositoryMock.GetBy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBy.expectedInvocations, n)
+	mmGetBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBy
+}
+
+func (mmGetBy *mOrderRepositoryMockGetBy) invocationsDone() bool {
+	if len(mmGetBy.expectations) == 0 && mmGetBy.defaultExpectation == nil && mmGetBy.mock.funcGetBy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBy.mock.afterGetByCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBy implements mm_order.Repository
+func (mmGetBy *OrderRepositoryMock) GetBy(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error) {
+	mm_atomic.AddUint64(&mmGetBy.beforeGetByCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBy.afterGetByCounter, 1)
+
+	mmGetBy.t.Helper()
+
+	if mmGetBy.inspectFuncGetBy != nil {
+		mmGetBy.inspectFuncGetBy(ctx, fp1)
+	}
+
+	mm_params := OrderRepositoryMockGetByParams{ctx, fp1}
+
+	// Record call args
+	mmGetBy.GetByMock.mutex.Lock()
+	mmGetBy.GetByMock.callArgs = append(mmGetBy.GetByMock.callArgs, &mm_params)
+	mmGetBy.GetByMock.mutex.Unlock()
+
+	for _, e := range mmGetBy.GetByMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmGetBy.GetByMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBy.GetByMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBy.GetByMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBy.GetByMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetByParams{ctx, fp1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBy.t.Errorf("OrderRepositoryMock.GetBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBy.GetByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fp1 != nil && !minimock.Equal(*mm_want_ptrs.fp1, mm_got.fp1) {
+				mmGetBy.t.Errorf("OrderRepositoryMock.GetBy got unexpected parameter fp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBy.GetByMock.defaultExpectation.expectationOrigins.originFp1, *mm_want_ptrs.fp1, mm_got.fp1, minimock.Diff(*mm_want_ptrs.fp1, mm_got.fp1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBy.t.Errorf("OrderRepositoryMock.GetBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBy.GetByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBy.GetByMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBy.t.Fatal("No results are set for the OrderRepositoryMock.GetBy")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmGetBy.funcGetBy != nil {
+		return mmGetBy.funcGetBy(ctx, fp1)
+	}
+	mmGetBy.t.Fatalf("Unexpected call to OrderRepositoryMock.GetBy. %v %v", ctx, fp1)
+	return
+}
+
+// GetByAfterCounter returns a count of finished OrderRepositoryMock.GetBy invocations
+func (mmGetBy *OrderRepositoryMock) GetByAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBy.afterGetByCounter)
+}
+
+// GetByBeforeCounter returns a count of OrderRepositoryMock.GetBy invocations
+func (mmGetBy *OrderRepositoryMock) GetByBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBy.beforeGetByCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetBy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBy *mOrderRepositoryMockGetBy) Calls() []*OrderRepositoryMockGetByParams {
+	mmGetBy.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetByParams, len(mmGetBy.callArgs))
+	copy(argCopy, mmGetBy.callArgs)
+
+	mmGetBy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByDone returns true if the count of the GetBy invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetByDone() bool {
+	if m.GetByMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByMock.invocationsDone()
+}
+
+// MinimockGetByInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetByInspect() {
+	for _, e := range m.GetByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetByCounter := mm_atomic.LoadUint64(&m.afterGetByCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByMock.defaultExpectation != nil && afterGetByCounter < 1 {
+		if m.GetByMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s", m.GetByMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s with params: %#v", m.GetByMock.defaultExpectation.expectationOrigins.origin, *m.GetByMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBy != nil && afterGetByCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s", m.funcGetByOrigin)
+	}
+
+	if !m.GetByMock.invocationsDone() && afterGetByCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetBy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByMock.expectedInvocations), m.GetByMock.expectedInvocationsOrigin, afterGetByCounter)
+	}
+}
+
+type mOrderRepositoryMockGetExpiredIDs struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetExpiredIDsExpectation
+	expectations       []*OrderRepositoryMockGetExpiredIDsExpectation
+
+	callArgs []*OrderRepositoryMockGetExpiredIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetExpiredIDsExpectation specifies expectation struct of the Repository.GetExpiredIDs
+type OrderRepositoryMockGetExpiredIDsExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetExpiredIDsParams
+	paramPtrs          *OrderRepositoryMockGetExpiredIDsParamPtrs
+	expectationOrigins OrderRepositoryMockGetExpiredIDsExpectationOrigins
+	results            *OrderRepositoryMockGetExpiredIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetExpiredIDsParams contains parameters of the Repository.GetExpiredIDs
+type OrderRepositoryMockGetExpiredIDsParams struct {
+	ctx         context.Context
+	timeToStore time.Duration
+	now         time.Time
+	limit       int
+}
+
+// OrderRepositoryMockGetExpiredIDsParamPtrs contains pointers to parameters of the Repository.GetExpiredIDs
+type OrderRepositoryMockGetExpiredIDsParamPtrs struct {
+	ctx         *context.Context
+	timeToStore *time.Duration
+	now         *time.Time
+	limit       *int
+}
+
+// OrderRepositoryMockGetExpiredIDsResults contains results of the Repository.GetExpiredIDs
+type OrderRepositoryMockGetExpiredIDsResults struct {
+	ia1 []basetypes.ID
+	err error
+}
+
+// OrderRepositoryMockGetExpiredIDsOrigins contains origins of expectations of the Repository.GetExpiredIDs
+type OrderRepositoryMockGetExpiredIDsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originTimeToStore string
+	originNow         string
+	originLimit       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Optional() *mOrderRepositoryMockGetExpiredIDs {
+	mmGetExpiredIDs.optional = true
+	return mmGetExpiredIDs
+}
+
+// Expect sets up expected params for Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Expect(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) *mOrderRepositoryMockGetExpiredIDs {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation == nil {
+		mmGetExpiredIDs.defaultExpectation = &OrderRepositoryMockGetExpiredIDsExpectation{}
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.paramPtrs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by ExpectParams functions")
+	}
+
+	mmGetExpiredIDs.defaultExpectation.params = &OrderRepositoryMockGetExpiredIDsParams{ctx, timeToStore, now, limit}
+	mmGetExpiredIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetExpiredIDs.expectations {
+		if minimock.Equal(e.params, mmGetExpiredIDs.defaultExpectation.params) {
+			mmGetExpiredIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetExpiredIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetExpiredIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetExpiredIDs {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation == nil {
+		mmGetExpiredIDs.defaultExpectation = &OrderRepositoryMockGetExpiredIDsExpectation{}
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.params != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Expect")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.paramPtrs == nil {
+		mmGetExpiredIDs.defaultExpectation.paramPtrs = &OrderRepositoryMockGetExpiredIDsParamPtrs{}
+	}
+	mmGetExpiredIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetExpiredIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetExpiredIDs
+}
+
+// ExpectTimeToStoreParam2 sets up expected param timeToStore for Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) ExpectTimeToStoreParam2(timeToStore time.Duration) *mOrderRepositoryMockGetExpiredIDs {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation == nil {
+		mmGetExpiredIDs.defaultExpectation = &OrderRepositoryMockGetExpiredIDsExpectation{}
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.params != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Expect")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.paramPtrs == nil {
+		mmGetExpiredIDs.defaultExpectation.paramPtrs = &OrderRepositoryMockGetExpiredIDsParamPtrs{}
+	}
+	mmGetExpiredIDs.defaultExpectation.paramPtrs.timeToStore = &timeToStore
+	mmGetExpiredIDs.defaultExpectation.expectationOrigins.originTimeToStore = minimock.CallerInfo(1)
+
+	return mmGetExpiredIDs
+}
+
+// ExpectNowParam3 sets up expected param now for Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) ExpectNowParam3(now time.Time) *mOrderRepositoryMockGetExpiredIDs {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation == nil {
+		mmGetExpiredIDs.defaultExpectation = &OrderRepositoryMockGetExpiredIDsExpectation{}
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.params != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Expect")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.paramPtrs == nil {
+		mmGetExpiredIDs.defaultExpectation.paramPtrs = &OrderRepositoryMockGetExpiredIDsParamPtrs{}
+	}
+	mmGetExpiredIDs.defaultExpectation.paramPtrs.now = &now
+	mmGetExpiredIDs.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmGetExpiredIDs
+}
+
+// ExpectLimitParam4 sets up expected param limit for Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) ExpectLimitParam4(limit int) *mOrderRepositoryMockGetExpiredIDs {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation == nil {
+		mmGetExpiredIDs.defaultExpectation = &OrderRepositoryMockGetExpiredIDsExpectation{}
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.params != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Expect")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation.paramPtrs == nil {
+		mmGetExpiredIDs.defaultExpectation.paramPtrs = &OrderRepositoryMockGetExpiredIDsParamPtrs{}
+	}
+	mmGetExpiredIDs.defaultExpectation.paramPtrs.limit = &limit
+	mmGetExpiredIDs.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetExpiredIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Inspect(f func(ctx context.Context, timeToStore time.Duration, now time.Time, limit int)) *mOrderRepositoryMockGetExpiredIDs {
+	if mmGetExpiredIDs.mock.inspectFuncGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetExpiredIDs")
+	}
+
+	mmGetExpiredIDs.mock.inspectFuncGetExpiredIDs = f
+
+	return mmGetExpiredIDs
+}
+
+// Return sets up results that will be returned by Repository.GetExpiredIDs
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Return(ia1 []basetypes.ID, err error) *OrderRepositoryMock {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	if mmGetExpiredIDs.defaultExpectation == nil {
+		mmGetExpiredIDs.defaultExpectation = &OrderRepositoryMockGetExpiredIDsExpectation{mock: mmGetExpiredIDs.mock}
+	}
+	mmGetExpiredIDs.defaultExpectation.results = &OrderRepositoryMockGetExpiredIDsResults{ia1, err}
+	mmGetExpiredIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetExpiredIDs.mock
+}
+
+// Set uses given function f to mock the Repository.GetExpiredIDs method
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Set(f func(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) (ia1 []basetypes.ID, err error)) *OrderRepositoryMock {
+	if mmGetExpiredIDs.defaultExpectation != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("Default expectation is already set for the Repository.GetExpiredIDs method")
+	}
+
+	if len(mmGetExpiredIDs.expectations) > 0 {
+		mmGetExpiredIDs.mock.t.Fatalf("Some expectations are already set for the Repository.GetExpiredIDs method")
+	}
+
+	mmGetExpiredIDs.mock.funcGetExpiredIDs = f
+	mmGetExpiredIDs.mock.funcGetExpiredIDsOrigin = minimock.CallerInfo(1)
+	return mmGetExpiredIDs.mock
+}
+
+// When sets expectation for the Repository.GetExpiredIDs which will trigger the result defined by the following
+// Then helper
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) When(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) *OrderRepositoryMockGetExpiredIDsExpectation {
+	if mmGetExpiredIDs.mock.funcGetExpiredIDs != nil {
+		mmGetExpiredIDs.mock.t.Fatalf("OrderRepositoryMock.GetExpiredIDs mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetExpiredIDsExpectation{
+		mock:               mmGetExpiredIDs.mock,
+		params:             &OrderRepositoryMockGetExpiredIDsParams{ctx, timeToStore, now, limit},
+		expectationOrigins: OrderRepositoryMockGetExpiredIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetExpiredIDs.expectations = append(mmGetExpiredIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetExpiredIDs return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetExpiredIDsExpectation) Then(ia1 []basetypes.ID, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetExpiredIDsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetExpiredIDs should be invoked
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Times(n uint64) *mOrderRepositoryMockGetExpiredIDs {
+	if n == 0 {
+		mmGetExpiredIDs.mock.t.Fatalf("Times of OrderRepositoryMock.GetExpiredIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetExpiredIDs.expectedInvocations, n)
+	mmGetExpiredIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetExpiredIDs
+}
+
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) invocationsDone() bool {
+	if len(mmGetExpiredIDs.expectations) == 0 && mmGetExpiredIDs.defaultExpectation == nil && mmGetExpiredIDs.mock.funcGetExpiredIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetExpiredIDs.mock.afterGetExpiredIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetExpiredIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetExpiredIDs implements mm_order.Repository
+func (mmGetExpiredIDs *OrderRepositoryMock) GetExpiredIDs(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) (ia1 []basetypes.ID, err error) {
+	mm_atomic.AddUint64(&mmGetExpiredIDs.beforeGetExpiredIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetExpiredIDs.afterGetExpiredIDsCounter, 1)
+
+	mmGetExpiredIDs.t.Helper()
+
+	if mmGetExpiredIDs.inspectFuncGetExpiredIDs != nil {
+		mmGetExpiredIDs.inspectFuncGetExpiredIDs(ctx, timeToStore, now, limit)
+	}
+
+	mm_params := OrderRepositoryMockGetExpiredIDsParams{ctx, timeToStore, now, limit}
+
+	// Record call args
+	mmGetExpiredIDs.GetExpiredIDsMock.mutex.Lock()
+	mmGetExpiredIDs.GetExpiredIDsMock.callArgs = append(mmGetExpiredIDs.GetExpiredIDsMock.callArgs, &mm_params)
+	mmGetExpiredIDs.GetExpiredIDsMock.mutex.Unlock()
+
+	for _, e := range mmGetExpiredIDs.GetExpiredIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetExpiredIDsParams{ctx, timeToStore, now, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetExpiredIDs.t.Errorf("OrderRepositoryMock.GetExpiredIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.timeToStore != nil && !minimock.Equal(*mm_want_ptrs.timeToStore, mm_got.timeToStore) {
+				mmGetExpiredIDs.t.Errorf("OrderRepositoryMock.GetExpiredIDs got unexpected parameter timeToStore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.expectationOrigins.originTimeToStore, *mm_want_ptrs.timeToStore, mm_got.timeToStore, minimock.Diff(*mm_want_ptrs.timeToStore, mm_got.timeToStore))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmGetExpiredIDs.t.Errorf("OrderRepositoryMock.GetExpiredIDs got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetExpiredIDs.t.Errorf("OrderRepositoryMock.GetExpiredIDs got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetExpiredIDs.t.Errorf("OrderRepositoryMock.GetExpiredIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetExpiredIDs.GetExpiredIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetExpiredIDs.t.Fatal("No results are set for the OrderRepositoryMock.GetExpiredIDs")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetExpiredIDs.funcGetExpiredIDs != nil {
+		return mmGetExpiredIDs.funcGetExpiredIDs(ctx, timeToStore, now, limit)
+	}
+	mmGetExpiredIDs.t.Fatalf("Unexpected call to OrderRepositoryMock.GetExpiredIDs. %v %v %v %v", ctx, timeToStore, now, limit)
+	return
+}
+
+// GetExpiredIDsAfterCounter returns a count of finished OrderRepositoryMock.GetExpiredIDs invocations
+func (mmGetExpiredIDs *OrderRepositoryMock) GetExpiredIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiredIDs.afterGetExpiredIDsCounter)
+}
+
+// GetExpiredIDsBeforeCounter returns a count of OrderRepositoryMock.GetExpiredIDs invocations
+func (mmGetExpiredIDs *OrderRepositoryMock) GetExpiredIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiredIDs.beforeGetExpiredIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetExpiredIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetExpiredIDs *mOrderRepositoryMockGetExpiredIDs) Calls() []*OrderRepositoryMockGetExpiredIDsParams {
+	mmGetExpiredIDs.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetExpiredIDsParams, len(mmGetExpiredIDs.callArgs))
+	copy(argCopy, mmGetExpiredIDs.callArgs)
+
+	mmGetExpiredIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetExpiredIDsDone returns true if the count of the GetExpiredIDs invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetExpiredIDsDone() bool {
+	if m.GetExpiredIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetExpiredIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetExpiredIDsMock.invocationsDone()
+}
+
+// MinimockGetExpiredIDsInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetExpiredIDsInspect() {
+	for _, e := range m.GetExpiredIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetExpiredIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetExpiredIDsCounter := mm_atomic.LoadUint64(&m.afterGetExpiredIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExpiredIDsMock.defaultExpectation != nil && afterGetExpiredIDsCounter < 1 {
+		if m.GetExpiredIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetExpiredIDs at\n%s", m.GetExpiredIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetExpiredIDs at\n%s with params: %#v", m.GetExpiredIDsMock.defaultExpectation.expectationOrigins.origin, *m.GetExpiredIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExpiredIDs != nil && afterGetExpiredIDsCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetExpiredIDs at\n%s", m.funcGetExpiredIDsOrigin)
+	}
+
+	if !m.GetExpiredIDsMock.invocationsDone() && afterGetExpiredIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetExpiredIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetExpiredIDsMock.expectedInvocations), m.GetExpiredIDsMock.expectedInvocationsOrigin, afterGetExpiredIDsCounter)
+	}
+}
+
+type mOrderRepositoryMockGetHandover struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetHandoverExpectation
+	expectations       []*OrderRepositoryMockGetHandoverExpectation
+
+	callArgs []*OrderRepositoryMockGetHandoverParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetHandoverExpectation specifies expectation struct of the Repository.GetHandover
+type OrderRepositoryMockGetHandoverExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetHandoverParams
+	paramPtrs          *OrderRepositoryMockGetHandoverParamPtrs
+	expectationOrigins OrderRepositoryMockGetHandoverExpectationOrigins
+	results            *OrderRepositoryMockGetHandoverResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetHandoverParams contains parameters of the Repository.GetHandover
+type OrderRepositoryMockGetHandoverParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// OrderRepositoryMockGetHandoverParamPtrs contains pointers to parameters of the Repository.GetHandover
+type OrderRepositoryMockGetHandoverParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// OrderRepositoryMockGetHandoverResults contains results of the Repository.GetHandover
+type OrderRepositoryMockGetHandoverResults struct {
+	hp1 *order.Handover
+	err error
+}
+
+// OrderRepositoryMockGetHandoverOrigins contains origins of expectations of the Repository.GetHandover
+type OrderRepositoryMockGetHandoverExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Optional() *mOrderRepositoryMockGetHandover {
+	mmGetHandover.optional = true
+	return mmGetHandover
+}
+
+// Expect sets up expected params for Repository.GetHandover
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Expect(ctx context.Context, id uint64) *mOrderRepositoryMockGetHandover {
+	if mmGetHandover.mock.funcGetHandover != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Set")
+	}
+
+	if mmGetHandover.defaultExpectation == nil {
+		mmGetHandover.defaultExpectation = &OrderRepositoryMockGetHandoverExpectation{}
+	}
+
+	if mmGetHandover.defaultExpectation.paramPtrs != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by ExpectParams functions")
+	}
+
+	mmGetHandover.defaultExpectation.params = &OrderRepositoryMockGetHandoverParams{ctx, id}
+	mmGetHandover.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetHandover.expectations {
+		if minimock.Equal(e.params, mmGetHandover.defaultExpectation.params) {
+			mmGetHandover.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetHandover.defaultExpectation.params)
+		}
+	}
+
+	return mmGetHandover
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetHandover
+func (mmGetHandover *mOrderRepositoryMockGetHandover) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetHandover {
+	if mmGetHandover.mock.funcGetHandover != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Set")
+	}
+
+	if mmGetHandover.defaultExpectation == nil {
+		mmGetHandover.defaultExpectation = &OrderRepositoryMockGetHandoverExpectation{}
+	}
+
+	if mmGetHandover.defaultExpectation.params != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Expect")
+	}
+
+	if mmGetHandover.defaultExpectation.paramPtrs == nil {
+		mmGetHandover.defaultExpectation.paramPtrs = &OrderRepositoryMockGetHandoverParamPtrs{}
+	}
+	mmGetHandover.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetHandover.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetHandover
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.GetHandover
+func (mmGetHandover *mOrderRepositoryMockGetHandover) ExpectIdParam2(id uint64) *mOrderRepositoryMockGetHandover {
+	if mmGetHandover.mock.funcGetHandover != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Set")
+	}
+
+	if mmGetHandover.defaultExpectation == nil {
+		mmGetHandover.defaultExpectation = &OrderRepositoryMockGetHandoverExpectation{}
+	}
+
+	if mmGetHandover.defaultExpectation.params != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Expect")
+	}
+
+	if mmGetHandover.defaultExpectation.paramPtrs == nil {
+		mmGetHandover.defaultExpectation.paramPtrs = &OrderRepositoryMockGetHandoverParamPtrs{}
+	}
+	mmGetHandover.defaultExpectation.paramPtrs.id = &id
+	mmGetHandover.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetHandover
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetHandover
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Inspect(f func(ctx context.Context, id uint64)) *mOrderRepositoryMockGetHandover {
+	if mmGetHandover.mock.inspectFuncGetHandover != nil {
+		mmGetHandover.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetHandover")
+	}
+
+	mmGetHandover.mock.inspectFuncGetHandover = f
+
+	return mmGetHandover
+}
+
+// Return sets up results that will be returned by Repository.GetHandover
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Return(hp1 *order.Handover, err error) *OrderRepositoryMock {
+	if mmGetHandover.mock.funcGetHandover != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Set")
+	}
+
+	if mmGetHandover.defaultExpectation == nil {
+		mmGetHandover.defaultExpectation = &OrderRepositoryMockGetHandoverExpectation{mock: mmGetHandover.mock}
+	}
+	mmGetHandover.defaultExpectation.results = &OrderRepositoryMockGetHandoverResults{hp1, err}
+	mmGetHandover.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetHandover.mock
+}
+
+// Set uses given function f to mock the Repository.GetHandover method
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Set(f func(ctx context.Context, id uint64) (hp1 *order.Handover, err error)) *OrderRepositoryMock {
+	if mmGetHandover.defaultExpectation != nil {
+		mmGetHandover.mock.t.Fatalf("Default expectation is already set for the Repository.GetHandover method")
+	}
+
+	if len(mmGetHandover.expectations) > 0 {
+		mmGetHandover.mock.t.Fatalf("Some expectations are already set for the Repository.GetHandover method")
+	}
+
+	mmGetHandover.mock.funcGetHandover = f
+	mmGetHandover.mock.funcGetHandoverOrigin = minimock.CallerInfo(1)
+	return mmGetHandover.mock
+}
+
+// When sets expectation for the Repository.GetHandover which will trigger the result defined by the following
+// Then helper
+func (mmGetHandover *mOrderRepositoryMockGetHandover) When(ctx context.Context, id uint64) *OrderRepositoryMockGetHandoverExpectation {
+	if mmGetHandover.mock.funcGetHandover != nil {
+		mmGetHandover.mock.t.Fatalf("OrderRepositoryMock.GetHandover mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetHandoverExpectation{
+		mock:               mmGetHandover.mock,
+		params:             &OrderRepositoryMockGetHandoverParams{ctx, id},
+		expectationOrigins: OrderRepositoryMockGetHandoverExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetHandover.expectations = append(mmGetHandover.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetHandover return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetHandoverExpectation) Then(hp1 *order.Handover, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetHandoverResults{hp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetHandover should be invoked
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Times(n uint64) *mOrderRepositoryMockGetHandover {
+	if n == 0 {
+		mmGetHandover.mock.t.Fatalf("Times of OrderRepositoryMock.GetHandover mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetHandover.expectedInvocations, n)
+	mmGetHandover.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetHandover
+}
+
+func (mmGetHandover *mOrderRepositoryMockGetHandover) invocationsDone() bool {
+	if len(mmGetHandover.expectations) == 0 && mmGetHandover.defaultExpectation == nil && mmGetHandover.mock.funcGetHandover == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetHandover.mock.afterGetHandoverCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetHandover.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetHandover implements mm_order.Repository
+func (mmGetHandover *OrderRepositoryMock) GetHandover(ctx context.Context, id uint64) (hp1 *order.Handover, err error) {
+	mm_atomic.AddUint64(&mmGetHandover.beforeGetHandoverCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetHandover.afterGetHandoverCounter, 1)
+
+	mmGetHandover.t.Helper()
+
+	if mmGetHandover.inspectFuncGetHandover != nil {
+		mmGetHandover.inspectFuncGetHandover(ctx, id)
+	}
+
+	mm_params := OrderRepositoryMockGetHandoverParams{ctx, id}
+
+	// Record call args
+	mmGetHandover.GetHandoverMock.mutex.Lock()
+	mmGetHandover.GetHandoverMock.callArgs = append(mmGetHandover.GetHandoverMock.callArgs, &mm_params)
+	mmGetHandover.GetHandoverMock.mutex.Unlock()
+
+	for _, e := range mmGetHandover.GetHandoverMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.hp1, e.results.err
+		}
+	}
+
+	if mmGetHandover.GetHandoverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetHandover.GetHandoverMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetHandover.GetHandoverMock.defaultExpectation.params
+		mm_want_ptrs := mmGetHandover.GetHandoverMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetHandoverParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetHandover.t.Errorf("OrderRepositoryMock.GetHandover got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHandover.GetHandoverMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetHandover.t.Errorf("OrderRepositoryMock.GetHandover got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHandover.GetHandoverMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetHandover.t.Errorf("OrderRepositoryMock.GetHandover got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetHandover.GetHandoverMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetHandover.GetHandoverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetHandover.t.Fatal("No results are set for the OrderRepositoryMock.GetHandover")
+		}
+		return (*mm_results).hp1, (*mm_results).err
+	}
+	if mmGetHandover.funcGetHandover != nil {
+		return mmGetHandover.funcGetHandover(ctx, id)
+	}
+	mmGetHandover.t.Fatalf("Unexpected call to OrderRepositoryMock.GetHandover. %v %v", ctx, id)
+	return
+}
+
+// GetHandoverAfterCounter returns a count of finished OrderRepositoryMock.GetHandover invocations
+func (mmGetHandover *OrderRepositoryMock) GetHandoverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetHandover.afterGetHandoverCounter)
+}
+
+// GetHandoverBeforeCounter returns a count of OrderRepositoryMock.GetHandover invocations
+func (mmGetHandover *OrderRepositoryMock) GetHandoverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetHandover.beforeGetHandoverCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetHandover.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetHandover *mOrderRepositoryMockGetHandover) Calls() []*OrderRepositoryMockGetHandoverParams {
+	mmGetHandover.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetHandoverParams, len(mmGetHandover.callArgs))
+	copy(argCopy, mmGetHandover.callArgs)
+
+	mmGetHandover.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetHandoverDone returns true if the count of the GetHandover invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetHandoverDone() bool {
+	if m.GetHandoverMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetHandoverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetHandoverMock.invocationsDone()
+}
+
+// MinimockGetHandoverInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetHandoverInspect() {
+	for _, e := range m.GetHandoverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetHandover at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetHandoverCounter := mm_atomic.LoadUint64(&m.afterGetHandoverCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetHandoverMock.defaultExpectation != nil && afterGetHandoverCounter < 1 {
+		if m.GetHandoverMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetHandover at\n%s", m.GetHandoverMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetHandover at\n%s with params: %#v", m.GetHandoverMock.defaultExpectation.expectationOrigins.origin, *m.GetHandoverMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetHandover != nil && afterGetHandoverCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetHandover at\n%s", m.funcGetHandoverOrigin)
+	}
+
+	if !m.GetHandoverMock.invocationsDone() && afterGetHandoverCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetHandover at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetHandoverMock.expectedInvocations), m.GetHandoverMock.expectedInvocationsOrigin, afterGetHandoverCounter)
+	}
+}
+
+type mOrderRepositoryMockGetHistory struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetHistoryExpectation
+	expectations       []*OrderRepositoryMockGetHistoryExpectation
+
+	callArgs []*OrderRepositoryMockGetHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetHistoryExpectation specifies expectation struct of the Repository.GetHistory
+type OrderRepositoryMockGetHistoryExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetHistoryParams
+	paramPtrs          *OrderRepositoryMockGetHistoryParamPtrs
+	expectationOrigins OrderRepositoryMockGetHistoryExpectationOrigins
+	results            *OrderRepositoryMockGetHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetHistoryParams contains parameters of the Repository.GetHistory
+type OrderRepositoryMockGetHistoryParams struct {
+	ctx context.Context
+	i1  basetypes.ID
+}
+
+// OrderRepositoryMockGetHistoryParamPtrs contains pointers to parameters of the Repository.GetHistory
+type OrderRepositoryMockGetHistoryParamPtrs struct {
+	ctx *context.Context
+	i1  *basetypes.ID
+}
+
+// OrderRepositoryMockGetHistoryResults contains results of the Repository.GetHistory
+type OrderRepositoryMockGetHistoryResults struct {
+	spa1 []*order.StatusChange
+	err  error
+}
+
+// OrderRepositoryMockGetHistoryOrigins contains origins of expectations of the Repository.GetHistory
+type OrderRepositoryMockGetHistoryExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originI1  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Optional() *mOrderRepositoryMockGetHistory {
+	mmGetHistory.optional = true
+	return mmGetHistory
+}
+
+// Expect sets up expected params for Repository.GetHistory
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockGetHistory {
+	if mmGetHistory.mock.funcGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Set")
+	}
+
+	if mmGetHistory.defaultExpectation == nil {
+		mmGetHistory.defaultExpectation = &OrderRepositoryMockGetHistoryExpectation{}
+	}
+
+	if mmGetHistory.defaultExpectation.paramPtrs != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetHistory.defaultExpectation.params = &OrderRepositoryMockGetHistoryParams{ctx, i1}
+	mmGetHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetHistory.expectations {
+		if minimock.Equal(e.params, mmGetHistory.defaultExpectation.params) {
+			mmGetHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetHistory
+func (mmGetHistory *mOrderRepositoryMockGetHistory) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetHistory {
+	if mmGetHistory.mock.funcGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Set")
+	}
+
+	if mmGetHistory.defaultExpectation == nil {
+		mmGetHistory.defaultExpectation = &OrderRepositoryMockGetHistoryExpectation{}
+	}
+
+	if mmGetHistory.defaultExpectation.params != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Expect")
+	}
+
+	if mmGetHistory.defaultExpectation.paramPtrs == nil {
+		mmGetHistory.defaultExpectation.paramPtrs = &OrderRepositoryMockGetHistoryParamPtrs{}
+	}
+	mmGetHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetHistory
+}
+
+// ExpectI1Param2 sets up expected param i1 for Repository.GetHistory
+func (mmGetHistory *mOrderRepositoryMockGetHistory) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockGetHistory {
+	if mmGetHistory.mock.funcGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Set")
+	}
+
+	if mmGetHistory.defaultExpectation == nil {
+		mmGetHistory.defaultExpectation = &OrderRepositoryMockGetHistoryExpectation{}
+	}
+
+	if mmGetHistory.defaultExpectation.params != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Expect")
+	}
+
+	if mmGetHistory.defaultExpectation.paramPtrs == nil {
+		mmGetHistory.defaultExpectation.paramPtrs = &OrderRepositoryMockGetHistoryParamPtrs{}
+	}
+	mmGetHistory.defaultExpectation.paramPtrs.i1 = &i1
+	mmGetHistory.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+
+	return mmGetHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetHistory
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockGetHistory {
+	if mmGetHistory.mock.inspectFuncGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetHistory")
+	}
+
+	mmGetHistory.mock.inspectFuncGetHistory = f
+
+	return mmGetHistory
+}
+
+// Return sets up results that will be returned by Repository.GetHistory
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Return(spa1 []*order.StatusChange, err error) *OrderRepositoryMock {
+	if mmGetHistory.mock.funcGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Set")
+	}
+
+	if mmGetHistory.defaultExpectation == nil {
+		mmGetHistory.defaultExpectation = &OrderRepositoryMockGetHistoryExpectation{mock: mmGetHistory.mock}
+	}
+	mmGetHistory.defaultExpectation.results = &OrderRepositoryMockGetHistoryResults{spa1, err}
+	mmGetHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetHistory.mock
+}
+
+// Set uses given function f to mock the Repository.GetHistory method
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Set(f func(ctx context.Context, i1 basetypes.ID) (spa1 []*order.StatusChange, err error)) *OrderRepositoryMock {
+	if mmGetHistory.defaultExpectation != nil {
+		mmGetHistory.mock.t.Fatalf("Default expectation is already set for the Repository.GetHistory method")
+	}
+
+	if len(mmGetHistory.expectations) > 0 {
+		mmGetHistory.mock.t.Fatalf("Some expectations are already set for the Repository.GetHistory method")
+	}
+
+	mmGetHistory.mock.funcGetHistory = f
+	mmGetHistory.mock.funcGetHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetHistory.mock
+}
+
+// When sets expectation for the Repository.GetHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetHistory *mOrderRepositoryMockGetHistory) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockGetHistoryExpectation {
+	if mmGetHistory.mock.funcGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("OrderRepositoryMock.GetHistory mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetHistoryExpectation{
+		mock:               mmGetHistory.mock,
+		params:             &OrderRepositoryMockGetHistoryParams{ctx, i1},
+		expectationOrigins: OrderRepositoryMockGetHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetHistory.expectations = append(mmGetHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetHistory return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetHistoryExpectation) Then(spa1 []*order.StatusChange, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetHistoryResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetHistory should be invoked
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Times(n uint64) *mOrderRepositoryMockGetHistory {
+	if n == 0 {
+		mmGetHistory.mock.t.Fatalf("Times of OrderRepositoryMock.GetHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetHistory.expectedInvocations, n)
+	mmGetHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetHistory
+}
+
+func (mmGetHistory *mOrderRepositoryMockGetHistory) invocationsDone() bool {
+	if len(mmGetHistory.expectations) == 0 && mmGetHistory.defaultExpectation == nil && mmGetHistory.mock.funcGetHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetHistory.mock.afterGetHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetHistory implements mm_order.Repository
+func (mmGetHistory *OrderRepositoryMock) GetHistory(ctx context.Context, i1 basetypes.ID) (spa1 []*order.StatusChange, err error) {
+	mm_atomic.AddUint64(&mmGetHistory.beforeGetHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetHistory.afterGetHistoryCounter, 1)
+
+	mmGetHistory.t.Helper()
+
+	if mmGetHistory.inspectFuncGetHistory != nil {
+		mmGetHistory.inspectFuncGetHistory(ctx, i1)
+	}
+
+	mm_params := OrderRepositoryMockGetHistoryParams{ctx, i1}
+
+	// Record call args
+	mmGetHistory.GetHistoryMock.mutex.Lock()
+	mmGetHistory.GetHistoryMock.callArgs = append(mmGetHistory.GetHistoryMock.callArgs, &mm_params)
+	mmGetHistory.GetHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetHistory.GetHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmGetHistory.GetHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetHistory.GetHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetHistory.GetHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetHistory.GetHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetHistoryParams{ctx, i1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetHistory.t.Errorf("OrderRepositoryMock.GetHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHistory.GetHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
+				mmGetHistory.t.Errorf("OrderRepositoryMock.GetHistory got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHistory.GetHistoryMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetHistory.t.Errorf("OrderRepositoryMock.GetHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetHistory.GetHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetHistory.GetHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetHistory.t.Fatal("No results are set for the OrderRepositoryMock.GetHistory")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmGetHistory.funcGetHistory != nil {
+		return mmGetHistory.funcGetHistory(ctx, i1)
+	}
+	mmGetHistory.t.Fatalf("Unexpected call to OrderRepositoryMock.GetHistory. %v %v", ctx, i1)
+	return
+}
+
+// GetHistoryAfterCounter returns a count of finished OrderRepositoryMock.GetHistory invocations
+func (mmGetHistory *OrderRepositoryMock) GetHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetHistory.afterGetHistoryCounter)
+}
+
+// GetHistoryBeforeCounter returns a count of OrderRepositoryMock.GetHistory invocations
+func (mmGetHistory *OrderRepositoryMock) GetHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetHistory.beforeGetHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetHistory *mOrderRepositoryMockGetHistory) Calls() []*OrderRepositoryMockGetHistoryParams {
+	mmGetHistory.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetHistoryParams, len(mmGetHistory.callArgs))
+	copy(argCopy, mmGetHistory.callArgs)
+
+	mmGetHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetHistoryDone returns true if the count of the GetHistory invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetHistoryDone() bool {
+	if m.GetHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetHistoryMock.invocationsDone()
+}
+
+// MinimockGetHistoryInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetHistoryInspect() {
+	for _, e := range m.GetHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetHistoryCounter := mm_atomic.LoadUint64(&m.afterGetHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetHistoryMock.defaultExpectation != nil && afterGetHistoryCounter < 1 {
+		if m.GetHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetHistory at\n%s", m.GetHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetHistory at\n%s with params: %#v", m.GetHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetHistory != nil && afterGetHistoryCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetHistory at\n%s", m.funcGetHistoryOrigin)
+	}
+
+	if !m.GetHistoryMock.invocationsDone() && afterGetHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetHistoryMock.expectedInvocations), m.GetHistoryMock.expectedInvocationsOrigin, afterGetHistoryCounter)
+	}
+}
+
+type mOrderRepositoryMockGetPackagingType struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetPackagingTypeExpectation
+	expectations       []*OrderRepositoryMockGetPackagingTypeExpectation
+
+	callArgs []*OrderRepositoryMockGetPackagingTypeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetPackagingTypeExpectation specifies expectation struct of the Repository.GetPackagingType
+type OrderRepositoryMockGetPackagingTypeExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetPackagingTypeParams
+	paramPtrs          *OrderRepositoryMockGetPackagingTypeParamPtrs
+	expectationOrigins OrderRepositoryMockGetPackagingTypeExpectationOrigins
+	results            *OrderRepositoryMockGetPackagingTypeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetPackagingTypeParams contains parameters of the Repository.GetPackagingType
+type OrderRepositoryMockGetPackagingTypeParams struct {
+	ctx  context.Context
+	name string
+}
+
+// OrderRepositoryMockGetPackagingTypeParamPtrs contains pointers to parameters of the Repository.GetPackagingType
+type OrderRepositoryMockGetPackagingTypeParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// OrderRepositoryMockGetPackagingTypeResults contains results of the Repository.GetPackagingType
+type OrderRepositoryMockGetPackagingTypeResults struct {
+	pp1 *order.PackagingType
+	err error
+}
+
+// OrderRepositoryMockGetPackagingTypeOrigins contains origins of expectations of the Repository.GetPackagingType
+type OrderRepositoryMockGetPackagingTypeExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Optional() *mOrderRepositoryMockGetPackagingType {
+	mmGetPackagingType.optional = true
+	return mmGetPackagingType
+}
+
+// Expect sets up expected params for Repository.GetPackagingType
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Expect(ctx context.Context, name string) *mOrderRepositoryMockGetPackagingType {
+	if mmGetPackagingType.mock.funcGetPackagingType != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Set")
+	}
+
+	if mmGetPackagingType.defaultExpectation == nil {
+		mmGetPackagingType.defaultExpectation = &OrderRepositoryMockGetPackagingTypeExpectation{}
+	}
+
+	if mmGetPackagingType.defaultExpectation.paramPtrs != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by ExpectParams functions")
+	}
+
+	mmGetPackagingType.defaultExpectation.params = &OrderRepositoryMockGetPackagingTypeParams{ctx, name}
+	mmGetPackagingType.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPackagingType.expectations {
+		if minimock.Equal(e.params, mmGetPackagingType.defaultExpectation.params) {
+			mmGetPackagingType.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPackagingType.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPackagingType
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPackagingType
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetPackagingType {
+	if mmGetPackagingType.mock.funcGetPackagingType != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Set")
+	}
+
+	if mmGetPackagingType.defaultExpectation == nil {
+		mmGetPackagingType.defaultExpectation = &OrderRepositoryMockGetPackagingTypeExpectation{}
+	}
+
+	if mmGetPackagingType.defaultExpectation.params != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Expect")
+	}
+
+	if mmGetPackagingType.defaultExpectation.paramPtrs == nil {
+		mmGetPackagingType.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPackagingTypeParamPtrs{}
+	}
+	mmGetPackagingType.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPackagingType.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPackagingType
+}
+
+// ExpectNameParam2 sets up expected param name for Repository.GetPackagingType
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) ExpectNameParam2(name string) *mOrderRepositoryMockGetPackagingType {
+	if mmGetPackagingType.mock.funcGetPackagingType != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Set")
+	}
+
+	if mmGetPackagingType.defaultExpectation == nil {
+		mmGetPackagingType.defaultExpectation = &OrderRepositoryMockGetPackagingTypeExpectation{}
+	}
+
+	if mmGetPackagingType.defaultExpectation.params != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Expect")
+	}
+
+	if mmGetPackagingType.defaultExpectation.paramPtrs == nil {
+		mmGetPackagingType.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPackagingTypeParamPtrs{}
+	}
+	mmGetPackagingType.defaultExpectation.paramPtrs.name = &name
+	mmGetPackagingType.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmGetPackagingType
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPackagingType
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Inspect(f func(ctx context.Context, name string)) *mOrderRepositoryMockGetPackagingType {
+	if mmGetPackagingType.mock.inspectFuncGetPackagingType != nil {
+		mmGetPackagingType.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetPackagingType")
+	}
+
+	mmGetPackagingType.mock.inspectFuncGetPackagingType = f
+
+	return mmGetPackagingType
+}
+
+// Return sets up results that will be returned by Repository.GetPackagingType
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Return(pp1 *order.PackagingType, err error) *OrderRepositoryMock {
+	if mmGetPackagingType.mock.funcGetPackagingType != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Set")
+	}
+
+	if mmGetPackagingType.defaultExpectation == nil {
+		mmGetPackagingType.defaultExpectation = &OrderRepositoryMockGetPackagingTypeExpectation{mock: mmGetPackagingType.mock}
+	}
+	mmGetPackagingType.defaultExpectation.results = &OrderRepositoryMockGetPackagingTypeResults{pp1, err}
+	mmGetPackagingType.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPackagingType.mock
+}
+
+// Set uses given function f to mock the Repository.GetPackagingType method
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Set(f func(ctx context.Context, name string) (pp1 *order.PackagingType, err error)) *OrderRepositoryMock {
+	if mmGetPackagingType.defaultExpectation != nil {
+		mmGetPackagingType.mock.t.Fatalf("Default expectation is already set for the Repository.GetPackagingType method")
+	}
+
+	if len(mmGetPackagingType.expectations) > 0 {
+		mmGetPackagingType.mock.t.Fatalf("Some expectations are already set for the Repository.GetPackagingType method")
+	}
+
+	mmGetPackagingType.mock.funcGetPackagingType = f
+	mmGetPackagingType.mock.funcGetPackagingTypeOrigin = minimock.CallerInfo(1)
+	return mmGetPackagingType.mock
+}
+
+// When sets expectation for the Repository.GetPackagingType which will trigger the result defined by the following
+// Then helper
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) When(ctx context.Context, name string) *OrderRepositoryMockGetPackagingTypeExpectation {
+	if mmGetPackagingType.mock.funcGetPackagingType != nil {
+		mmGetPackagingType.mock.t.Fatalf("OrderRepositoryMock.GetPackagingType mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetPackagingTypeExpectation{
+		mock:               mmGetPackagingType.mock,
+		params:             &OrderRepositoryMockGetPackagingTypeParams{ctx, name},
+		expectationOrigins: OrderRepositoryMockGetPackagingTypeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPackagingType.expectations = append(mmGetPackagingType.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPackagingType return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetPackagingTypeExpectation) Then(pp1 *order.PackagingType, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetPackagingTypeResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPackagingType should be invoked
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Times(n uint64) *mOrderRepositoryMockGetPackagingType {
+	if n == 0 {
+		mmGetPackagingType.mock.t.Fatalf("Times of OrderRepositoryMock.GetPackagingType mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPackagingType.expectedInvocations, n)
+	mmGetPackagingType.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPackagingType
+}
+
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) invocationsDone() bool {
+	if len(mmGetPackagingType.expectations) == 0 && mmGetPackagingType.defaultExpectation == nil && mmGetPackagingType.mock.funcGetPackagingType == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPackagingType.mock.afterGetPackagingTypeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPackagingType.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPackagingType implements mm_order.Repository
+func (mmGetPackagingType *OrderRepositoryMock) GetPackagingType(ctx context.Context, name string) (pp1 *order.PackagingType, err error) {
+	mm_atomic.AddUint64(&mmGetPackagingType.beforeGetPackagingTypeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPackagingType.afterGetPackagingTypeCounter, 1)
+
+	mmGetPackagingType.t.Helper()
+
+	if mmGetPackagingType.inspectFuncGetPackagingType != nil {
+		mmGetPackagingType.inspectFuncGetPackagingType(ctx, name)
+	}
+
+	mm_params := OrderRepositoryMockGetPackagingTypeParams{ctx, name}
+
+	// Record call args
+	mmGetPackagingType.GetPackagingTypeMock.mutex.Lock()
+	mmGetPackagingType.GetPackagingTypeMock.callArgs = append(mmGetPackagingType.GetPackagingTypeMock.callArgs, &mm_params)
+	mmGetPackagingType.GetPackagingTypeMock.mutex.Unlock()
+
+	for _, e := range mmGetPackagingType.GetPackagingTypeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetPackagingType.GetPackagingTypeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetPackagingTypeParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPackagingType.t.Errorf("OrderRepositoryMock.GetPackagingType got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmGetPackagingType.t.Errorf("OrderRepositoryMock.GetPackagingType got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPackagingType.t.Errorf("OrderRepositoryMock.GetPackagingType got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPackagingType.GetPackagingTypeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPackagingType.t.Fatal("No results are set for the OrderRepositoryMock.GetPackagingType")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetPackagingType.funcGetPackagingType != nil {
+		return mmGetPackagingType.funcGetPackagingType(ctx, name)
+	}
+	mmGetPackagingType.t.Fatalf("Unexpected call to OrderRepositoryMock.GetPackagingType. %v %v", ctx, name)
+	return
+}
+
+// GetPackagingTypeAfterCounter returns a count of finished OrderRepositoryMock.GetPackagingType invocations
+func (mmGetPackagingType *OrderRepositoryMock) GetPackagingTypeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPackagingType.afterGetPackagingTypeCounter)
+}
+
+// GetPackagingTypeBeforeCounter returns a count of OrderRepositoryMock.GetPackagingType invocations
+func (mmGetPackagingType *OrderRepositoryMock) GetPackagingTypeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPackagingType.beforeGetPackagingTypeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetPackagingType.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPackagingType *mOrderRepositoryMockGetPackagingType) Calls() []*OrderRepositoryMockGetPackagingTypeParams {
+	mmGetPackagingType.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetPackagingTypeParams, len(mmGetPackagingType.callArgs))
+	copy(argCopy, mmGetPackagingType.callArgs)
+
+	mmGetPackagingType.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPackagingTypeDone returns true if the count of the GetPackagingType invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetPackagingTypeDone() bool {
+	if m.GetPackagingTypeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPackagingTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPackagingTypeMock.invocationsDone()
+}
+
+// MinimockGetPackagingTypeInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetPackagingTypeInspect() {
+	for _, e := range m.GetPackagingTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPackagingType at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPackagingTypeCounter := mm_atomic.LoadUint64(&m.afterGetPackagingTypeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPackagingTypeMock.defaultExpectation != nil && afterGetPackagingTypeCounter < 1 {
+		if m.GetPackagingTypeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPackagingType at\n%s", m.GetPackagingTypeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPackagingType at\n%s with params: %#v", m.GetPackagingTypeMock.defaultExpectation.expectationOrigins.origin, *m.GetPackagingTypeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPackagingType != nil && afterGetPackagingTypeCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetPackagingType at\n%s", m.funcGetPackagingTypeOrigin)
+	}
+
+	if !m.GetPackagingTypeMock.invocationsDone() && afterGetPackagingTypeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetPackagingType at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPackagingTypeMock.expectedInvocations), m.GetPackagingTypeMock.expectedInvocationsOrigin, afterGetPackagingTypeCounter)
+	}
+}
+
+type mOrderRepositoryMockGetPage struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetPageExpectation
+	expectations       []*OrderRepositoryMockGetPageExpectation
+
+	callArgs []*OrderRepositoryMockGetPageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetPageExpectation specifies expectation struct of the Repository.GetPage
+type OrderRepositoryMockGetPageExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetPageParams
+	paramPtrs          *OrderRepositoryMockGetPageParamPtrs
+	expectationOrigins OrderRepositoryMockGetPageExpectationOrigins
+	results            *OrderRepositoryMockGetPageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetPageParams contains parameters of the Repository.GetPage
+type OrderRepositoryMockGetPageParams struct {
+	ctx    context.Context
+	filter *order.Filter
+	sort   []order.Sort
+	after  *order.Cursor
+	limit  int
+}
+
+// OrderRepositoryMockGetPageParamPtrs contains pointers to parameters of the Repository.GetPage
+type OrderRepositoryMockGetPageParamPtrs struct {
+	ctx    *context.Context
+	filter **order.Filter
+	sort   *[]order.Sort
+	after  **order.Cursor
+	limit  *int
+}
+
+// OrderRepositoryMockGetPageResults contains results of the Repository.GetPage
+type OrderRepositoryMockGetPageResults struct {
+	opa1 []*order.Order
+	err  error
+}
+
+// OrderRepositoryMockGetPageOrigins contains origins of expectations of the Repository.GetPage
+type OrderRepositoryMockGetPageExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+	originSort   string
+	originAfter  string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPage *mOrderRepositoryMockGetPage) Optional() *mOrderRepositoryMockGetPage {
+	mmGetPage.optional = true
+	return mmGetPage
+}
+
+// Expect sets up expected params for Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) Expect(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{}
+	}
+
+	if mmGetPage.defaultExpectation.paramPtrs != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by ExpectParams functions")
+	}
+
+	mmGetPage.defaultExpectation.params = &OrderRepositoryMockGetPageParams{ctx, filter, sort, after, limit}
+	mmGetPage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPage.expectations {
+		if minimock.Equal(e.params, mmGetPage.defaultExpectation.params) {
+			mmGetPage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{}
+	}
+
+	if mmGetPage.defaultExpectation.params != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Expect")
+	}
+
+	if mmGetPage.defaultExpectation.paramPtrs == nil {
+		mmGetPage.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPageParamPtrs{}
+	}
+	mmGetPage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPage
+}
+
+// ExpectFilterParam2 sets up expected param filter for Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) ExpectFilterParam2(filter *order.Filter) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{}
+	}
+
+	if mmGetPage.defaultExpectation.params != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Expect")
+	}
+
+	if mmGetPage.defaultExpectation.paramPtrs == nil {
+		mmGetPage.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPageParamPtrs{}
+	}
+	mmGetPage.defaultExpectation.paramPtrs.filter = &filter
+	mmGetPage.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetPage
+}
+
+// ExpectSortParam3 sets up expected param sort for Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) ExpectSortParam3(sort []order.Sort) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{}
+	}
+
+	if mmGetPage.defaultExpectation.params != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Expect")
+	}
+
+	if mmGetPage.defaultExpectation.paramPtrs == nil {
+		mmGetPage.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPageParamPtrs{}
+	}
+	mmGetPage.defaultExpectation.paramPtrs.sort = &sort
+	mmGetPage.defaultExpectation.expectationOrigins.originSort = minimock.CallerInfo(1)
+
+	return mmGetPage
+}
+
+// ExpectAfterParam4 sets up expected param after for Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) ExpectAfterParam4(after *order.Cursor) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{}
+	}
+
+	if mmGetPage.defaultExpectation.params != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Expect")
+	}
+
+	if mmGetPage.defaultExpectation.paramPtrs == nil {
+		mmGetPage.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPageParamPtrs{}
+	}
+	mmGetPage.defaultExpectation.paramPtrs.after = &after
+	mmGetPage.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmGetPage
+}
+
+// ExpectLimitParam5 sets up expected param limit for Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) ExpectLimitParam5(limit int) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{}
+	}
+
+	if mmGetPage.defaultExpectation.params != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Expect")
+	}
+
+	if mmGetPage.defaultExpectation.paramPtrs == nil {
+		mmGetPage.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPageParamPtrs{}
+	}
+	mmGetPage.defaultExpectation.paramPtrs.limit = &limit
+	mmGetPage.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetPage
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) Inspect(f func(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int)) *mOrderRepositoryMockGetPage {
+	if mmGetPage.mock.inspectFuncGetPage != nil {
+		mmGetPage.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetPage")
+	}
+
+	mmGetPage.mock.inspectFuncGetPage = f
+
+	return mmGetPage
+}
+
+// Return sets up results that will be returned by Repository.GetPage
+func (mmGetPage *mOrderRepositoryMockGetPage) Return(opa1 []*order.Order, err error) *OrderRepositoryMock {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	if mmGetPage.defaultExpectation == nil {
+		mmGetPage.defaultExpectation = &OrderRepositoryMockGetPageExpectation{mock: mmGetPage.mock}
+	}
+	mmGetPage.defaultExpectation.results = &OrderRepositoryMockGetPageResults{opa1, err}
+	mmGetPage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPage.mock
+}
+
+// Set uses given function f to mock the Repository.GetPage method
+func (mmGetPage *mOrderRepositoryMockGetPage) Set(f func(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) (opa1 []*order.Order, err error)) *OrderRepositoryMock {
+	if mmGetPage.defaultExpectation != nil {
+		mmGetPage.mock.t.Fatalf("Default expectation is already set for the Repository.GetPage method")
+	}
+
+	if len(mmGetPage.expectations) > 0 {
+		mmGetPage.mock.t.Fatalf("Some expectations are already set for the Repository.GetPage method")
+	}
+
+	mmGetPage.mock.funcGetPage = f
+	mmGetPage.mock.funcGetPageOrigin = minimock.CallerInfo(1)
+	return mmGetPage.mock
+}
+
+// When sets expectation for the Repository.GetPage which will trigger the result defined by the following
+// Then helper
+func (mmGetPage *mOrderRepositoryMockGetPage) When(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) *OrderRepositoryMockGetPageExpectation {
+	if mmGetPage.mock.funcGetPage != nil {
+		mmGetPage.mock.t.Fatalf("OrderRepositoryMock.GetPage mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetPageExpectation{
+		mock:               mmGetPage.mock,
+		params:             &OrderRepositoryMockGetPageParams{ctx, filter, sort, after, limit},
+		expectationOrigins: OrderRepositoryMockGetPageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPage.expectations = append(mmGetPage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPage return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetPageExpectation) Then(opa1 []*order.Order, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetPageResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPage should be invoked
+func (mmGetPage *mOrderRepositoryMockGetPage) Times(n uint64) *mOrderRepositoryMockGetPage {
+	if n == 0 {
+		mmGetPage.mock.t.Fatalf("Times of OrderRepositoryMock.GetPage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPage.expectedInvocations, n)
+	mmGetPage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPage
+}
+
+func (mmGetPage *mOrderRepositoryMockGetPage) invocationsDone() bool {
+	if len(mmGetPage.expectations) == 0 && mmGetPage.defaultExpectation == nil && mmGetPage.mock.funcGetPage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPage.mock.afterGetPageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPage implements mm_order.Repository
+func (mmGetPage *OrderRepositoryMock) GetPage(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) (opa1 []*order.Order, err error) {
+	mm_atomic.AddUint64(&mmGetPage.beforeGetPageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPage.afterGetPageCounter, 1)
+
+	mmGetPage.t.Helper()
+
+	if mmGetPage.inspectFuncGetPage != nil {
+		mmGetPage.inspectFuncGetPage(ctx, filter, sort, after, limit)
+	}
+
+	mm_params := OrderRepositoryMockGetPageParams{ctx, filter, sort, after, limit}
+
+	// Record call args
+	mmGetPage.GetPageMock.mutex.Lock()
+	mmGetPage.GetPageMock.callArgs = append(mmGetPage.GetPageMock.callArgs, &mm_params)
+	mmGetPage.GetPageMock.mutex.Unlock()
+
+	for _, e := range mmGetPage.GetPageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmGetPage.GetPageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPage.GetPageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPage.GetPageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPage.GetPageMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetPageParams{ctx, filter, sort, after, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPage.t.Errorf("OrderRepositoryMock.GetPage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPage.GetPageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetPage.t.Errorf("OrderRepositoryMock.GetPage got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPage.GetPageMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.sort != nil && !minimock.Equal(*mm_want_ptrs.sort, mm_got.sort) {
+				mmGetPage.t.Errorf("OrderRepositoryMock.GetPage got unexpected parameter sort, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPage.GetPageMock.defaultExpectation.expectationOrigins.originSort, *mm_want_ptrs.sort, mm_got.sort, minimock.Diff(*mm_want_ptrs.sort, mm_got.sort))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmGetPage.t.Errorf("OrderRepositoryMock.GetPage got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPage.GetPageMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetPage.t.Errorf("OrderRepositoryMock.GetPage got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPage.GetPageMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPage.t.Errorf("OrderRepositoryMock.GetPage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPage.GetPageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPage.GetPageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPage.t.Fatal("No results are set for the OrderRepositoryMock.GetPage")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmGetPage.funcGetPage != nil {
+		return mmGetPage.funcGetPage(ctx, filter, sort, after, limit)
+	}
+	mmGetPage.t.Fatalf("Unexpected call to OrderRepositoryMock.GetPage. %v %v %v %v %v", ctx, filter, sort, after, limit)
+	return
+}
+
+// GetPageAfterCounter returns a count of finished OrderRepositoryMock.GetPage invocations
+func (mmGetPage *OrderRepositoryMock) GetPageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPage.afterGetPageCounter)
+}
+
+// GetPageBeforeCounter returns a count of OrderRepositoryMock.GetPage invocations
+func (mmGetPage *OrderRepositoryMock) GetPageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPage.beforeGetPageCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetPage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPage *mOrderRepositoryMockGetPage) Calls() []*OrderRepositoryMockGetPageParams {
+	mmGetPage.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetPageParams, len(mmGetPage.callArgs))
+	copy(argCopy, mmGetPage.callArgs)
+
+	mmGetPage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPageDone returns true if the count of the GetPage invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetPageDone() bool {
+	if m.GetPageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPageMock.invocationsDone()
+}
+
+// MinimockGetPageInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetPageInspect() {
+	for _, e := range m.GetPageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPageCounter := mm_atomic.LoadUint64(&m.afterGetPageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPageMock.defaultExpectation != nil && afterGetPageCounter < 1 {
+		if m.GetPageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPage at\n%s", m.GetPageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPage at\n%s with params: %#v", m.GetPageMock.defaultExpectation.expectationOrigins.origin, *m.GetPageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPage != nil && afterGetPageCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetPage at\n%s", m.funcGetPageOrigin)
+	}
+
+	if !m.GetPageMock.invocationsDone() && afterGetPageCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetPage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPageMock.expectedInvocations), m.GetPageMock.expectedInvocationsOrigin, afterGetPageCounter)
+	}
+}
+
+type mOrderRepositoryMockGetPickupPoint struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockGetPickupPointExpectation
+	expectations       []*OrderRepositoryMockGetPickupPointExpectation
+
+	callArgs []*OrderRepositoryMockGetPickupPointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockGetPickupPointExpectation specifies expectation struct of the Repository.GetPickupPoint
+type OrderRepositoryMockGetPickupPointExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockGetPickupPointParams
+	paramPtrs          *OrderRepositoryMockGetPickupPointParamPtrs
+	expectationOrigins OrderRepositoryMockGetPickupPointExpectationOrigins
+	results            *OrderRepositoryMockGetPickupPointResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockGetPickupPointParams contains parameters of the Repository.GetPickupPoint
+type OrderRepositoryMockGetPickupPointParams struct {
+	ctx context.Context
+	i1  basetypes.ID
+}
+
+// OrderRepositoryMockGetPickupPointParamPtrs contains pointers to parameters of the Repository.GetPickupPoint
+type OrderRepositoryMockGetPickupPointParamPtrs struct {
+	ctx *context.Context
+	i1  *basetypes.ID
+}
+
+// OrderRepositoryMockGetPickupPointResults contains results of the Repository.GetPickupPoint
+type OrderRepositoryMockGetPickupPointResults struct {
+	pp1 *pickuppoint.PickupPoint
+	err error
+}
+
+// OrderRepositoryMockGetPickupPointOrigins contains origins of expectations of the Repository.GetPickupPoint
+type OrderRepositoryMockGetPickupPointExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originI1  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Optional() *mOrderRepositoryMockGetPickupPoint {
+	mmGetPickupPoint.optional = true
+	return mmGetPickupPoint
+}
+
+// Expect sets up expected params for Repository.GetPickupPoint
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockGetPickupPoint {
+	if mmGetPickupPoint.mock.funcGetPickupPoint != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Set")
+	}
+
+	if mmGetPickupPoint.defaultExpectation == nil {
+		mmGetPickupPoint.defaultExpectation = &OrderRepositoryMockGetPickupPointExpectation{}
+	}
+
+	if mmGetPickupPoint.defaultExpectation.paramPtrs != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by ExpectParams functions")
+	}
+
+	mmGetPickupPoint.defaultExpectation.params = &OrderRepositoryMockGetPickupPointParams{ctx, i1}
+	mmGetPickupPoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPickupPoint.expectations {
+		if minimock.Equal(e.params, mmGetPickupPoint.defaultExpectation.params) {
+			mmGetPickupPoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPickupPoint.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPickupPoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPickupPoint
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetPickupPoint {
+	if mmGetPickupPoint.mock.funcGetPickupPoint != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Set")
+	}
+
+	if mmGetPickupPoint.defaultExpectation == nil {
+		mmGetPickupPoint.defaultExpectation = &OrderRepositoryMockGetPickupPointExpectation{}
+	}
+
+	if mmGetPickupPoint.defaultExpectation.params != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Expect")
+	}
+
+	if mmGetPickupPoint.defaultExpectation.paramPtrs == nil {
+		mmGetPickupPoint.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPickupPointParamPtrs{}
+	}
+	mmGetPickupPoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPickupPoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPickupPoint
+}
+
+// ExpectI1Param2 sets up expected param i1 for Repository.GetPickupPoint
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockGetPickupPoint {
+	if mmGetPickupPoint.mock.funcGetPickupPoint != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Set")
+	}
+
+	if mmGetPickupPoint.defaultExpectation == nil {
+		mmGetPickupPoint.defaultExpectation = &OrderRepositoryMockGetPickupPointExpectation{}
+	}
+
+	if mmGetPickupPoint.defaultExpectation.params != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Expect")
+	}
+
+	if mmGetPickupPoint.defaultExpectation.paramPtrs == nil {
+		mmGetPickupPoint.defaultExpectation.paramPtrs = &OrderRepositoryMockGetPickupPointParamPtrs{}
+	}
+	mmGetPickupPoint.defaultExpectation.paramPtrs.i1 = &i1
+	mmGetPickupPoint.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+
+	return mmGetPickupPoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPickupPoint
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockGetPickupPoint {
+	if mmGetPickupPoint.mock.inspectFuncGetPickupPoint != nil {
+		mmGetPickupPoint.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetPickupPoint")
+	}
+
+	mmGetPickupPoint.mock.inspectFuncGetPickupPoint = f
+
+	return mmGetPickupPoint
+}
+
+// Return sets up results that will be returned by Repository.GetPickupPoint
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Return(pp1 *pickuppoint.PickupPoint, err error) *OrderRepositoryMock {
+	if mmGetPickupPoint.mock.funcGetPickupPoint != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Set")
+	}
+
+	if mmGetPickupPoint.defaultExpectation == nil {
+		mmGetPickupPoint.defaultExpectation = &OrderRepositoryMockGetPickupPointExpectation{mock: mmGetPickupPoint.mock}
+	}
+	mmGetPickupPoint.defaultExpectation.results = &OrderRepositoryMockGetPickupPointResults{pp1, err}
+	mmGetPickupPoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPickupPoint.mock
+}
+
+// Set uses given function f to mock the Repository.GetPickupPoint method
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Set(f func(ctx context.Context, i1 basetypes.ID) (pp1 *pickuppoint.PickupPoint, err error)) *OrderRepositoryMock {
+	if mmGetPickupPoint.defaultExpectation != nil {
+		mmGetPickupPoint.mock.t.Fatalf("Default expectation is already set for the Repository.GetPickupPoint method")
+	}
+
+	if len(mmGetPickupPoint.expectations) > 0 {
+		mmGetPickupPoint.mock.t.Fatalf("Some expectations are already set for the Repository.GetPickupPoint method")
+	}
+
+	mmGetPickupPoint.mock.funcGetPickupPoint = f
+	mmGetPickupPoint.mock.funcGetPickupPointOrigin = minimock.CallerInfo(1)
+	return mmGetPickupPoint.mock
+}
+
+// When sets expectation for the Repository.GetPickupPoint which will trigger the result defined by the following
+// Then helper
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockGetPickupPointExpectation {
+	if mmGetPickupPoint.mock.funcGetPickupPoint != nil {
+		mmGetPickupPoint.mock.t.Fatalf("OrderRepositoryMock.GetPickupPoint mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockGetPickupPointExpectation{
+		mock:               mmGetPickupPoint.mock,
+		params:             &OrderRepositoryMockGetPickupPointParams{ctx, i1},
+		expectationOrigins: OrderRepositoryMockGetPickupPointExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPickupPoint.expectations = append(mmGetPickupPoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPickupPoint return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockGetPickupPointExpectation) Then(pp1 *pickuppoint.PickupPoint, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockGetPickupPointResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPickupPoint should be invoked
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Times(n uint64) *mOrderRepositoryMockGetPickupPoint {
+	if n == 0 {
+		mmGetPickupPoint.mock.t.Fatalf("Times of OrderRepositoryMock.GetPickupPoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPickupPoint.expectedInvocations, n)
+	mmGetPickupPoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPickupPoint
+}
+
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) invocationsDone() bool {
+	if len(mmGetPickupPoint.expectations) == 0 && mmGetPickupPoint.defaultExpectation == nil && mmGetPickupPoint.mock.funcGetPickupPoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPickupPoint.mock.afterGetPickupPointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPickupPoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPickupPoint implements mm_order.Repository
+func (mmGetPickupPoint *OrderRepositoryMock) GetPickupPoint(ctx context.Context, i1 basetypes.ID) (pp1 *pickuppoint.PickupPoint, err error) {
+	mm_atomic.AddUint64(&mmGetPickupPoint.beforeGetPickupPointCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPickupPoint.afterGetPickupPointCounter, 1)
+
+	mmGetPickupPoint.t.Helper()
+
+	if mmGetPickupPoint.inspectFuncGetPickupPoint != nil {
+		mmGetPickupPoint.inspectFuncGetPickupPoint(ctx, i1)
+	}
+
+	mm_params := OrderRepositoryMockGetPickupPointParams{ctx, i1}
+
+	// Record call args
+	mmGetPickupPoint.GetPickupPointMock.mutex.Lock()
+	mmGetPickupPoint.GetPickupPointMock.callArgs = append(mmGetPickupPoint.GetPickupPointMock.callArgs, &mm_params)
+	mmGetPickupPoint.GetPickupPointMock.mutex.Unlock()
+
+	for _, e := range mmGetPickupPoint.GetPickupPointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetPickupPoint.GetPickupPointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPickupPoint.GetPickupPointMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPickupPoint.GetPickupPointMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPickupPoint.GetPickupPointMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockGetPickupPointParams{ctx, i1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPickupPoint.t.Errorf("OrderRepositoryMock.GetPickupPoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPickupPoint.GetPickupPointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
+				mmGetPickupPoint.t.Errorf("OrderRepositoryMock.GetPickupPoint got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPickupPoint.GetPickupPointMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPickupPoint.t.Errorf("OrderRepositoryMock.GetPickupPoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPickupPoint.GetPickupPointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPickupPoint.GetPickupPointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPickupPoint.t.Fatal("No results are set for the OrderRepositoryMock.GetPickupPoint")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetPickupPoint.funcGetPickupPoint != nil {
+		return mmGetPickupPoint.funcGetPickupPoint(ctx, i1)
+	}
+	mmGetPickupPoint.t.Fatalf("Unexpected call to OrderRepositoryMock.GetPickupPoint. %v %v", ctx, i1)
+	return
+}
+
+// GetPickupPointAfterCounter returns a count of finished OrderRepositoryMock.GetPickupPoint invocations
+func (mmGetPickupPoint *OrderRepositoryMock) GetPickupPointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPickupPoint.afterGetPickupPointCounter)
+}
+
+// GetPickupPointBeforeCounter returns a count of OrderRepositoryMock.GetPickupPoint invocations
+func (mmGetPickupPoint *OrderRepositoryMock) GetPickupPointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPickupPoint.beforeGetPickupPointCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetPickupPoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPickupPoint *mOrderRepositoryMockGetPickupPoint) Calls() []*OrderRepositoryMockGetPickupPointParams {
+	mmGetPickupPoint.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockGetPickupPointParams, len(mmGetPickupPoint.callArgs))
+	copy(argCopy, mmGetPickupPoint.callArgs)
+
+	mmGetPickupPoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPickupPointDone returns true if the count of the GetPickupPoint invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockGetPickupPointDone() bool {
+	if m.GetPickupPointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPickupPointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPickupPointMock.invocationsDone()
+}
+
+// MinimockGetPickupPointInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockGetPickupPointInspect() {
+	for _, e := range m.GetPickupPointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPickupPoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPickupPointCounter := mm_atomic.LoadUint64(&m.afterGetPickupPointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPickupPointMock.defaultExpectation != nil && afterGetPickupPointCounter < 1 {
+		if m.GetPickupPointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPickupPoint at\n%s", m.GetPickupPointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.GetPickupPoint at\n%s with params: %#v", m.GetPickupPointMock.defaultExpectation.expectationOrigins.origin, *m.GetPickupPointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPickupPoint != nil && afterGetPickupPointCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.GetPickupPoint at\n%s", m.funcGetPickupPointOrigin)
+	}
+
+	if !m.GetPickupPointMock.invocationsDone() && afterGetPickupPointCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetPickupPoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPickupPointMock.expectedInvocations), m.GetPickupPointMock.expectedInvocationsOrigin, afterGetPickupPointCounter)
+	}
+}
+
+type mOrderRepositoryMockIssueList struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockIssueListExpectation
+	expectations       []*OrderRepositoryMockIssueListExpectation
+
+	callArgs []*OrderRepositoryMockIssueListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockIssueListExpectation specifies expectation struct of the Repository.IssueList
+type OrderRepositoryMockIssueListExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockIssueListParams
+	paramPtrs          *OrderRepositoryMockIssueListParamPtrs
+	expectationOrigins OrderRepositoryMockIssueListExpectationOrigins
+	results            *OrderRepositoryMockIssueListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockIssueListParams contains parameters of the Repository.IssueList
+type OrderRepositoryMockIssueListParams struct {
+	ctx    context.Context
+	ids    []basetypes.ID
+	redeem func(context.Context, verification.Storage) error
+	update func([]*order.Order) ([]*order.Order, []order.Event, error)
+}
+
+// OrderRepositoryMockIssueListParamPtrs contains pointers to parameters of the Repository.IssueList
+type OrderRepositoryMockIssueListParamPtrs struct {
+	ctx    *context.Context
+	ids    *[]basetypes.ID
+	redeem *func(context.Context, verification.Storage) error
+	update *func([]*order.Order) ([]*order.Order, []order.Event, error)
+}
+
+// OrderRepositoryMockIssueListResults contains results of the Repository.IssueList
+type OrderRepositoryMockIssueListResults struct {
+	err error
+}
+
+// OrderRepositoryMockIssueListOrigins contains origins of expectations of the Repository.IssueList
+type OrderRepositoryMockIssueListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originIds    string
+	originRedeem string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIssueList *mOrderRepositoryMockIssueList) Optional() *mOrderRepositoryMockIssueList {
+	mmIssueList.optional = true
+	return mmIssueList
+}
+
+// Expect sets up expected params for Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) Expect(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) *mOrderRepositoryMockIssueList {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	if mmIssueList.defaultExpectation == nil {
+		mmIssueList.defaultExpectation = &OrderRepositoryMockIssueListExpectation{}
+	}
+
+	if mmIssueList.defaultExpectation.paramPtrs != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by ExpectParams functions")
+	}
+
+	mmIssueList.defaultExpectation.params = &OrderRepositoryMockIssueListParams{ctx, ids, redeem, update}
+	mmIssueList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIssueList.expectations {
+		if minimock.Equal(e.params, mmIssueList.defaultExpectation.params) {
+			mmIssueList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIssueList.defaultExpectation.params)
+		}
+	}
+
+	return mmIssueList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockIssueList {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	if mmIssueList.defaultExpectation == nil {
+		mmIssueList.defaultExpectation = &OrderRepositoryMockIssueListExpectation{}
+	}
+
+	if mmIssueList.defaultExpectation.params != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Expect")
+	}
+
+	if mmIssueList.defaultExpectation.paramPtrs == nil {
+		mmIssueList.defaultExpectation.paramPtrs = &OrderRepositoryMockIssueListParamPtrs{}
+	}
+	mmIssueList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIssueList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIssueList
+}
+
+// ExpectIdsParam2 sets up expected param ids for Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) ExpectIdsParam2(ids []basetypes.ID) *mOrderRepositoryMockIssueList {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	if mmIssueList.defaultExpectation == nil {
+		mmIssueList.defaultExpectation = &OrderRepositoryMockIssueListExpectation{}
+	}
+
+	if mmIssueList.defaultExpectation.params != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Expect")
+	}
+
+	if mmIssueList.defaultExpectation.paramPtrs == nil {
+		mmIssueList.defaultExpectation.paramPtrs = &OrderRepositoryMockIssueListParamPtrs{}
+	}
+	mmIssueList.defaultExpectation.paramPtrs.ids = &ids
+	mmIssueList.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmIssueList
+}
+
+// ExpectRedeemParam3 sets up expected param redeem for Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) ExpectRedeemParam3(redeem func(context.Context, verification.Storage) error) *mOrderRepositoryMockIssueList {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	if mmIssueList.defaultExpectation == nil {
+		mmIssueList.defaultExpectation = &OrderRepositoryMockIssueListExpectation{}
+	}
+
+	if mmIssueList.defaultExpectation.params != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Expect")
+	}
+
+	if mmIssueList.defaultExpectation.paramPtrs == nil {
+		mmIssueList.defaultExpectation.paramPtrs = &OrderRepositoryMockIssueListParamPtrs{}
+	}
+	mmIssueList.defaultExpectation.paramPtrs.redeem = &redeem
+	mmIssueList.defaultExpectation.expectationOrigins.originRedeem = minimock.CallerInfo(1)
+
+	return mmIssueList
+}
+
+// ExpectUpdateParam4 sets up expected param update for Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) ExpectUpdateParam4(update func([]*order.Order) ([]*order.Order, []order.Event, error)) *mOrderRepositoryMockIssueList {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	if mmIssueList.defaultExpectation == nil {
+		mmIssueList.defaultExpectation = &OrderRepositoryMockIssueListExpectation{}
+	}
+
+	if mmIssueList.defaultExpectation.params != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Expect")
+	}
+
+	if mmIssueList.defaultExpectation.paramPtrs == nil {
+		mmIssueList.defaultExpectation.paramPtrs = &OrderRepositoryMockIssueListParamPtrs{}
+	}
+	mmIssueList.defaultExpectation.paramPtrs.update = &update
+	mmIssueList.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmIssueList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) Inspect(f func(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error))) *mOrderRepositoryMockIssueList {
+	if mmIssueList.mock.inspectFuncIssueList != nil {
+		mmIssueList.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.IssueList")
+	}
+
+	mmIssueList.mock.inspectFuncIssueList = f
+
+	return mmIssueList
+}
+
+// Return sets up results that will be returned by Repository.IssueList
+func (mmIssueList *mOrderRepositoryMockIssueList) Return(err error) *OrderRepositoryMock {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	if mmIssueList.defaultExpectation == nil {
+		mmIssueList.defaultExpectation = &OrderRepositoryMockIssueListExpectation{mock: mmIssueList.mock}
+	}
+	mmIssueList.defaultExpectation.results = &OrderRepositoryMockIssueListResults{err}
+	mmIssueList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIssueList.mock
+}
+
+// Set uses given function f to mock the Repository.IssueList method
+func (mmIssueList *mOrderRepositoryMockIssueList) Set(f func(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error)) *OrderRepositoryMock {
+	if mmIssueList.defaultExpectation != nil {
+		mmIssueList.mock.t.Fatalf("Default expectation is already set for the Repository.IssueList method")
+	}
+
+	if len(mmIssueList.expectations) > 0 {
+		mmIssueList.mock.t.Fatalf("Some expectations are already set for the Repository.IssueList method")
+	}
+
+	mmIssueList.mock.funcIssueList = f
+	mmIssueList.mock.funcIssueListOrigin = minimock.CallerInfo(1)
+	return mmIssueList.mock
+}
+
+// When sets expectation for the Repository.IssueList which will trigger the result defined by the following
+// Then helper
+func (mmIssueList *mOrderRepositoryMockIssueList) When(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) *OrderRepositoryMockIssueListExpectation {
+	if mmIssueList.mock.funcIssueList != nil {
+		mmIssueList.mock.t.Fatalf("OrderRepositoryMock.IssueList mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockIssueListExpectation{
+		mock:               mmIssueList.mock,
+		params:             &OrderRepositoryMockIssueListParams{ctx, ids, redeem, update},
+		expectationOrigins: OrderRepositoryMockIssueListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIssueList.expectations = append(mmIssueList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.IssueList return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockIssueListExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockIssueListResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.IssueList should be invoked
+func (mmIssueList *mOrderRepositoryMockIssueList) Times(n uint64) *mOrderRepositoryMockIssueList {
+	if n == 0 {
+		mmIssueList.mock.t.Fatalf("Times of OrderRepositoryMock.IssueList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIssueList.expectedInvocations, n)
+	mmIssueList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIssueList
+}
+
+func (mmIssueList *mOrderRepositoryMockIssueList) invocationsDone() bool {
+	if len(mmIssueList.expectations) == 0 && mmIssueList.defaultExpectation == nil && mmIssueList.mock.funcIssueList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIssueList.mock.afterIssueListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIssueList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IssueList implements mm_order.Repository
+func (mmIssueList *OrderRepositoryMock) IssueList(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error) {
+	mm_atomic.AddUint64(&mmIssueList.beforeIssueListCounter, 1)
+	defer mm_atomic.AddUint64(&mmIssueList.afterIssueListCounter, 1)
+
+	mmIssueList.t.Helper()
+
+	if mmIssueList.inspectFuncIssueList != nil {
+		mmIssueList.inspectFuncIssueList(ctx, ids, redeem, update)
+	}
+
+	mm_params := OrderRepositoryMockIssueListParams{ctx, ids, redeem, update}
+
+	// Record call args
+	mmIssueList.IssueListMock.mutex.Lock()
+	mmIssueList.IssueListMock.callArgs = append(mmIssueList.IssueListMock.callArgs, &mm_params)
+	mmIssueList.IssueListMock.mutex.Unlock()
+
+	for _, e := range mmIssueList.IssueListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmIssueList.IssueListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIssueList.IssueListMock.defaultExpectation.Counter, 1)
+		mm_want := mmIssueList.IssueListMock.defaultExpectation.params
+		mm_want_ptrs := mmIssueList.IssueListMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockIssueListParams{ctx, ids, redeem, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIssueList.t.Errorf("OrderRepositoryMock.IssueList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueList.IssueListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmIssueList.t.Errorf("OrderRepositoryMock.IssueList got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueList.IssueListMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+			if mm_want_ptrs.redeem != nil && !minimock.Equal(*mm_want_ptrs.redeem, mm_got.redeem) {
+				mmIssueList.t.Errorf("OrderRepositoryMock.IssueList got unexpected parameter redeem, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueList.IssueListMock.defaultExpectation.expectationOrigins.originRedeem, *mm_want_ptrs.redeem, mm_got.redeem, minimock.Diff(*mm_want_ptrs.redeem, mm_got.redeem))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmIssueList.t.Errorf("OrderRepositoryMock.IssueList got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueList.IssueListMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIssueList.t.Errorf("OrderRepositoryMock.IssueList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIssueList.IssueListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIssueList.IssueListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIssueList.t.Fatal("No results are set for the OrderRepositoryMock.IssueList")
+		}
+		return (*mm_results).err
+	}
+	if mmIssueList.funcIssueList != nil {
+		return mmIssueList.funcIssueList(ctx, ids, redeem, update)
+	}
+	mmIssueList.t.Fatalf("Unexpected call to OrderRepositoryMock.IssueList. %v %v %v %v", ctx, ids, redeem, update)
+	return
+}
+
+// IssueListAfterCounter returns a count of finished OrderRepositoryMock.IssueList invocations
+func (mmIssueList *OrderRepositoryMock) IssueListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueList.afterIssueListCounter)
+}
+
+// IssueListBeforeCounter returns a count of OrderRepositoryMock.IssueList invocations
+func (mmIssueList *OrderRepositoryMock) IssueListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueList.beforeIssueListCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.IssueList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIssueList *mOrderRepositoryMockIssueList) Calls() []*OrderRepositoryMockIssueListParams {
+	mmIssueList.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockIssueListParams, len(mmIssueList.callArgs))
+	copy(argCopy, mmIssueList.callArgs)
+
+	mmIssueList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIssueListDone returns true if the count of the IssueList invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockIssueListDone() bool {
+	if m.IssueListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IssueListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IssueListMock.invocationsDone()
+}
+
+// MinimockIssueListInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockIssueListInspect() {
+	for _, e := range m.IssueListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.IssueList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIssueListCounter := mm_atomic.LoadUint64(&m.afterIssueListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IssueListMock.defaultExpectation != nil && afterIssueListCounter < 1 {
+		if m.IssueListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.IssueList at\n%s", m.IssueListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.IssueList at\n%s with params: %#v", m.IssueListMock.defaultExpectation.expectationOrigins.origin, *m.IssueListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIssueList != nil && afterIssueListCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.IssueList at\n%s", m.funcIssueListOrigin)
+	}
+
+	if !m.IssueListMock.invocationsDone() && afterIssueListCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.IssueList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IssueListMock.expectedInvocations), m.IssueListMock.expectedInvocationsOrigin, afterIssueListCounter)
+	}
+}
+
+type mOrderRepositoryMockListPackagingTypes struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockListPackagingTypesExpectation
+	expectations       []*OrderRepositoryMockListPackagingTypesExpectation
+
+	callArgs []*OrderRepositoryMockListPackagingTypesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockListPackagingTypesExpectation specifies expectation struct of the Repository.ListPackagingTypes
+type OrderRepositoryMockListPackagingTypesExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockListPackagingTypesParams
+	paramPtrs          *OrderRepositoryMockListPackagingTypesParamPtrs
+	expectationOrigins OrderRepositoryMockListPackagingTypesExpectationOrigins
+	results            *OrderRepositoryMockListPackagingTypesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockListPackagingTypesParams contains parameters of the Repository.ListPackagingTypes
+type OrderRepositoryMockListPackagingTypesParams struct {
+	ctx context.Context
+}
+
+// OrderRepositoryMockListPackagingTypesParamPtrs contains pointers to parameters of the Repository.ListPackagingTypes
+type OrderRepositoryMockListPackagingTypesParamPtrs struct {
+	ctx *context.Context
+}
+
+// OrderRepositoryMockListPackagingTypesResults contains results of the Repository.ListPackagingTypes
+type OrderRepositoryMockListPackagingTypesResults struct {
+	ppa1 []*order.PackagingType
+	err  error
+}
+
+// OrderRepositoryMockListPackagingTypesOrigins contains origins of expectations of the Repository.ListPackagingTypes
+type OrderRepositoryMockListPackagingTypesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Optional() *mOrderRepositoryMockListPackagingTypes {
+	mmListPackagingTypes.optional = true
+	return mmListPackagingTypes
+}
+
+// Expect sets up expected params for Repository.ListPackagingTypes
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Expect(ctx context.Context) *mOrderRepositoryMockListPackagingTypes {
+	if mmListPackagingTypes.mock.funcListPackagingTypes != nil {
+		mmListPackagingTypes.mock.t.Fatalf("OrderRepositoryMock.ListPackagingTypes mock is already set by Set")
+	}
+
+	if mmListPackagingTypes.defaultExpectation == nil {
+		mmListPackagingTypes.defaultExpectation = &OrderRepositoryMockListPackagingTypesExpectation{}
+	}
+
+	if mmListPackagingTypes.defaultExpectation.paramPtrs != nil {
+		mmListPackagingTypes.mock.t.Fatalf("OrderRepositoryMock.ListPackagingTypes mock is already set by ExpectParams functions")
+	}
+
+	mmListPackagingTypes.defaultExpectation.params = &OrderRepositoryMockListPackagingTypesParams{ctx}
+	mmListPackagingTypes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPackagingTypes.expectations {
+		if minimock.Equal(e.params, mmListPackagingTypes.defaultExpectation.params) {
+			mmListPackagingTypes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPackagingTypes.defaultExpectation.params)
+		}
+	}
+
+	return mmListPackagingTypes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPackagingTypes
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockListPackagingTypes {
+	if mmListPackagingTypes.mock.funcListPackagingTypes != nil {
+		mmListPackagingTypes.mock.t.Fatalf("OrderRepositoryMock.ListPackagingTypes mock is already set by Set")
+	}
+
+	if mmListPackagingTypes.defaultExpectation == nil {
+		mmListPackagingTypes.defaultExpectation = &OrderRepositoryMockListPackagingTypesExpectation{}
+	}
+
+	if mmListPackagingTypes.defaultExpectation.params != nil {
+		mmListPackagingTypes.mock.t.Fatalf("OrderRepositoryMock.ListPackagingTypes mock is already set by Expect")
+	}
+
+	if mmListPackagingTypes.defaultExpectation.paramPtrs == nil {
+		mmListPackagingTypes.defaultExpectation.paramPtrs = &OrderRepositoryMockListPackagingTypesParamPtrs{}
+	}
+	mmListPackagingTypes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPackagingTypes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPackagingTypes
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPackagingTypes
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Inspect(f func(ctx context.Context)) *mOrderRepositoryMockListPackagingTypes {
+	if mmListPackagingTypes.mock.inspectFuncListPackagingTypes != nil {
+		mmListPackagingTypes.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.ListPackagingTypes")
+	}
+
+	mmListPackagingTypes.mock.inspectFuncListPackagingTypes = f
+
+	return mmListPackagingTypes
+}
+
+// Return sets up results that will be returned by Repository.ListPackagingTypes
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Return(ppa1 []*order.PackagingType, err error) *OrderRepositoryMock {
+	if mmListPackagingTypes.mock.funcListPackagingTypes != nil {
+		mmListPackagingTypes.mock.t.Fatalf("OrderRepositoryMock.ListPackagingTypes mock is already set by Set")
+	}
+
+	if mmListPackagingTypes.defaultExpectation == nil {
+		mmListPackagingTypes.defaultExpectation = &OrderRepositoryMockListPackagingTypesExpectation{mock: mmListPackagingTypes.mock}
+	}
+	mmListPackagingTypes.defaultExpectation.results = &OrderRepositoryMockListPackagingTypesResults{ppa1, err}
+	mmListPackagingTypes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPackagingTypes.mock
+}
+
+// Set uses given function f to mock the Repository.ListPackagingTypes method
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Set(f func(ctx context.Context) (ppa1 []*order.PackagingType, err error)) *OrderRepositoryMock {
+	if mmListPackagingTypes.defaultExpectation != nil {
+		mmListPackagingTypes.mock.t.Fatalf("Default expectation is already set for the Repository.ListPackagingTypes method")
+	}
+
+	if len(mmListPackagingTypes.expectations) > 0 {
+		mmListPackagingTypes.mock.t.Fatalf("Some expectations are already set for the Repository.ListPackagingTypes method")
+	}
+
+	mmListPackagingTypes.mock.funcListPackagingTypes = f
+	mmListPackagingTypes.mock.funcListPackagingTypesOrigin = minimock.CallerInfo(1)
+	return mmListPackagingTypes.mock
+}
+
+// When sets expectation for the Repository.ListPackagingTypes which will trigger the result defined by the following
+// Then helper
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) When(ctx context.Context) *OrderRepositoryMockListPackagingTypesExpectation {
+	if mmListPackagingTypes.mock.funcListPackagingTypes != nil {
+		mmListPackagingTypes.mock.t.Fatalf("OrderRepositoryMock.ListPackagingTypes mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockListPackagingTypesExpectation{
+		mock:               mmListPackagingTypes.mock,
+		params:             &OrderRepositoryMockListPackagingTypesParams{ctx},
+		expectationOrigins: OrderRepositoryMockListPackagingTypesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPackagingTypes.expectations = append(mmListPackagingTypes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListPackagingTypes return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockListPackagingTypesExpectation) Then(ppa1 []*order.PackagingType, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockListPackagingTypesResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListPackagingTypes should be invoked
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Times(n uint64) *mOrderRepositoryMockListPackagingTypes {
+	if n == 0 {
+		mmListPackagingTypes.mock.t.Fatalf("Times of OrderRepositoryMock.ListPackagingTypes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPackagingTypes.expectedInvocations, n)
+	mmListPackagingTypes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPackagingTypes
+}
+
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) invocationsDone() bool {
+	if len(mmListPackagingTypes.expectations) == 0 && mmListPackagingTypes.defaultExpectation == nil && mmListPackagingTypes.mock.funcListPackagingTypes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPackagingTypes.mock.afterListPackagingTypesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPackagingTypes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPackagingTypes implements mm_order.Repository
+func (mmListPackagingTypes *OrderRepositoryMock) ListPackagingTypes(ctx context.Context) (ppa1 []*order.PackagingType, err error) {
+	mm_atomic.AddUint64(&mmListPackagingTypes.beforeListPackagingTypesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPackagingTypes.afterListPackagingTypesCounter, 1)
+
+	mmListPackagingTypes.t.Helper()
+
+	if mmListPackagingTypes.inspectFuncListPackagingTypes != nil {
+		mmListPackagingTypes.inspectFuncListPackagingTypes(ctx)
+	}
+
+	mm_params := OrderRepositoryMockListPackagingTypesParams{ctx}
+
+	// Record call args
+	mmListPackagingTypes.ListPackagingTypesMock.mutex.Lock()
+	mmListPackagingTypes.ListPackagingTypesMock.callArgs = append(mmListPackagingTypes.ListPackagingTypesMock.callArgs, &mm_params)
+	mmListPackagingTypes.ListPackagingTypesMock.mutex.Unlock()
+
+	for _, e := range mmListPackagingTypes.ListPackagingTypesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockListPackagingTypesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPackagingTypes.t.Errorf("OrderRepositoryMock.ListPackagingTypes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPackagingTypes.t.Errorf("OrderRepositoryMock.ListPackagingTypes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPackagingTypes.ListPackagingTypesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPackagingTypes.t.Fatal("No results are set for the OrderRepositoryMock.ListPackagingTypes")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPackagingTypes.funcListPackagingTypes != nil {
+		return mmListPackagingTypes.funcListPackagingTypes(ctx)
+	}
+	mmListPackagingTypes.t.Fatalf("Unexpected call to OrderRepositoryMock.ListPackagingTypes. %v", ctx)
+	return
+}
+
+// ListPackagingTypesAfterCounter returns a count of finished OrderRepositoryMock.ListPackagingTypes invocations
+func (mmListPackagingTypes *OrderRepositoryMock) ListPackagingTypesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPackagingTypes.afterListPackagingTypesCounter)
+}
+
+// ListPackagingTypesBeforeCounter returns a count of OrderRepositoryMock.ListPackagingTypes invocations
+func (mmListPackagingTypes *OrderRepositoryMock) ListPackagingTypesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPackagingTypes.beforeListPackagingTypesCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.ListPackagingTypes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPackagingTypes *mOrderRepositoryMockListPackagingTypes) Calls() []*OrderRepositoryMockListPackagingTypesParams {
+	mmListPackagingTypes.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockListPackagingTypesParams, len(mmListPackagingTypes.callArgs))
+	copy(argCopy, mmListPackagingTypes.callArgs)
+
+	mmListPackagingTypes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPackagingTypesDone returns true if the count of the ListPackagingTypes invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockListPackagingTypesDone() bool {
+	if m.ListPackagingTypesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPackagingTypesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPackagingTypesMock.invocationsDone()
+}
+
+// MinimockListPackagingTypesInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockListPackagingTypesInspect() {
+	for _, e := range m.ListPackagingTypesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.ListPackagingTypes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPackagingTypesCounter := mm_atomic.LoadUint64(&m.afterListPackagingTypesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPackagingTypesMock.defaultExpectation != nil && afterListPackagingTypesCounter < 1 {
+		if m.ListPackagingTypesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.ListPackagingTypes at\n%s", m.ListPackagingTypesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.ListPackagingTypes at\n%s with params: %#v", m.ListPackagingTypesMock.defaultExpectation.expectationOrigins.origin, *m.ListPackagingTypesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPackagingTypes != nil && afterListPackagingTypesCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.ListPackagingTypes at\n%s", m.funcListPackagingTypesOrigin)
+	}
+
+	if !m.ListPackagingTypesMock.invocationsDone() && afterListPackagingTypesCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.ListPackagingTypes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPackagingTypesMock.expectedInvocations), m.ListPackagingTypesMock.expectedInvocationsOrigin, afterListPackagingTypesCounter)
+	}
+}
+
+type mOrderRepositoryMockListPickupPoints struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockListPickupPointsExpectation
+	expectations       []*OrderRepositoryMockListPickupPointsExpectation
+
+	callArgs []*OrderRepositoryMockListPickupPointsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockListPickupPointsExpectation specifies expectation struct of the Repository.ListPickupPoints
+type OrderRepositoryMockListPickupPointsExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockListPickupPointsParams
+	paramPtrs          *OrderRepositoryMockListPickupPointsParamPtrs
+	expectationOrigins OrderRepositoryMockListPickupPointsExpectationOrigins
+	results            *OrderRepositoryMockListPickupPointsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockListPickupPointsParams contains parameters of the Repository.ListPickupPoints
+type OrderRepositoryMockListPickupPointsParams struct {
+	ctx context.Context
+}
+
+// OrderRepositoryMockListPickupPointsParamPtrs contains pointers to parameters of the Repository.ListPickupPoints
+type OrderRepositoryMockListPickupPointsParamPtrs struct {
+	ctx *context.Context
+}
+
+// OrderRepositoryMockListPickupPointsResults contains results of the Repository.ListPickupPoints
+type OrderRepositoryMockListPickupPointsResults struct {
+	ppa1 []*pickuppoint.PickupPoint
+	err  error
+}
+
+// OrderRepositoryMockListPickupPointsOrigins contains origins of expectations of the Repository.ListPickupPoints
+type OrderRepositoryMockListPickupPointsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Optional() *mOrderRepositoryMockListPickupPoints {
+	mmListPickupPoints.optional = true
+	return mmListPickupPoints
+}
+
+// Expect sets up expected params for Repository.ListPickupPoints
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Expect(ctx context.Context) *mOrderRepositoryMockListPickupPoints {
+	if mmListPickupPoints.mock.funcListPickupPoints != nil {
+		mmListPickupPoints.mock.t.Fatalf("OrderRepositoryMock.ListPickupPoints mock is already set by Set")
+	}
+
+	if mmListPickupPoints.defaultExpectation == nil {
+		mmListPickupPoints.defaultExpectation = &OrderRepositoryMockListPickupPointsExpectation{}
+	}
+
+	if mmListPickupPoints.defaultExpectation.paramPtrs != nil {
+		mmListPickupPoints.mock.t.Fatalf("OrderRepositoryMock.ListPickupPoints mock is already set by ExpectParams functions")
+	}
+
+	mmListPickupPoints.defaultExpectation.params = &OrderRepositoryMockListPickupPointsParams{ctx}
+	mmListPickupPoints.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPickupPoints.expectations {
+		if minimock.Equal(e.params, mmListPickupPoints.defaultExpectation.params) {
+			mmListPickupPoints.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPickupPoints.defaultExpectation.params)
+		}
+	}
+
+	return mmListPickupPoints
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPickupPoints
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockListPickupPoints {
+	if mmListPickupPoints.mock.funcListPickupPoints != nil {
+		mmListPickupPoints.mock.t.Fatalf("OrderRepositoryMock.ListPickupPoints mock is already set by Set")
+	}
+
+	if mmListPickupPoints.defaultExpectation == nil {
+		mmListPickupPoints.defaultExpectation = &OrderRepositoryMockListPickupPointsExpectation{}
+	}
+
+	if mmListPickupPoints.defaultExpectation.params != nil {
+		mmListPickupPoints.mock.t.Fatalf("OrderRepositoryMock.ListPickupPoints mock is already set by Expect")
+	}
+
+	if mmListPickupPoints.defaultExpectation.paramPtrs == nil {
+		mmListPickupPoints.defaultExpectation.paramPtrs = &OrderRepositoryMockListPickupPointsParamPtrs{}
+	}
+	mmListPickupPoints.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPickupPoints.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPickupPoints
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPickupPoints
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Inspect(f func(ctx context.Context)) *mOrderRepositoryMockListPickupPoints {
+	if mmListPickupPoints.mock.inspectFuncListPickupPoints != nil {
+		mmListPickupPoints.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.ListPickupPoints")
+	}
+
+	mmListPickupPoints.mock.inspectFuncListPickupPoints = f
+
+	return mmListPickupPoints
+}
+
+// Return sets up results that will be returned by Repository.ListPickupPoints
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Return(ppa1 []*pickuppoint.PickupPoint, err error) *OrderRepositoryMock {
+	if mmListPickupPoints.mock.funcListPickupPoints != nil {
+		mmListPickupPoints.mock.t.Fatalf("OrderRepositoryMock.ListPickupPoints mock is already set by Set")
+	}
+
+	if mmListPickupPoints.defaultExpectation == nil {
+		mmListPickupPoints.defaultExpectation = &OrderRepositoryMockListPickupPointsExpectation{mock: mmListPickupPoints.mock}
+	}
+	mmListPickupPoints.defaultExpectation.results = &OrderRepositoryMockListPickupPointsResults{ppa1, err}
+	mmListPickupPoints.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPickupPoints.mock
+}
+
+// Set uses given function f to mock the Repository.ListPickupPoints method
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Set(f func(ctx context.Context) (ppa1 []*pickuppoint.PickupPoint, err error)) *OrderRepositoryMock {
+	if mmListPickupPoints.defaultExpectation != nil {
+		mmListPickupPoints.mock.t.Fatalf("Default expectation is already set for the Repository.ListPickupPoints method")
+	}
+
+	if len(mmListPickupPoints.expectations) > 0 {
+		mmListPickupPoints.mock.t.Fatalf("Some expectations are already set for the Repository.ListPickupPoints method")
+	}
+
+	mmListPickupPoints.mock.funcListPickupPoints = f
+	mmListPickupPoints.mock.funcListPickupPointsOrigin = minimock.CallerInfo(1)
+	return mmListPickupPoints.mock
+}
+
+// When sets expectation for the Repository.ListPickupPoints which will trigger the result defined by the following
+// Then helper
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) When(ctx context.Context) *OrderRepositoryMockListPickupPointsExpectation {
+	if mmListPickupPoints.mock.funcListPickupPoints != nil {
+		mmListPickupPoints.mock.t.Fatalf("OrderRepositoryMock.ListPickupPoints mock is already set by Set")
 	}
 
-	expectation := &OrderRepositoryMockDeleteByExpectation{
-		mock:               mmDeleteBy.mock,
-		params:             &OrderRepositoryMockDeleteByParams{ctx, fp1},
-		expectationOrigins: OrderRepositoryMockDeleteByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderRepositoryMockListPickupPointsExpectation{
+		mock:               mmListPickupPoints.mock,
+		params:             &OrderRepositoryMockListPickupPointsParams{ctx},
+		expectationOrigins: OrderRepositoryMockListPickupPointsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteBy.expectations = append(mmDeleteBy.expectations, expectation)
+	mmListPickupPoints.expectations = append(mmListPickupPoints.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.DeleteBy return parameters for the expectation previously defined by the When method
-func (e *OrderRepositoryMockDeleteByExpectation) Then(err error) *OrderRepositoryMock {
-	e.results = &OrderRepositoryMockDeleteByResults{err}
+// Then sets up Repository.ListPickupPoints return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockListPickupPointsExpectation) Then(ppa1 []*pickuppoint.PickupPoint, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockListPickupPointsResults{ppa1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.DeleteBy should be invoked
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Times(n uint64) *mOrderRepositoryMockDeleteBy {
+// Times sets number of times Repository.ListPickupPoints should be invoked
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Times(n uint64) *mOrderRepositoryMockListPickupPoints {
 	if n == 0 {
-		mmDeleteBy.mock.t.Fatalf("Times of OrderRepositoryMock.DeleteBy mock can not be zero")
+		mmListPickupPoints.mock.t.Fatalf("Times of OrderRepositoryMock.ListPickupPoints mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteBy.expectedInvocations, n)
-	mmDeleteBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteBy
+	mm_atomic.StoreUint64(&mmListPickupPoints.expectedInvocations, n)
+	mmListPickupPoints.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPickupPoints
 }
 
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) invocationsDone() bool {
-	if len(mmDeleteBy.expectations) == 0 && mmDeleteBy.defaultExpectation == nil && mmDeleteBy.mock.funcDeleteBy == nil {
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) invocationsDone() bool {
+	if len(mmListPickupPoints.expectations) == 0 && mmListPickupPoints.defaultExpectation == nil && mmListPickupPoints.mock.funcListPickupPoints == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteBy.mock.afterDeleteByCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteBy.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListPickupPoints.mock.afterListPickupPointsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPickupPoints.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteBy implements mm_order.Repository
-func (mmDeleteBy *OrderRepositoryMock) DeleteBy(ctx context.Context, fp1 *order.Filter) (err error) {
-	mm_atomic.AddUint64(&mmDeleteBy.beforeDeleteByCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteBy.afterDeleteByCounter, 1)
+// ListPickupPoints implements mm_order.Repository
+func (mmListPickupPoints *OrderRepositoryMock) ListPickupPoints(ctx context.Context) (ppa1 []*pickuppoint.PickupPoint, err error) {
+	mm_atomic.AddUint64(&mmListPickupPoints.beforeListPickupPointsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPickupPoints.afterListPickupPointsCounter, 1)
 
-	mmDeleteBy.t.Helper()
+	mmListPickupPoints.t.Helper()
+
+	if mmListPickupPoints.inspectFuncListPickupPoints != nil {
+		mmListPickupPoints.inspectFuncListPickupPoints(ctx)
+	}
+
+	mm_params := OrderRepositoryMockListPickupPointsParams{ctx}
+
+	// Record call args
+	mmListPickupPoints.ListPickupPointsMock.mutex.Lock()
+	mmListPickupPoints.ListPickupPointsMock.callArgs = append(mmListPickupPoints.ListPickupPointsMock.callArgs, &mm_params)
+	mmListPickupPoints.ListPickupPointsMock.mutex.Unlock()
+
+	for _, e := range mmListPickupPoints.ListPickupPointsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPickupPoints.ListPickupPointsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPickupPoints.ListPickupPointsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPickupPoints.ListPickupPointsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPickupPoints.ListPickupPointsMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockListPickupPointsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPickupPoints.t.Errorf("OrderRepositoryMock.ListPickupPoints got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPickupPoints.ListPickupPointsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPickupPoints.t.Errorf("OrderRepositoryMock.ListPickupPoints got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPickupPoints.ListPickupPointsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPickupPoints.ListPickupPointsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPickupPoints.t.Fatal("No results are set for the OrderRepositoryMock.ListPickupPoints")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPickupPoints.funcListPickupPoints != nil {
+		return mmListPickupPoints.funcListPickupPoints(ctx)
+	}
+	mmListPickupPoints.t.Fatalf("Unexpected call to OrderRepositoryMock.ListPickupPoints. %v", ctx)
+	return
+}
+
+// ListPickupPointsAfterCounter returns a count of finished OrderRepositoryMock.ListPickupPoints invocations
+func (mmListPickupPoints *OrderRepositoryMock) ListPickupPointsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPickupPoints.afterListPickupPointsCounter)
+}
+
+// ListPickupPointsBeforeCounter returns a count of OrderRepositoryMock.ListPickupPoints invocations
+func (mmListPickupPoints *OrderRepositoryMock) ListPickupPointsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPickupPoints.beforeListPickupPointsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.ListPickupPoints.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPickupPoints *mOrderRepositoryMockListPickupPoints) Calls() []*OrderRepositoryMockListPickupPointsParams {
+	mmListPickupPoints.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockListPickupPointsParams, len(mmListPickupPoints.callArgs))
+	copy(argCopy, mmListPickupPoints.callArgs)
+
+	mmListPickupPoints.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPickupPointsDone returns true if the count of the ListPickupPoints invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockListPickupPointsDone() bool {
+	if m.ListPickupPointsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPickupPointsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPickupPointsMock.invocationsDone()
+}
+
+// MinimockListPickupPointsInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockListPickupPointsInspect() {
+	for _, e := range m.ListPickupPointsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.ListPickupPoints at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPickupPointsCounter := mm_atomic.LoadUint64(&m.afterListPickupPointsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPickupPointsMock.defaultExpectation != nil && afterListPickupPointsCounter < 1 {
+		if m.ListPickupPointsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.ListPickupPoints at\n%s", m.ListPickupPointsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.ListPickupPoints at\n%s with params: %#v", m.ListPickupPointsMock.defaultExpectation.expectationOrigins.origin, *m.ListPickupPointsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPickupPoints != nil && afterListPickupPointsCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.ListPickupPoints at\n%s", m.funcListPickupPointsOrigin)
+	}
+
+	if !m.ListPickupPointsMock.invocationsDone() && afterListPickupPointsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.ListPickupPoints at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPickupPointsMock.expectedInvocations), m.ListPickupPointsMock.expectedInvocationsOrigin, afterListPickupPointsCounter)
+	}
+}
+
+type mOrderRepositoryMockPickupPointExists struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockPickupPointExistsExpectation
+	expectations       []*OrderRepositoryMockPickupPointExistsExpectation
+
+	callArgs []*OrderRepositoryMockPickupPointExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockPickupPointExistsExpectation specifies expectation struct of the Repository.PickupPointExists
+type OrderRepositoryMockPickupPointExistsExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockPickupPointExistsParams
+	paramPtrs          *OrderRepositoryMockPickupPointExistsParamPtrs
+	expectationOrigins OrderRepositoryMockPickupPointExistsExpectationOrigins
+	results            *OrderRepositoryMockPickupPointExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockPickupPointExistsParams contains parameters of the Repository.PickupPointExists
+type OrderRepositoryMockPickupPointExistsParams struct {
+	ctx context.Context
+	i1  basetypes.ID
+}
+
+// OrderRepositoryMockPickupPointExistsParamPtrs contains pointers to parameters of the Repository.PickupPointExists
+type OrderRepositoryMockPickupPointExistsParamPtrs struct {
+	ctx *context.Context
+	i1  *basetypes.ID
+}
+
+// OrderRepositoryMockPickupPointExistsResults contains results of the Repository.PickupPointExists
+type OrderRepositoryMockPickupPointExistsResults struct {
+	b1  bool
+	err error
+}
+
+// OrderRepositoryMockPickupPointExistsOrigins contains origins of expectations of the Repository.PickupPointExists
+type OrderRepositoryMockPickupPointExistsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originI1  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Optional() *mOrderRepositoryMockPickupPointExists {
+	mmPickupPointExists.optional = true
+	return mmPickupPointExists
+}
+
+// Expect sets up expected params for Repository.PickupPointExists
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockPickupPointExists {
+	if mmPickupPointExists.mock.funcPickupPointExists != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Set")
+	}
+
+	if mmPickupPointExists.defaultExpectation == nil {
+		mmPickupPointExists.defaultExpectation = &OrderRepositoryMockPickupPointExistsExpectation{}
+	}
+
+	if mmPickupPointExists.defaultExpectation.paramPtrs != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by ExpectParams functions")
+	}
+
+	mmPickupPointExists.defaultExpectation.params = &OrderRepositoryMockPickupPointExistsParams{ctx, i1}
+	mmPickupPointExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPickupPointExists.expectations {
+		if minimock.Equal(e.params, mmPickupPointExists.defaultExpectation.params) {
+			mmPickupPointExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPickupPointExists.defaultExpectation.params)
+		}
+	}
+
+	return mmPickupPointExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.PickupPointExists
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockPickupPointExists {
+	if mmPickupPointExists.mock.funcPickupPointExists != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Set")
+	}
+
+	if mmPickupPointExists.defaultExpectation == nil {
+		mmPickupPointExists.defaultExpectation = &OrderRepositoryMockPickupPointExistsExpectation{}
+	}
+
+	if mmPickupPointExists.defaultExpectation.params != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Expect")
+	}
+
+	if mmPickupPointExists.defaultExpectation.paramPtrs == nil {
+		mmPickupPointExists.defaultExpectation.paramPtrs = &OrderRepositoryMockPickupPointExistsParamPtrs{}
+	}
+	mmPickupPointExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPickupPointExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPickupPointExists
+}
+
+// ExpectI1Param2 sets up expected param i1 for Repository.PickupPointExists
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockPickupPointExists {
+	if mmPickupPointExists.mock.funcPickupPointExists != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Set")
+	}
+
+	if mmPickupPointExists.defaultExpectation == nil {
+		mmPickupPointExists.defaultExpectation = &OrderRepositoryMockPickupPointExistsExpectation{}
+	}
+
+	if mmPickupPointExists.defaultExpectation.params != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Expect")
+	}
+
+	if mmPickupPointExists.defaultExpectation.paramPtrs == nil {
+		mmPickupPointExists.defaultExpectation.paramPtrs = &OrderRepositoryMockPickupPointExistsParamPtrs{}
+	}
+	mmPickupPointExists.defaultExpectation.paramPtrs.i1 = &i1
+	mmPickupPointExists.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+
+	return mmPickupPointExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.PickupPointExists
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockPickupPointExists {
+	if mmPickupPointExists.mock.inspectFuncPickupPointExists != nil {
+		mmPickupPointExists.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.PickupPointExists")
+	}
+
+	mmPickupPointExists.mock.inspectFuncPickupPointExists = f
+
+	return mmPickupPointExists
+}
+
+// Return sets up results that will be returned by Repository.PickupPointExists
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Return(b1 bool, err error) *OrderRepositoryMock {
+	if mmPickupPointExists.mock.funcPickupPointExists != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Set")
+	}
+
+	if mmPickupPointExists.defaultExpectation == nil {
+		mmPickupPointExists.defaultExpectation = &OrderRepositoryMockPickupPointExistsExpectation{mock: mmPickupPointExists.mock}
+	}
+	mmPickupPointExists.defaultExpectation.results = &OrderRepositoryMockPickupPointExistsResults{b1, err}
+	mmPickupPointExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPickupPointExists.mock
+}
+
+// Set uses given function f to mock the Repository.PickupPointExists method
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Set(f func(ctx context.Context, i1 basetypes.ID) (b1 bool, err error)) *OrderRepositoryMock {
+	if mmPickupPointExists.defaultExpectation != nil {
+		mmPickupPointExists.mock.t.Fatalf("Default expectation is already set for the Repository.PickupPointExists method")
+	}
+
+	if len(mmPickupPointExists.expectations) > 0 {
+		mmPickupPointExists.mock.t.Fatalf("Some expectations are already set for the Repository.PickupPointExists method")
+	}
+
+	mmPickupPointExists.mock.funcPickupPointExists = f
+	mmPickupPointExists.mock.funcPickupPointExistsOrigin = minimock.CallerInfo(1)
+	return mmPickupPointExists.mock
+}
+
+// When sets expectation for the Repository.PickupPointExists which will trigger the result defined by the following
+// Then helper
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockPickupPointExistsExpectation {
+	if mmPickupPointExists.mock.funcPickupPointExists != nil {
+		mmPickupPointExists.mock.t.Fatalf("OrderRepositoryMock.PickupPointExists mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockPickupPointExistsExpectation{
+		mock:               mmPickupPointExists.mock,
+		params:             &OrderRepositoryMockPickupPointExistsParams{ctx, i1},
+		expectationOrigins: OrderRepositoryMockPickupPointExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPickupPointExists.expectations = append(mmPickupPointExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.PickupPointExists return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockPickupPointExistsExpectation) Then(b1 bool, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockPickupPointExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.PickupPointExists should be invoked
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Times(n uint64) *mOrderRepositoryMockPickupPointExists {
+	if n == 0 {
+		mmPickupPointExists.mock.t.Fatalf("Times of OrderRepositoryMock.PickupPointExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPickupPointExists.expectedInvocations, n)
+	mmPickupPointExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPickupPointExists
+}
+
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) invocationsDone() bool {
+	if len(mmPickupPointExists.expectations) == 0 && mmPickupPointExists.defaultExpectation == nil && mmPickupPointExists.mock.funcPickupPointExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPickupPointExists.mock.afterPickupPointExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPickupPointExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PickupPointExists implements mm_order.Repository
+func (mmPickupPointExists *OrderRepositoryMock) PickupPointExists(ctx context.Context, i1 basetypes.ID) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmPickupPointExists.beforePickupPointExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPickupPointExists.afterPickupPointExistsCounter, 1)
+
+	mmPickupPointExists.t.Helper()
 
-	if mmDeleteBy.inspectFuncDeleteBy != nil {
-		mmDeleteBy.inspectFuncDeleteBy(ctx, fp1)
+	if mmPickupPointExists.inspectFuncPickupPointExists != nil {
+		mmPickupPointExists.inspectFuncPickupPointExists(ctx, i1)
 	}
 
-	mm_params := OrderRepositoryMockDeleteByParams{ctx, fp1}
+	mm_params := OrderRepositoryMockPickupPointExistsParams{ctx, i1}
 
 	// Record call args
-	mmDeleteBy.DeleteByMock.mutex.Lock()
-	mmDeleteBy.DeleteByMock.callArgs = append(mmDeleteBy.DeleteByMock.callArgs, &mm_params)
-	mmDeleteBy.DeleteByMock.mutex.Unlock()
+	mmPickupPointExists.PickupPointExistsMock.mutex.Lock()
+	mmPickupPointExists.PickupPointExistsMock.callArgs = append(mmPickupPointExists.PickupPointExistsMock.callArgs, &mm_params)
+	mmPickupPointExists.PickupPointExistsMock.mutex.Unlock()
 
-	for _, e := range mmDeleteBy.DeleteByMock.expectations {
+	for _, e := range mmPickupPointExists.PickupPointExistsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmDeleteBy.DeleteByMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteBy.DeleteByMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteBy.DeleteByMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteBy.DeleteByMock.defaultExpectation.paramPtrs
+	if mmPickupPointExists.PickupPointExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPickupPointExists.PickupPointExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPickupPointExists.PickupPointExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmPickupPointExists.PickupPointExistsMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockDeleteByParams{ctx, fp1}
+		mm_got := OrderRepositoryMockPickupPointExistsParams{ctx, i1}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteBy.t.Errorf("OrderRepositoryMock.DeleteBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteBy.DeleteByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmPickupPointExists.t.Errorf("OrderRepositoryMock.PickupPointExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPickupPointExists.PickupPointExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.fp1 != nil && !minimock.Equal(*mm_want_ptrs.fp1, mm_got.fp1) {
-				mmDeleteBy.t.Errorf("OrderRepositoryMock.DeleteBy got unexpected parameter fp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteBy.DeleteByMock.defaultExpectation.expectationOrigins.originFp1, *mm_want_ptrs.fp1, mm_got.fp1, minimock.Diff(*mm_want_ptrs.fp1, mm_got.fp1))
+			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
+				mmPickupPointExists.t.Errorf("OrderRepositoryMock.PickupPointExists got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPickupPointExists.PickupPointExistsMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteBy.t.Errorf("OrderRepositoryMock.DeleteBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteBy.DeleteByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmPickupPointExists.t.Errorf("OrderRepositoryMock.PickupPointExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPickupPointExists.PickupPointExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteBy.DeleteByMock.defaultExpectation.results
+		mm_results := mmPickupPointExists.PickupPointExistsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteBy.t.Fatal("No results are set for the OrderRepositoryMock.DeleteBy")
+			mmPickupPointExists.t.Fatal("No results are set for the OrderRepositoryMock.PickupPointExists")
 		}
-		return (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmDeleteBy.funcDeleteBy != nil {
-		return mmDeleteBy.funcDeleteBy(ctx, fp1)
+	if mmPickupPointExists.funcPickupPointExists != nil {
+		return mmPickupPointExists.funcPickupPointExists(ctx, i1)
 	}
-	mmDeleteBy.t.Fatalf("Unexpected call to OrderRepositoryMock.DeleteBy. %v %v", ctx, fp1)
+	mmPickupPointExists.t.Fatalf("Unexpected call to OrderRepositoryMock.PickupPointExists. %v %v", ctx, i1)
 	return
 }
 
-// DeleteByAfterCounter returns a count of finished OrderRepositoryMock.DeleteBy invocations
-func (mmDeleteBy *OrderRepositoryMock) DeleteByAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteBy.afterDeleteByCounter)
+// PickupPointExistsAfterCounter returns a count of finished OrderRepositoryMock.PickupPointExists invocations
+func (mmPickupPointExists *OrderRepositoryMock) PickupPointExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPickupPointExists.afterPickupPointExistsCounter)
 }
 
-// DeleteByBeforeCounter returns a count of OrderRepositoryMock.DeleteBy invocations
-func (mmDeleteBy *OrderRepositoryMock) DeleteByBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteBy.beforeDeleteByCounter)
+// PickupPointExistsBeforeCounter returns a count of OrderRepositoryMock.PickupPointExists invocations
+func (mmPickupPointExists *OrderRepositoryMock) PickupPointExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPickupPointExists.beforePickupPointExistsCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderRepositoryMock.DeleteBy.
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.PickupPointExists.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteBy *mOrderRepositoryMockDeleteBy) Calls() []*OrderRepositoryMockDeleteByParams {
-	mmDeleteBy.mutex.RLock()
+func (mmPickupPointExists *mOrderRepositoryMockPickupPointExists) Calls() []*OrderRepositoryMockPickupPointExistsParams {
+	mmPickupPointExists.mutex.RLock()
 
-	argCopy := make([]*OrderRepositoryMockDeleteByParams, len(mmDeleteBy.callArgs))
-	copy(argCopy, mmDeleteBy.callArgs)
+	argCopy := make([]*OrderRepositoryMockPickupPointExistsParams, len(mmPickupPointExists.callArgs))
+	copy(argCopy, mmPickupPointExists.callArgs)
 
-	mmDeleteBy.mutex.RUnlock()
+	mmPickupPointExists.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteByDone returns true if the count of the DeleteBy invocations corresponds
+// MinimockPickupPointExistsDone returns true if the count of the PickupPointExists invocations corresponds
 // the number of defined expectations
-func (m *OrderRepositoryMock) MinimockDeleteByDone() bool {
-	if m.DeleteByMock.optional {
+func (m *OrderRepositoryMock) MinimockPickupPointExistsDone() bool {
+	if m.PickupPointExistsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteByMock.expectations {
+	for _, e := range m.PickupPointExistsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteByMock.invocationsDone()
+	return m.PickupPointExistsMock.invocationsDone()
 }
 
-// MinimockDeleteByInspect logs each unmet expectation
-func (m *OrderRepositoryMock) MinimockDeleteByInspect() {
-	for _, e := range m.DeleteByMock.expectations {
+// MinimockPickupPointExistsInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockPickupPointExistsInspect() {
+	for _, e := range m.PickupPointExistsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.PickupPointExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteByCounter := mm_atomic.LoadUint64(&m.afterDeleteByCounter)
+	afterPickupPointExistsCounter := mm_atomic.LoadUint64(&m.afterPickupPointExistsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteByMock.defaultExpectation != nil && afterDeleteByCounter < 1 {
-		if m.DeleteByMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s", m.DeleteByMock.defaultExpectation.returnOrigin)
+	if m.PickupPointExistsMock.defaultExpectation != nil && afterPickupPointExistsCounter < 1 {
+		if m.PickupPointExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.PickupPointExists at\n%s", m.PickupPointExistsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s with params: %#v", m.DeleteByMock.defaultExpectation.expectationOrigins.origin, *m.DeleteByMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.PickupPointExists at\n%s with params: %#v", m.PickupPointExistsMock.defaultExpectation.expectationOrigins.origin, *m.PickupPointExistsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteBy != nil && afterDeleteByCounter < 1 {
-		m.t.Errorf("Expected call to OrderRepositoryMock.DeleteBy at\n%s", m.funcDeleteByOrigin)
+	if m.funcPickupPointExists != nil && afterPickupPointExistsCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.PickupPointExists at\n%s", m.funcPickupPointExistsOrigin)
 	}
 
-	if !m.DeleteByMock.invocationsDone() && afterDeleteByCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderRepositoryMock.DeleteBy at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteByMock.expectedInvocations), m.DeleteByMock.expectedInvocationsOrigin, afterDeleteByCounter)
+	if !m.PickupPointExistsMock.invocationsDone() && afterPickupPointExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.PickupPointExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PickupPointExistsMock.expectedInvocations), m.PickupPointExistsMock.expectedInvocationsOrigin, afterPickupPointExistsCounter)
 	}
 }
 
-type mOrderRepositoryMockGet struct {
+type mOrderRepositoryMockUpdate struct {
 	optional           bool
 	mock               *OrderRepositoryMock
-	defaultExpectation *OrderRepositoryMockGetExpectation
-	expectations       []*OrderRepositoryMockGetExpectation
+	defaultExpectation *OrderRepositoryMockUpdateExpectation
+	expectations       []*OrderRepositoryMockUpdateExpectation
 
-	callArgs []*OrderRepositoryMockGetParams
+	callArgs []*OrderRepositoryMockUpdateParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrderRepositoryMockGetExpectation specifies expectation struct of the Repository.Get
-type OrderRepositoryMockGetExpectation struct {
+// OrderRepositoryMockUpdateExpectation specifies expectation struct of the Repository.Update
+type OrderRepositoryMockUpdateExpectation struct {
 	mock               *OrderRepositoryMock
-	params             *OrderRepositoryMockGetParams
-	paramPtrs          *OrderRepositoryMockGetParamPtrs
-	expectationOrigins OrderRepositoryMockGetExpectationOrigins
-	results            *OrderRepositoryMockGetResults
+	params             *OrderRepositoryMockUpdateParams
+	paramPtrs          *OrderRepositoryMockUpdateParamPtrs
+	expectationOrigins OrderRepositoryMockUpdateExpectationOrigins
+	results            *OrderRepositoryMockUpdateResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepositoryMockGetParams contains parameters of the Repository.Get
-type OrderRepositoryMockGetParams struct {
+// OrderRepositoryMockUpdateParams contains parameters of the Repository.Update
+type OrderRepositoryMockUpdateParams struct {
 	ctx context.Context
-	i1  basetypes.ID
+	op1 *order.Order
+	ea1 []order.Event
 }
 
-// OrderRepositoryMockGetParamPtrs contains pointers to parameters of the Repository.Get
-type OrderRepositoryMockGetParamPtrs struct {
+// OrderRepositoryMockUpdateParamPtrs contains pointers to parameters of the Repository.Update
+type OrderRepositoryMockUpdateParamPtrs struct {
 	ctx *context.Context
-	i1  *basetypes.ID
+	op1 **order.Order
+	ea1 *[]order.Event
 }
 
-// OrderRepositoryMockGetResults contains results of the Repository.Get
-type OrderRepositoryMockGetResults struct {
-	op1 *order.Order
+// OrderRepositoryMockUpdateResults contains results of the Repository.Update
+type OrderRepositoryMockUpdateResults struct {
 	err error
 }
 
-// OrderRepositoryMockGetOrigins contains origins of expectations of the Repository.Get
-type OrderRepositoryMockGetExpectationOrigins struct {
+// OrderRepositoryMockUpdateOrigins contains origins of expectations of the Repository.Update
+type OrderRepositoryMockUpdateExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originI1  string
+	originOp1 string
+	originEa1 string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1527,342 +7810,372 @@ type OrderRepositoryMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mOrderRepositoryMockGet) Optional() *mOrderRepositoryMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmUpdate *mOrderRepositoryMockUpdate) Optional() *mOrderRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
 }
 
-// Expect sets up expected params for Repository.Get
-func (mmGet *mOrderRepositoryMockGet) Expect(ctx context.Context, i1 basetypes.ID) *mOrderRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+// Expect sets up expected params for Repository.Update
+func (mmUpdate *mOrderRepositoryMockUpdate) Expect(ctx context.Context, op1 *order.Order, ea1 ...order.Event) *mOrderRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{}
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OrderRepositoryMockUpdateExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by ExpectParams functions")
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &OrderRepositoryMockGetParams{ctx, i1}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmUpdate.defaultExpectation.params = &OrderRepositoryMockUpdateParams{ctx, op1, ea1}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmUpdate
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.Get
-func (mmGet *mOrderRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.Update
+func (mmUpdate *mOrderRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{}
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OrderRepositoryMockUpdateExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Expect")
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &OrderRepositoryMockGetParamPtrs{}
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdateParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmUpdate
 }
 
-// ExpectI1Param2 sets up expected param i1 for Repository.Get
-func (mmGet *mOrderRepositoryMockGet) ExpectI1Param2(i1 basetypes.ID) *mOrderRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+// ExpectOp1Param2 sets up expected param op1 for Repository.Update
+func (mmUpdate *mOrderRepositoryMockUpdate) ExpectOp1Param2(op1 *order.Order) *mOrderRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{}
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OrderRepositoryMockUpdateExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Expect")
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &OrderRepositoryMockGetParamPtrs{}
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdateParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.i1 = &i1
-	mmGet.defaultExpectation.expectationOrigins.originI1 = minimock.CallerInfo(1)
+	mmUpdate.defaultExpectation.paramPtrs.op1 = &op1
+	mmUpdate.defaultExpectation.expectationOrigins.originOp1 = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmUpdate
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.Get
-func (mmGet *mOrderRepositoryMockGet) Inspect(f func(ctx context.Context, i1 basetypes.ID)) *mOrderRepositoryMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Get")
+// ExpectEa1Param3 sets up expected param ea1 for Repository.Update
+func (mmUpdate *mOrderRepositoryMockUpdate) ExpectEa1Param3(ea1 []order.Event) *mOrderRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Set")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OrderRepositoryMockUpdateExpectation{}
+	}
 
-	return mmGet
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ea1 = &ea1
+	mmUpdate.defaultExpectation.expectationOrigins.originEa1 = minimock.CallerInfo(1)
+
+	return mmUpdate
 }
 
-// Return sets up results that will be returned by Repository.Get
-func (mmGet *mOrderRepositoryMockGet) Return(op1 *order.Order, err error) *OrderRepositoryMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Repository.Update
+func (mmUpdate *mOrderRepositoryMockUpdate) Inspect(f func(ctx context.Context, op1 *order.Order, ea1 ...order.Event)) *mOrderRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Update")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &OrderRepositoryMockGetExpectation{mock: mmGet.mock}
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by Repository.Update
+func (mmUpdate *mOrderRepositoryMockUpdate) Return(err error) *OrderRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Set")
 	}
-	mmGet.defaultExpectation.results = &OrderRepositoryMockGetResults{op1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OrderRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &OrderRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
 }
 
-// Set uses given function f to mock the Repository.Get method
-func (mmGet *mOrderRepositoryMockGet) Set(f func(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error)) *OrderRepositoryMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the Repository.Get method")
+// Set uses given function f to mock the Repository.Update method
+func (mmUpdate *mOrderRepositoryMockUpdate) Set(f func(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (err error)) *OrderRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the Repository.Update method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the Repository.Get method")
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the Repository.Update method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
 }
 
-// When sets expectation for the Repository.Get which will trigger the result defined by the following
+// When sets expectation for the Repository.Update which will trigger the result defined by the following
 // Then helper
-func (mmGet *mOrderRepositoryMockGet) When(ctx context.Context, i1 basetypes.ID) *OrderRepositoryMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("OrderRepositoryMock.Get mock is already set by Set")
+func (mmUpdate *mOrderRepositoryMockUpdate) When(ctx context.Context, op1 *order.Order, ea1 ...order.Event) *OrderRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OrderRepositoryMock.Update mock is already set by Set")
 	}
 
-	expectation := &OrderRepositoryMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &OrderRepositoryMockGetParams{ctx, i1},
-		expectationOrigins: OrderRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &OrderRepositoryMockUpdateParams{ctx, op1, ea1},
+		expectationOrigins: OrderRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.Get return parameters for the expectation previously defined by the When method
-func (e *OrderRepositoryMockGetExpectation) Then(op1 *order.Order, err error) *OrderRepositoryMock {
-	e.results = &OrderRepositoryMockGetResults{op1, err}
+// Then sets up Repository.Update return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockUpdateExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockUpdateResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.Get should be invoked
-func (mmGet *mOrderRepositoryMockGet) Times(n uint64) *mOrderRepositoryMockGet {
+// Times sets number of times Repository.Update should be invoked
+func (mmUpdate *mOrderRepositoryMockUpdate) Times(n uint64) *mOrderRepositoryMockUpdate {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of OrderRepositoryMock.Get mock can not be zero")
+		mmUpdate.mock.t.Fatalf("Times of OrderRepositoryMock.Update mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
 }
 
-func (mmGet *mOrderRepositoryMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmUpdate *mOrderRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_order.Repository
-func (mmGet *OrderRepositoryMock) Get(ctx context.Context, i1 basetypes.ID) (op1 *order.Order, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// Update implements mm_order.Repository
+func (mmUpdate *OrderRepositoryMock) Update(ctx context.Context, op1 *order.Order, ea1 ...order.Event) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
 
-	mmGet.t.Helper()
+	mmUpdate.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, i1)
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, op1, ea1...)
 	}
 
-	mm_params := OrderRepositoryMockGetParams{ctx, i1}
+	mm_params := OrderRepositoryMockUpdateParams{ctx, op1, ea1}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmUpdate.UpdateMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.op1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockGetParams{ctx, i1}
+		mm_got := OrderRepositoryMockUpdateParams{ctx, op1, ea1}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("OrderRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdate.t.Errorf("OrderRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.i1 != nil && !minimock.Equal(*mm_want_ptrs.i1, mm_got.i1) {
-				mmGet.t.Errorf("OrderRepositoryMock.Get got unexpected parameter i1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originI1, *mm_want_ptrs.i1, mm_got.i1, minimock.Diff(*mm_want_ptrs.i1, mm_got.i1))
+			if mm_want_ptrs.op1 != nil && !minimock.Equal(*mm_want_ptrs.op1, mm_got.op1) {
+				mmUpdate.t.Errorf("OrderRepositoryMock.Update got unexpected parameter op1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originOp1, *mm_want_ptrs.op1, mm_got.op1, minimock.Diff(*mm_want_ptrs.op1, mm_got.op1))
+			}
+
+			if mm_want_ptrs.ea1 != nil && !minimock.Equal(*mm_want_ptrs.ea1, mm_got.ea1) {
+				mmUpdate.t.Errorf("OrderRepositoryMock.Update got unexpected parameter ea1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originEa1, *mm_want_ptrs.ea1, mm_got.ea1, minimock.Diff(*mm_want_ptrs.ea1, mm_got.ea1))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("OrderRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdate.t.Errorf("OrderRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the OrderRepositoryMock.Get")
+			mmUpdate.t.Fatal("No results are set for the OrderRepositoryMock.Update")
 		}
-		return (*mm_results).op1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, i1)
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, op1, ea1...)
 	}
-	mmGet.t.Fatalf("Unexpected call to OrderRepositoryMock.Get. %v %v", ctx, i1)
+	mmUpdate.t.Fatalf("Unexpected call to OrderRepositoryMock.Update. %v %v %v", ctx, op1, ea1)
 	return
 }
 
-// GetAfterCounter returns a count of finished OrderRepositoryMock.Get invocations
-func (mmGet *OrderRepositoryMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// UpdateAfterCounter returns a count of finished OrderRepositoryMock.Update invocations
+func (mmUpdate *OrderRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
 }
 
-// GetBeforeCounter returns a count of OrderRepositoryMock.Get invocations
-func (mmGet *OrderRepositoryMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// UpdateBeforeCounter returns a count of OrderRepositoryMock.Update invocations
+func (mmUpdate *OrderRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderRepositoryMock.Get.
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.Update.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mOrderRepositoryMockGet) Calls() []*OrderRepositoryMockGetParams {
-	mmGet.mutex.RLock()
+func (mmUpdate *mOrderRepositoryMockUpdate) Calls() []*OrderRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
 
-	argCopy := make([]*OrderRepositoryMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*OrderRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmUpdate.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
 // the number of defined expectations
-func (m *OrderRepositoryMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *OrderRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.UpdateMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.UpdateMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *OrderRepositoryMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockUpdateInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to OrderRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderRepositoryMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
 	}
 }
 
-type mOrderRepositoryMockGetBy struct {
+type mOrderRepositoryMockUpdateList struct {
 	optional           bool
 	mock               *OrderRepositoryMock
-	defaultExpectation *OrderRepositoryMockGetByExpectation
-	expectations       []*OrderRepositoryMockGetByExpectation
+	defaultExpectation *OrderRepositoryMockUpdateListExpectation
+	expectations       []*OrderRepositoryMockUpdateListExpectation
 
-	callArgs []*OrderRepositoryMockGetByParams
+	callArgs []*OrderRepositoryMockUpdateListParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
-
-// OrderRepositoryMockGetByExpectation specifies expectation struct of the Repository.GetBy
-type OrderRepositoryMockGetByExpectation struct {
+
+// OrderRepositoryMockUpdateListExpectation specifies expectation struct of the Repository.UpdateList
+type OrderRepositoryMockUpdateListExpectation struct {
 	mock               *OrderRepositoryMock
-	params             *OrderRepositoryMockGetByParams
-	paramPtrs          *OrderRepositoryMockGetByParamPtrs
-	expectationOrigins OrderRepositoryMockGetByExpectationOrigins
-	results            *OrderRepositoryMockGetByResults
+	params             *OrderRepositoryMockUpdateListParams
+	paramPtrs          *OrderRepositoryMockUpdateListParamPtrs
+	expectationOrigins OrderRepositoryMockUpdateListExpectationOrigins
+	results            *OrderRepositoryMockUpdateListResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepositoryMockGetByParams contains parameters of the Repository.GetBy
-type OrderRepositoryMockGetByParams struct {
-	ctx context.Context
-	fp1 *order.Filter
+// OrderRepositoryMockUpdateListParams contains parameters of the Repository.UpdateList
+type OrderRepositoryMockUpdateListParams struct {
+	ctx    context.Context
+	ids    []basetypes.ID
+	update func([]*order.Order) ([]*order.Order, []order.Event, error)
 }
 
-// OrderRepositoryMockGetByParamPtrs contains pointers to parameters of the Repository.GetBy
-type OrderRepositoryMockGetByParamPtrs struct {
-	ctx *context.Context
-	fp1 **order.Filter
+// OrderRepositoryMockUpdateListParamPtrs contains pointers to parameters of the Repository.UpdateList
+type OrderRepositoryMockUpdateListParamPtrs struct {
+	ctx    *context.Context
+	ids    *[]basetypes.ID
+	update *func([]*order.Order) ([]*order.Order, []order.Event, error)
 }
 
-// OrderRepositoryMockGetByResults contains results of the Repository.GetBy
-type OrderRepositoryMockGetByResults struct {
-	opa1 []*order.Order
-	err  error
+// OrderRepositoryMockUpdateListResults contains results of the Repository.UpdateList
+type OrderRepositoryMockUpdateListResults struct {
+	err error
 }
 
-// OrderRepositoryMockGetByOrigins contains origins of expectations of the Repository.GetBy
-type OrderRepositoryMockGetByExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originFp1 string
+// OrderRepositoryMockUpdateListOrigins contains origins of expectations of the Repository.UpdateList
+type OrderRepositoryMockUpdateListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originIds    string
+	originUpdate string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1870,348 +8183,369 @@ type OrderRepositoryMockGetByExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetBy *mOrderRepositoryMockGetBy) Optional() *mOrderRepositoryMockGetBy {
-	mmGetBy.optional = true
-	return mmGetBy
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Optional() *mOrderRepositoryMockUpdateList {
+	mmUpdateList.optional = true
+	return mmUpdateList
 }
 
-// Expect sets up expected params for Repository.GetBy
-func (mmGetBy *mOrderRepositoryMockGetBy) Expect(ctx context.Context, fp1 *order.Filter) *mOrderRepositoryMockGetBy {
-	if mmGetBy.mock.funcGetBy != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+// Expect sets up expected params for Repository.UpdateList
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Expect(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) *mOrderRepositoryMockUpdateList {
+	if mmUpdateList.mock.funcUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Set")
 	}
 
-	if mmGetBy.defaultExpectation == nil {
-		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{}
+	if mmUpdateList.defaultExpectation == nil {
+		mmUpdateList.defaultExpectation = &OrderRepositoryMockUpdateListExpectation{}
 	}
 
-	if mmGetBy.defaultExpectation.paramPtrs != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by ExpectParams functions")
+	if mmUpdateList.defaultExpectation.paramPtrs != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by ExpectParams functions")
 	}
 
-	mmGetBy.defaultExpectation.params = &OrderRepositoryMockGetByParams{ctx, fp1}
-	mmGetBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetBy.expectations {
-		if minimock.Equal(e.params, mmGetBy.defaultExpectation.params) {
-			mmGetBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBy.defaultExpectation.params)
+	mmUpdateList.defaultExpectation.params = &OrderRepositoryMockUpdateListParams{ctx, ids, update}
+	mmUpdateList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateList.expectations {
+		if minimock.Equal(e.params, mmUpdateList.defaultExpectation.params) {
+			mmUpdateList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateList.defaultExpectation.params)
 		}
 	}
 
-	return mmGetBy
+	return mmUpdateList
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.GetBy
-func (mmGetBy *mOrderRepositoryMockGetBy) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetBy {
-	if mmGetBy.mock.funcGetBy != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateList
+func (mmUpdateList *mOrderRepositoryMockUpdateList) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockUpdateList {
+	if mmUpdateList.mock.funcUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Set")
 	}
 
-	if mmGetBy.defaultExpectation == nil {
-		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{}
+	if mmUpdateList.defaultExpectation == nil {
+		mmUpdateList.defaultExpectation = &OrderRepositoryMockUpdateListExpectation{}
 	}
 
-	if mmGetBy.defaultExpectation.params != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Expect")
+	if mmUpdateList.defaultExpectation.params != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Expect")
 	}
 
-	if mmGetBy.defaultExpectation.paramPtrs == nil {
-		mmGetBy.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByParamPtrs{}
+	if mmUpdateList.defaultExpectation.paramPtrs == nil {
+		mmUpdateList.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdateListParamPtrs{}
 	}
-	mmGetBy.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetBy
+	return mmUpdateList
 }
 
-// ExpectFp1Param2 sets up expected param fp1 for Repository.GetBy
-func (mmGetBy *mOrderRepositoryMockGetBy) ExpectFp1Param2(fp1 *order.Filter) *mOrderRepositoryMockGetBy {
-	if mmGetBy.mock.funcGetBy != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+// ExpectIdsParam2 sets up expected param ids for Repository.UpdateList
+func (mmUpdateList *mOrderRepositoryMockUpdateList) ExpectIdsParam2(ids []basetypes.ID) *mOrderRepositoryMockUpdateList {
+	if mmUpdateList.mock.funcUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Set")
 	}
 
-	if mmGetBy.defaultExpectation == nil {
-		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{}
+	if mmUpdateList.defaultExpectation == nil {
+		mmUpdateList.defaultExpectation = &OrderRepositoryMockUpdateListExpectation{}
 	}
 
-	if mmGetBy.defaultExpectation.params != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Expect")
+	if mmUpdateList.defaultExpectation.params != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Expect")
 	}
 
-	if mmGetBy.defaultExpectation.paramPtrs == nil {
-		mmGetBy.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByParamPtrs{}
+	if mmUpdateList.defaultExpectation.paramPtrs == nil {
+		mmUpdateList.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdateListParamPtrs{}
 	}
-	mmGetBy.defaultExpectation.paramPtrs.fp1 = &fp1
-	mmGetBy.defaultExpectation.expectationOrigins.originFp1 = minimock.CallerInfo(1)
+	mmUpdateList.defaultExpectation.paramPtrs.ids = &ids
+	mmUpdateList.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
 
-	return mmGetBy
+	return mmUpdateList
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.GetBy
-func (mmGetBy *mOrderRepositoryMockGetBy) Inspect(f func(ctx context.Context, fp1 *order.Filter)) *mOrderRepositoryMockGetBy {
-	if mmGetBy.mock.inspectFuncGetBy != nil {
-		mmGetBy.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetBy")
+// ExpectUpdateParam3 sets up expected param update for Repository.UpdateList
+func (mmUpdateList *mOrderRepositoryMockUpdateList) ExpectUpdateParam3(update func([]*order.Order) ([]*order.Order, []order.Event, error)) *mOrderRepositoryMockUpdateList {
+	if mmUpdateList.mock.funcUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Set")
 	}
 
-	mmGetBy.mock.inspectFuncGetBy = f
+	if mmUpdateList.defaultExpectation == nil {
+		mmUpdateList.defaultExpectation = &OrderRepositoryMockUpdateListExpectation{}
+	}
 
-	return mmGetBy
+	if mmUpdateList.defaultExpectation.params != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Expect")
+	}
+
+	if mmUpdateList.defaultExpectation.paramPtrs == nil {
+		mmUpdateList.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdateListParamPtrs{}
+	}
+	mmUpdateList.defaultExpectation.paramPtrs.update = &update
+	mmUpdateList.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateList
 }
 
-// Return sets up results that will be returned by Repository.GetBy
-func (mmGetBy *mOrderRepositoryMockGetBy) Return(opa1 []*order.Order, err error) *OrderRepositoryMock {
-	if mmGetBy.mock.funcGetBy != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateList
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Inspect(f func(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error))) *mOrderRepositoryMockUpdateList {
+	if mmUpdateList.mock.inspectFuncUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.UpdateList")
 	}
 
-	if mmGetBy.defaultExpectation == nil {
-		mmGetBy.defaultExpectation = &OrderRepositoryMockGetByExpectation{mock: mmGetBy.mock}
+	mmUpdateList.mock.inspectFuncUpdateList = f
+
+	return mmUpdateList
+}
+
+// Return sets up results that will be returned by Repository.UpdateList
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Return(err error) *OrderRepositoryMock {
+	if mmUpdateList.mock.funcUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Set")
 	}
-	mmGetBy.defaultExpectation.results = &OrderRepositoryMockGetByResults{opa1, err}
-	mmGetBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetBy.mock
+
+	if mmUpdateList.defaultExpectation == nil {
+		mmUpdateList.defaultExpectation = &OrderRepositoryMockUpdateListExpectation{mock: mmUpdateList.mock}
+	}
+	mmUpdateList.defaultExpectation.results = &OrderRepositoryMockUpdateListResults{err}
+	mmUpdateList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateList.mock
 }
 
-// Set uses given function f to mock the Repository.GetBy method
-func (mmGetBy *mOrderRepositoryMockGetBy) Set(f func(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error)) *OrderRepositoryMock {
-	if mmGetBy.defaultExpectation != nil {
-		mmGetBy.mock.t.Fatalf("Default expectation is already set for the Repository.GetBy method")
+// Set uses given function f to mock the Repository.UpdateList method
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Set(f func(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error)) *OrderRepositoryMock {
+	if mmUpdateList.defaultExpectation != nil {
+		mmUpdateList.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateList method")
 	}
 
-	if len(mmGetBy.expectations) > 0 {
-		mmGetBy.mock.t.Fatalf("Some expectations are already set for the Repository.GetBy method")
+	if len(mmUpdateList.expectations) > 0 {
+		mmUpdateList.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateList method")
 	}
 
-	mmGetBy.mock.funcGetBy = f
-	mmGetBy.mock.funcGetByOrigin = minimock.CallerInfo(1)
-	return mmGetBy.mock
+	mmUpdateList.mock.funcUpdateList = f
+	mmUpdateList.mock.funcUpdateListOrigin = minimock.CallerInfo(1)
+	return mmUpdateList.mock
 }
 
-// When sets expectation for the Repository.GetBy which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdateList which will trigger the result defined by the following
 // Then helper
-func (mmGetBy *mOrderRepositoryMockGetBy) When(ctx context.Context, fp1 *order.Filter) *OrderRepositoryMockGetByExpectation {
-	if mmGetBy.mock.funcGetBy != nil {
-		mmGetBy.mock.t.Fatalf("OrderRepositoryMock.GetBy mock is already set by Set")
+func (mmUpdateList *mOrderRepositoryMockUpdateList) When(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) *OrderRepositoryMockUpdateListExpectation {
+	if mmUpdateList.mock.funcUpdateList != nil {
+		mmUpdateList.mock.t.Fatalf("OrderRepositoryMock.UpdateList mock is already set by Set")
 	}
 
-	expectation := &OrderRepositoryMockGetByExpectation{
-		mock:               mmGetBy.mock,
-		params:             &OrderRepositoryMockGetByParams{ctx, fp1},
-		expectationOrigins: OrderRepositoryMockGetByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderRepositoryMockUpdateListExpectation{
+		mock:               mmUpdateList.mock,
+		params:             &OrderRepositoryMockUpdateListParams{ctx, ids, update},
+		expectationOrigins: OrderRepositoryMockUpdateListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetBy.expectations = append(mmGetBy.expectations, expectation)
+	mmUpdateList.expectations = append(mmUpdateList.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.GetBy return parameters for the expectation previously defined by the When method
-func (e *OrderRepositoryMockGetByExpectation) Then(opa1 []*order.Order, err error) *OrderRepositoryMock {
-	e.results = &OrderRepositoryMockGetByResults{opa1, err}
+// Then sets up Repository.UpdateList return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockUpdateListExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockUpdateListResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.GetBy should be invoked
-func (mmGetBy *mOrderRepositoryMockGetBy) Times(n uint64) *mOrderRepositoryMockGetBy {
+// Times sets number of times Repository.UpdateList should be invoked
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Times(n uint64) *mOrderRepositoryMockUpdateList {
 	if n == 0 {
-		mmGetBy.mock.t.Fatalf("Times of OrderRepositoryMock.GetBy mock can not be zero")
+		mmUpdateList.mock.t.Fatalf("Times of OrderRepositoryMock.UpdateList mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetBy.expectedInvocations, n)
-	mmGetBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetBy
+	mm_atomic.StoreUint64(&mmUpdateList.expectedInvocations, n)
+	mmUpdateList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateList
 }
 
-func (mmGetBy *mOrderRepositoryMockGetBy) invocationsDone() bool {
-	if len(mmGetBy.expectations) == 0 && mmGetBy.defaultExpectation == nil && mmGetBy.mock.funcGetBy == nil {
+func (mmUpdateList *mOrderRepositoryMockUpdateList) invocationsDone() bool {
+	if len(mmUpdateList.expectations) == 0 && mmUpdateList.defaultExpectation == nil && mmUpdateList.mock.funcUpdateList == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetBy.mock.afterGetByCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetBy.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateList.mock.afterUpdateListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateList.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetBy implements mm_order.Repository
-func (mmGetBy *OrderRepositoryMock) GetBy(ctx context.Context, fp1 *order.Filter) (opa1 []*order.Order, err error) {
-	mm_atomic.AddUint64(&mmGetBy.beforeGetByCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetBy.afterGetByCounter, 1)
+// UpdateList implements mm_order.Repository
+func (mmUpdateList *OrderRepositoryMock) UpdateList(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) (err error) {
+	mm_atomic.AddUint64(&mmUpdateList.beforeUpdateListCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateList.afterUpdateListCounter, 1)
 
-	mmGetBy.t.Helper()
+	mmUpdateList.t.Helper()
 
-	if mmGetBy.inspectFuncGetBy != nil {
-		mmGetBy.inspectFuncGetBy(ctx, fp1)
+	if mmUpdateList.inspectFuncUpdateList != nil {
+		mmUpdateList.inspectFuncUpdateList(ctx, ids, update)
 	}
 
-	mm_params := OrderRepositoryMockGetByParams{ctx, fp1}
+	mm_params := OrderRepositoryMockUpdateListParams{ctx, ids, update}
 
 	// Record call args
-	mmGetBy.GetByMock.mutex.Lock()
-	mmGetBy.GetByMock.callArgs = append(mmGetBy.GetByMock.callArgs, &mm_params)
-	mmGetBy.GetByMock.mutex.Unlock()
+	mmUpdateList.UpdateListMock.mutex.Lock()
+	mmUpdateList.UpdateListMock.callArgs = append(mmUpdateList.UpdateListMock.callArgs, &mm_params)
+	mmUpdateList.UpdateListMock.mutex.Unlock()
 
-	for _, e := range mmGetBy.GetByMock.expectations {
+	for _, e := range mmUpdateList.UpdateListMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.opa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetBy.GetByMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetBy.GetByMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetBy.GetByMock.defaultExpectation.params
-		mm_want_ptrs := mmGetBy.GetByMock.defaultExpectation.paramPtrs
+	if mmUpdateList.UpdateListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateList.UpdateListMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateList.UpdateListMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateList.UpdateListMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockGetByParams{ctx, fp1}
+		mm_got := OrderRepositoryMockUpdateListParams{ctx, ids, update}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetBy.t.Errorf("OrderRepositoryMock.GetBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetBy.GetByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateList.t.Errorf("OrderRepositoryMock.UpdateList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateList.UpdateListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.fp1 != nil && !minimock.Equal(*mm_want_ptrs.fp1, mm_got.fp1) {
-				mmGetBy.t.Errorf("OrderRepositoryMock.GetBy got unexpected parameter fp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetBy.GetByMock.defaultExpectation.expectationOrigins.originFp1, *mm_want_ptrs.fp1, mm_got.fp1, minimock.Diff(*mm_want_ptrs.fp1, mm_got.fp1))
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmUpdateList.t.Errorf("OrderRepositoryMock.UpdateList got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateList.UpdateListMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateList.t.Errorf("OrderRepositoryMock.UpdateList got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateList.UpdateListMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetBy.t.Errorf("OrderRepositoryMock.GetBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetBy.GetByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateList.t.Errorf("OrderRepositoryMock.UpdateList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateList.UpdateListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetBy.GetByMock.defaultExpectation.results
+		mm_results := mmUpdateList.UpdateListMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetBy.t.Fatal("No results are set for the OrderRepositoryMock.GetBy")
+			mmUpdateList.t.Fatal("No results are set for the OrderRepositoryMock.UpdateList")
 		}
-		return (*mm_results).opa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetBy.funcGetBy != nil {
-		return mmGetBy.funcGetBy(ctx, fp1)
+	if mmUpdateList.funcUpdateList != nil {
+		return mmUpdateList.funcUpdateList(ctx, ids, update)
 	}
-	mmGetBy.t.Fatalf("Unexpected call to OrderRepositoryMock.GetBy. %v %v", ctx, fp1)
+	mmUpdateList.t.Fatalf("Unexpected call to OrderRepositoryMock.UpdateList. %v %v %v", ctx, ids, update)
 	return
 }
 
-// GetByAfterCounter returns a count of finished OrderRepositoryMock.GetBy invocations
-func (mmGetBy *OrderRepositoryMock) GetByAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetBy.afterGetByCounter)
+// UpdateListAfterCounter returns a count of finished OrderRepositoryMock.UpdateList invocations
+func (mmUpdateList *OrderRepositoryMock) UpdateListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateList.afterUpdateListCounter)
 }
 
-// GetByBeforeCounter returns a count of OrderRepositoryMock.GetBy invocations
-func (mmGetBy *OrderRepositoryMock) GetByBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetBy.beforeGetByCounter)
+// UpdateListBeforeCounter returns a count of OrderRepositoryMock.UpdateList invocations
+func (mmUpdateList *OrderRepositoryMock) UpdateListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateList.beforeUpdateListCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetBy.
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.UpdateList.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetBy *mOrderRepositoryMockGetBy) Calls() []*OrderRepositoryMockGetByParams {
-	mmGetBy.mutex.RLock()
+func (mmUpdateList *mOrderRepositoryMockUpdateList) Calls() []*OrderRepositoryMockUpdateListParams {
+	mmUpdateList.mutex.RLock()
 
-	argCopy := make([]*OrderRepositoryMockGetByParams, len(mmGetBy.callArgs))
-	copy(argCopy, mmGetBy.callArgs)
+	argCopy := make([]*OrderRepositoryMockUpdateListParams, len(mmUpdateList.callArgs))
+	copy(argCopy, mmUpdateList.callArgs)
 
-	mmGetBy.mutex.RUnlock()
+	mmUpdateList.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetByDone returns true if the count of the GetBy invocations corresponds
+// MinimockUpdateListDone returns true if the count of the UpdateList invocations corresponds
 // the number of defined expectations
-func (m *OrderRepositoryMock) MinimockGetByDone() bool {
-	if m.GetByMock.optional {
+func (m *OrderRepositoryMock) MinimockUpdateListDone() bool {
+	if m.UpdateListMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetByMock.expectations {
+	for _, e := range m.UpdateListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetByMock.invocationsDone()
+	return m.UpdateListMock.invocationsDone()
 }
 
-// MinimockGetByInspect logs each unmet expectation
-func (m *OrderRepositoryMock) MinimockGetByInspect() {
-	for _, e := range m.GetByMock.expectations {
+// MinimockUpdateListInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockUpdateListInspect() {
+	for _, e := range m.UpdateListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.UpdateList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetByCounter := mm_atomic.LoadUint64(&m.afterGetByCounter)
+	afterUpdateListCounter := mm_atomic.LoadUint64(&m.afterUpdateListCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetByMock.defaultExpectation != nil && afterGetByCounter < 1 {
-		if m.GetByMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s", m.GetByMock.defaultExpectation.returnOrigin)
+	if m.UpdateListMock.defaultExpectation != nil && afterUpdateListCounter < 1 {
+		if m.UpdateListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.UpdateList at\n%s", m.UpdateListMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s with params: %#v", m.GetByMock.defaultExpectation.expectationOrigins.origin, *m.GetByMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.UpdateList at\n%s with params: %#v", m.UpdateListMock.defaultExpectation.expectationOrigins.origin, *m.UpdateListMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetBy != nil && afterGetByCounter < 1 {
-		m.t.Errorf("Expected call to OrderRepositoryMock.GetBy at\n%s", m.funcGetByOrigin)
+	if m.funcUpdateList != nil && afterUpdateListCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.UpdateList at\n%s", m.funcUpdateListOrigin)
 	}
 
-	if !m.GetByMock.invocationsDone() && afterGetByCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetBy at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetByMock.expectedInvocations), m.GetByMock.expectedInvocationsOrigin, afterGetByCounter)
+	if !m.UpdateListMock.invocationsDone() && afterUpdateListCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.UpdateList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateListMock.expectedInvocations), m.UpdateListMock.expectedInvocationsOrigin, afterUpdateListCounter)
 	}
 }
 
-type mOrderRepositoryMockGetByPaginated struct {
+type mOrderRepositoryMockUpdatePickupPoint struct {
 	optional           bool
 	mock               *OrderRepositoryMock
-	defaultExpectation *OrderRepositoryMockGetByPaginatedExpectation
-	expectations       []*OrderRepositoryMockGetByPaginatedExpectation
+	defaultExpectation *OrderRepositoryMockUpdatePickupPointExpectation
+	expectations       []*OrderRepositoryMockUpdatePickupPointExpectation
 
-	callArgs []*OrderRepositoryMockGetByPaginatedParams
+	callArgs []*OrderRepositoryMockUpdatePickupPointParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrderRepositoryMockGetByPaginatedExpectation specifies expectation struct of the Repository.GetByPaginated
-type OrderRepositoryMockGetByPaginatedExpectation struct {
+// OrderRepositoryMockUpdatePickupPointExpectation specifies expectation struct of the Repository.UpdatePickupPoint
+type OrderRepositoryMockUpdatePickupPointExpectation struct {
 	mock               *OrderRepositoryMock
-	params             *OrderRepositoryMockGetByPaginatedParams
-	paramPtrs          *OrderRepositoryMockGetByPaginatedParamPtrs
-	expectationOrigins OrderRepositoryMockGetByPaginatedExpectationOrigins
-	results            *OrderRepositoryMockGetByPaginatedResults
+	params             *OrderRepositoryMockUpdatePickupPointParams
+	paramPtrs          *OrderRepositoryMockUpdatePickupPointParamPtrs
+	expectationOrigins OrderRepositoryMockUpdatePickupPointExpectationOrigins
+	results            *OrderRepositoryMockUpdatePickupPointResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepositoryMockGetByPaginatedParams contains parameters of the Repository.GetByPaginated
-type OrderRepositoryMockGetByPaginatedParams struct {
-	ctx    context.Context
-	filter *order.Filter
-	offset uint
-	limit  int
+// OrderRepositoryMockUpdatePickupPointParams contains parameters of the Repository.UpdatePickupPoint
+type OrderRepositoryMockUpdatePickupPointParams struct {
+	ctx context.Context
+	pp1 *pickuppoint.PickupPoint
 }
 
-// OrderRepositoryMockGetByPaginatedParamPtrs contains pointers to parameters of the Repository.GetByPaginated
-type OrderRepositoryMockGetByPaginatedParamPtrs struct {
-	ctx    *context.Context
-	filter **order.Filter
-	offset *uint
-	limit  *int
+// OrderRepositoryMockUpdatePickupPointParamPtrs contains pointers to parameters of the Repository.UpdatePickupPoint
+type OrderRepositoryMockUpdatePickupPointParamPtrs struct {
+	ctx *context.Context
+	pp1 **pickuppoint.PickupPoint
 }
 
-// OrderRepositoryMockGetByPaginatedResults contains results of the Repository.GetByPaginated
-type OrderRepositoryMockGetByPaginatedResults struct {
-	opa1 []*order.Order
-	err  error
+// OrderRepositoryMockUpdatePickupPointResults contains results of the Repository.UpdatePickupPoint
+type OrderRepositoryMockUpdatePickupPointResults struct {
+	err error
 }
 
-// OrderRepositoryMockGetByPaginatedOrigins contains origins of expectations of the Repository.GetByPaginated
-type OrderRepositoryMockGetByPaginatedExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originFilter string
-	originOffset string
-	originLimit  string
+// OrderRepositoryMockUpdatePickupPointOrigins contains origins of expectations of the Repository.UpdatePickupPoint
+type OrderRepositoryMockUpdatePickupPointExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originPp1 string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2219,348 +8553,292 @@ type OrderRepositoryMockGetByPaginatedExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Optional() *mOrderRepositoryMockGetByPaginated {
-	mmGetByPaginated.optional = true
-	return mmGetByPaginated
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Optional() *mOrderRepositoryMockUpdatePickupPoint {
+	mmUpdatePickupPoint.optional = true
+	return mmUpdatePickupPoint
 }
 
-// Expect sets up expected params for Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Expect(ctx context.Context, filter *order.Filter, offset uint, limit int) *mOrderRepositoryMockGetByPaginated {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
+// Expect sets up expected params for Repository.UpdatePickupPoint
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Expect(ctx context.Context, pp1 *pickuppoint.PickupPoint) *mOrderRepositoryMockUpdatePickupPoint {
+	if mmUpdatePickupPoint.mock.funcUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Set")
 	}
 
-	if mmGetByPaginated.defaultExpectation == nil {
-		mmGetByPaginated.defaultExpectation = &OrderRepositoryMockGetByPaginatedExpectation{}
+	if mmUpdatePickupPoint.defaultExpectation == nil {
+		mmUpdatePickupPoint.defaultExpectation = &OrderRepositoryMockUpdatePickupPointExpectation{}
 	}
 
-	if mmGetByPaginated.defaultExpectation.paramPtrs != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by ExpectParams functions")
+	if mmUpdatePickupPoint.defaultExpectation.paramPtrs != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by ExpectParams functions")
 	}
 
-	mmGetByPaginated.defaultExpectation.params = &OrderRepositoryMockGetByPaginatedParams{ctx, filter, offset, limit}
-	mmGetByPaginated.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetByPaginated.expectations {
-		if minimock.Equal(e.params, mmGetByPaginated.defaultExpectation.params) {
-			mmGetByPaginated.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByPaginated.defaultExpectation.params)
+	mmUpdatePickupPoint.defaultExpectation.params = &OrderRepositoryMockUpdatePickupPointParams{ctx, pp1}
+	mmUpdatePickupPoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePickupPoint.expectations {
+		if minimock.Equal(e.params, mmUpdatePickupPoint.defaultExpectation.params) {
+			mmUpdatePickupPoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePickupPoint.defaultExpectation.params)
 		}
 	}
 
-	return mmGetByPaginated
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockGetByPaginated {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
-	}
-
-	if mmGetByPaginated.defaultExpectation == nil {
-		mmGetByPaginated.defaultExpectation = &OrderRepositoryMockGetByPaginatedExpectation{}
-	}
-
-	if mmGetByPaginated.defaultExpectation.params != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Expect")
-	}
-
-	if mmGetByPaginated.defaultExpectation.paramPtrs == nil {
-		mmGetByPaginated.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByPaginatedParamPtrs{}
-	}
-	mmGetByPaginated.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetByPaginated.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmGetByPaginated
-}
-
-// ExpectFilterParam2 sets up expected param filter for Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) ExpectFilterParam2(filter *order.Filter) *mOrderRepositoryMockGetByPaginated {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
-	}
-
-	if mmGetByPaginated.defaultExpectation == nil {
-		mmGetByPaginated.defaultExpectation = &OrderRepositoryMockGetByPaginatedExpectation{}
-	}
-
-	if mmGetByPaginated.defaultExpectation.params != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Expect")
-	}
-
-	if mmGetByPaginated.defaultExpectation.paramPtrs == nil {
-		mmGetByPaginated.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByPaginatedParamPtrs{}
-	}
-	mmGetByPaginated.defaultExpectation.paramPtrs.filter = &filter
-	mmGetByPaginated.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
-
-	return mmGetByPaginated
+	return mmUpdatePickupPoint
 }
 
-// ExpectOffsetParam3 sets up expected param offset for Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) ExpectOffsetParam3(offset uint) *mOrderRepositoryMockGetByPaginated {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdatePickupPoint
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockUpdatePickupPoint {
+	if mmUpdatePickupPoint.mock.funcUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Set")
 	}
 
-	if mmGetByPaginated.defaultExpectation == nil {
-		mmGetByPaginated.defaultExpectation = &OrderRepositoryMockGetByPaginatedExpectation{}
+	if mmUpdatePickupPoint.defaultExpectation == nil {
+		mmUpdatePickupPoint.defaultExpectation = &OrderRepositoryMockUpdatePickupPointExpectation{}
 	}
 
-	if mmGetByPaginated.defaultExpectation.params != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Expect")
+	if mmUpdatePickupPoint.defaultExpectation.params != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Expect")
 	}
 
-	if mmGetByPaginated.defaultExpectation.paramPtrs == nil {
-		mmGetByPaginated.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByPaginatedParamPtrs{}
+	if mmUpdatePickupPoint.defaultExpectation.paramPtrs == nil {
+		mmUpdatePickupPoint.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdatePickupPointParamPtrs{}
 	}
-	mmGetByPaginated.defaultExpectation.paramPtrs.offset = &offset
-	mmGetByPaginated.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+	mmUpdatePickupPoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePickupPoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetByPaginated
+	return mmUpdatePickupPoint
 }
 
-// ExpectLimitParam4 sets up expected param limit for Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) ExpectLimitParam4(limit int) *mOrderRepositoryMockGetByPaginated {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
+// ExpectPp1Param2 sets up expected param pp1 for Repository.UpdatePickupPoint
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) ExpectPp1Param2(pp1 *pickuppoint.PickupPoint) *mOrderRepositoryMockUpdatePickupPoint {
+	if mmUpdatePickupPoint.mock.funcUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Set")
 	}
 
-	if mmGetByPaginated.defaultExpectation == nil {
-		mmGetByPaginated.defaultExpectation = &OrderRepositoryMockGetByPaginatedExpectation{}
+	if mmUpdatePickupPoint.defaultExpectation == nil {
+		mmUpdatePickupPoint.defaultExpectation = &OrderRepositoryMockUpdatePickupPointExpectation{}
 	}
 
-	if mmGetByPaginated.defaultExpectation.params != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Expect")
+	if mmUpdatePickupPoint.defaultExpectation.params != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Expect")
 	}
 
-	if mmGetByPaginated.defaultExpectation.paramPtrs == nil {
-		mmGetByPaginated.defaultExpectation.paramPtrs = &OrderRepositoryMockGetByPaginatedParamPtrs{}
+	if mmUpdatePickupPoint.defaultExpectation.paramPtrs == nil {
+		mmUpdatePickupPoint.defaultExpectation.paramPtrs = &OrderRepositoryMockUpdatePickupPointParamPtrs{}
 	}
-	mmGetByPaginated.defaultExpectation.paramPtrs.limit = &limit
-	mmGetByPaginated.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+	mmUpdatePickupPoint.defaultExpectation.paramPtrs.pp1 = &pp1
+	mmUpdatePickupPoint.defaultExpectation.expectationOrigins.originPp1 = minimock.CallerInfo(1)
 
-	return mmGetByPaginated
+	return mmUpdatePickupPoint
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Inspect(f func(ctx context.Context, filter *order.Filter, offset uint, limit int)) *mOrderRepositoryMockGetByPaginated {
-	if mmGetByPaginated.mock.inspectFuncGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.GetByPaginated")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdatePickupPoint
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Inspect(f func(ctx context.Context, pp1 *pickuppoint.PickupPoint)) *mOrderRepositoryMockUpdatePickupPoint {
+	if mmUpdatePickupPoint.mock.inspectFuncUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.UpdatePickupPoint")
 	}
 
-	mmGetByPaginated.mock.inspectFuncGetByPaginated = f
+	mmUpdatePickupPoint.mock.inspectFuncUpdatePickupPoint = f
 
-	return mmGetByPaginated
+	return mmUpdatePickupPoint
 }
 
-// Return sets up results that will be returned by Repository.GetByPaginated
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Return(opa1 []*order.Order, err error) *OrderRepositoryMock {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdatePickupPoint
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Return(err error) *OrderRepositoryMock {
+	if mmUpdatePickupPoint.mock.funcUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Set")
 	}
 
-	if mmGetByPaginated.defaultExpectation == nil {
-		mmGetByPaginated.defaultExpectation = &OrderRepositoryMockGetByPaginatedExpectation{mock: mmGetByPaginated.mock}
+	if mmUpdatePickupPoint.defaultExpectation == nil {
+		mmUpdatePickupPoint.defaultExpectation = &OrderRepositoryMockUpdatePickupPointExpectation{mock: mmUpdatePickupPoint.mock}
 	}
-	mmGetByPaginated.defaultExpectation.results = &OrderRepositoryMockGetByPaginatedResults{opa1, err}
-	mmGetByPaginated.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetByPaginated.mock
+	mmUpdatePickupPoint.defaultExpectation.results = &OrderRepositoryMockUpdatePickupPointResults{err}
+	mmUpdatePickupPoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePickupPoint.mock
 }
 
-// Set uses given function f to mock the Repository.GetByPaginated method
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Set(f func(ctx context.Context, filter *order.Filter, offset uint, limit int) (opa1 []*order.Order, err error)) *OrderRepositoryMock {
-	if mmGetByPaginated.defaultExpectation != nil {
-		mmGetByPaginated.mock.t.Fatalf("Default expectation is already set for the Repository.GetByPaginated method")
+// Set uses given function f to mock the Repository.UpdatePickupPoint method
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Set(f func(ctx context.Context, pp1 *pickuppoint.PickupPoint) (err error)) *OrderRepositoryMock {
+	if mmUpdatePickupPoint.defaultExpectation != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("Default expectation is already set for the Repository.UpdatePickupPoint method")
 	}
 
-	if len(mmGetByPaginated.expectations) > 0 {
-		mmGetByPaginated.mock.t.Fatalf("Some expectations are already set for the Repository.GetByPaginated method")
+	if len(mmUpdatePickupPoint.expectations) > 0 {
+		mmUpdatePickupPoint.mock.t.Fatalf("Some expectations are already set for the Repository.UpdatePickupPoint method")
 	}
 
-	mmGetByPaginated.mock.funcGetByPaginated = f
-	mmGetByPaginated.mock.funcGetByPaginatedOrigin = minimock.CallerInfo(1)
-	return mmGetByPaginated.mock
+	mmUpdatePickupPoint.mock.funcUpdatePickupPoint = f
+	mmUpdatePickupPoint.mock.funcUpdatePickupPointOrigin = minimock.CallerInfo(1)
+	return mmUpdatePickupPoint.mock
 }
 
-// When sets expectation for the Repository.GetByPaginated which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdatePickupPoint which will trigger the result defined by the following
 // Then helper
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) When(ctx context.Context, filter *order.Filter, offset uint, limit int) *OrderRepositoryMockGetByPaginatedExpectation {
-	if mmGetByPaginated.mock.funcGetByPaginated != nil {
-		mmGetByPaginated.mock.t.Fatalf("OrderRepositoryMock.GetByPaginated mock is already set by Set")
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) When(ctx context.Context, pp1 *pickuppoint.PickupPoint) *OrderRepositoryMockUpdatePickupPointExpectation {
+	if mmUpdatePickupPoint.mock.funcUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.mock.t.Fatalf("OrderRepositoryMock.UpdatePickupPoint mock is already set by Set")
 	}
 
-	expectation := &OrderRepositoryMockGetByPaginatedExpectation{
-		mock:               mmGetByPaginated.mock,
-		params:             &OrderRepositoryMockGetByPaginatedParams{ctx, filter, offset, limit},
-		expectationOrigins: OrderRepositoryMockGetByPaginatedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderRepositoryMockUpdatePickupPointExpectation{
+		mock:               mmUpdatePickupPoint.mock,
+		params:             &OrderRepositoryMockUpdatePickupPointParams{ctx, pp1},
+		expectationOrigins: OrderRepositoryMockUpdatePickupPointExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetByPaginated.expectations = append(mmGetByPaginated.expectations, expectation)
+	mmUpdatePickupPoint.expectations = append(mmUpdatePickupPoint.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.GetByPaginated return parameters for the expectation previously defined by the When method
-func (e *OrderRepositoryMockGetByPaginatedExpectation) Then(opa1 []*order.Order, err error) *OrderRepositoryMock {
-	e.results = &OrderRepositoryMockGetByPaginatedResults{opa1, err}
+// Then sets up Repository.UpdatePickupPoint return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockUpdatePickupPointExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockUpdatePickupPointResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.GetByPaginated should be invoked
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Times(n uint64) *mOrderRepositoryMockGetByPaginated {
+// Times sets number of times Repository.UpdatePickupPoint should be invoked
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Times(n uint64) *mOrderRepositoryMockUpdatePickupPoint {
 	if n == 0 {
-		mmGetByPaginated.mock.t.Fatalf("Times of OrderRepositoryMock.GetByPaginated mock can not be zero")
+		mmUpdatePickupPoint.mock.t.Fatalf("Times of OrderRepositoryMock.UpdatePickupPoint mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetByPaginated.expectedInvocations, n)
-	mmGetByPaginated.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetByPaginated
+	mm_atomic.StoreUint64(&mmUpdatePickupPoint.expectedInvocations, n)
+	mmUpdatePickupPoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePickupPoint
 }
 
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) invocationsDone() bool {
-	if len(mmGetByPaginated.expectations) == 0 && mmGetByPaginated.defaultExpectation == nil && mmGetByPaginated.mock.funcGetByPaginated == nil {
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) invocationsDone() bool {
+	if len(mmUpdatePickupPoint.expectations) == 0 && mmUpdatePickupPoint.defaultExpectation == nil && mmUpdatePickupPoint.mock.funcUpdatePickupPoint == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetByPaginated.mock.afterGetByPaginatedCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetByPaginated.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePickupPoint.mock.afterUpdatePickupPointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePickupPoint.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetByPaginated implements mm_order.Repository
-func (mmGetByPaginated *OrderRepositoryMock) GetByPaginated(ctx context.Context, filter *order.Filter, offset uint, limit int) (opa1 []*order.Order, err error) {
-	mm_atomic.AddUint64(&mmGetByPaginated.beforeGetByPaginatedCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetByPaginated.afterGetByPaginatedCounter, 1)
+// UpdatePickupPoint implements mm_order.Repository
+func (mmUpdatePickupPoint *OrderRepositoryMock) UpdatePickupPoint(ctx context.Context, pp1 *pickuppoint.PickupPoint) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePickupPoint.beforeUpdatePickupPointCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePickupPoint.afterUpdatePickupPointCounter, 1)
 
-	mmGetByPaginated.t.Helper()
+	mmUpdatePickupPoint.t.Helper()
 
-	if mmGetByPaginated.inspectFuncGetByPaginated != nil {
-		mmGetByPaginated.inspectFuncGetByPaginated(ctx, filter, offset, limit)
+	if mmUpdatePickupPoint.inspectFuncUpdatePickupPoint != nil {
+		mmUpdatePickupPoint.inspectFuncUpdatePickupPoint(ctx, pp1)
 	}
 
-	mm_params := OrderRepositoryMockGetByPaginatedParams{ctx, filter, offset, limit}
+	mm_params := OrderRepositoryMockUpdatePickupPointParams{ctx, pp1}
 
 	// Record call args
-	mmGetByPaginated.GetByPaginatedMock.mutex.Lock()
-	mmGetByPaginated.GetByPaginatedMock.callArgs = append(mmGetByPaginated.GetByPaginatedMock.callArgs, &mm_params)
-	mmGetByPaginated.GetByPaginatedMock.mutex.Unlock()
+	mmUpdatePickupPoint.UpdatePickupPointMock.mutex.Lock()
+	mmUpdatePickupPoint.UpdatePickupPointMock.callArgs = append(mmUpdatePickupPoint.UpdatePickupPointMock.callArgs, &mm_params)
+	mmUpdatePickupPoint.UpdatePickupPointMock.mutex.Unlock()
 
-	for _, e := range mmGetByPaginated.GetByPaginatedMock.expectations {
+	for _, e := range mmUpdatePickupPoint.UpdatePickupPointMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.opa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetByPaginated.GetByPaginatedMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetByPaginated.GetByPaginatedMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetByPaginated.GetByPaginatedMock.defaultExpectation.params
-		mm_want_ptrs := mmGetByPaginated.GetByPaginatedMock.defaultExpectation.paramPtrs
+	if mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockGetByPaginatedParams{ctx, filter, offset, limit}
+		mm_got := OrderRepositoryMockUpdatePickupPointParams{ctx, pp1}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetByPaginated.t.Errorf("OrderRepositoryMock.GetByPaginated got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetByPaginated.GetByPaginatedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmGetByPaginated.t.Errorf("OrderRepositoryMock.GetByPaginated got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetByPaginated.GetByPaginatedMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
-			}
-
-			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
-				mmGetByPaginated.t.Errorf("OrderRepositoryMock.GetByPaginated got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetByPaginated.GetByPaginatedMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+				mmUpdatePickupPoint.t.Errorf("OrderRepositoryMock.UpdatePickupPoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
-				mmGetByPaginated.t.Errorf("OrderRepositoryMock.GetByPaginated got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetByPaginated.GetByPaginatedMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			if mm_want_ptrs.pp1 != nil && !minimock.Equal(*mm_want_ptrs.pp1, mm_got.pp1) {
+				mmUpdatePickupPoint.t.Errorf("OrderRepositoryMock.UpdatePickupPoint got unexpected parameter pp1, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.expectationOrigins.originPp1, *mm_want_ptrs.pp1, mm_got.pp1, minimock.Diff(*mm_want_ptrs.pp1, mm_got.pp1))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetByPaginated.t.Errorf("OrderRepositoryMock.GetByPaginated got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetByPaginated.GetByPaginatedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePickupPoint.t.Errorf("OrderRepositoryMock.UpdatePickupPoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetByPaginated.GetByPaginatedMock.defaultExpectation.results
+		mm_results := mmUpdatePickupPoint.UpdatePickupPointMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetByPaginated.t.Fatal("No results are set for the OrderRepositoryMock.GetByPaginated")
+			mmUpdatePickupPoint.t.Fatal("No results are set for the OrderRepositoryMock.UpdatePickupPoint")
 		}
-		return (*mm_results).opa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetByPaginated.funcGetByPaginated != nil {
-		return mmGetByPaginated.funcGetByPaginated(ctx, filter, offset, limit)
+	if mmUpdatePickupPoint.funcUpdatePickupPoint != nil {
+		return mmUpdatePickupPoint.funcUpdatePickupPoint(ctx, pp1)
 	}
-	mmGetByPaginated.t.Fatalf("Unexpected call to OrderRepositoryMock.GetByPaginated. %v %v %v %v", ctx, filter, offset, limit)
+	mmUpdatePickupPoint.t.Fatalf("Unexpected call to OrderRepositoryMock.UpdatePickupPoint. %v %v", ctx, pp1)
 	return
 }
 
-// GetByPaginatedAfterCounter returns a count of finished OrderRepositoryMock.GetByPaginated invocations
-func (mmGetByPaginated *OrderRepositoryMock) GetByPaginatedAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetByPaginated.afterGetByPaginatedCounter)
+// UpdatePickupPointAfterCounter returns a count of finished OrderRepositoryMock.UpdatePickupPoint invocations
+func (mmUpdatePickupPoint *OrderRepositoryMock) UpdatePickupPointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePickupPoint.afterUpdatePickupPointCounter)
 }
 
-// GetByPaginatedBeforeCounter returns a count of OrderRepositoryMock.GetByPaginated invocations
-func (mmGetByPaginated *OrderRepositoryMock) GetByPaginatedBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetByPaginated.beforeGetByPaginatedCounter)
+// UpdatePickupPointBeforeCounter returns a count of OrderRepositoryMock.UpdatePickupPoint invocations
+func (mmUpdatePickupPoint *OrderRepositoryMock) UpdatePickupPointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePickupPoint.beforeUpdatePickupPointCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderRepositoryMock.GetByPaginated.
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.UpdatePickupPoint.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetByPaginated *mOrderRepositoryMockGetByPaginated) Calls() []*OrderRepositoryMockGetByPaginatedParams {
-	mmGetByPaginated.mutex.RLock()
+func (mmUpdatePickupPoint *mOrderRepositoryMockUpdatePickupPoint) Calls() []*OrderRepositoryMockUpdatePickupPointParams {
+	mmUpdatePickupPoint.mutex.RLock()
 
-	argCopy := make([]*OrderRepositoryMockGetByPaginatedParams, len(mmGetByPaginated.callArgs))
-	copy(argCopy, mmGetByPaginated.callArgs)
+	argCopy := make([]*OrderRepositoryMockUpdatePickupPointParams, len(mmUpdatePickupPoint.callArgs))
+	copy(argCopy, mmUpdatePickupPoint.callArgs)
 
-	mmGetByPaginated.mutex.RUnlock()
+	mmUpdatePickupPoint.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetByPaginatedDone returns true if the count of the GetByPaginated invocations corresponds
+// MinimockUpdatePickupPointDone returns true if the count of the UpdatePickupPoint invocations corresponds
 // the number of defined expectations
-func (m *OrderRepositoryMock) MinimockGetByPaginatedDone() bool {
-	if m.GetByPaginatedMock.optional {
+func (m *OrderRepositoryMock) MinimockUpdatePickupPointDone() bool {
+	if m.UpdatePickupPointMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetByPaginatedMock.expectations {
+	for _, e := range m.UpdatePickupPointMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetByPaginatedMock.invocationsDone()
+	return m.UpdatePickupPointMock.invocationsDone()
 }
 
-// MinimockGetByPaginatedInspect logs each unmet expectation
-func (m *OrderRepositoryMock) MinimockGetByPaginatedInspect() {
-	for _, e := range m.GetByPaginatedMock.expectations {
+// MinimockUpdatePickupPointInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockUpdatePickupPointInspect() {
+	for _, e := range m.UpdatePickupPointMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderRepositoryMock.GetByPaginated at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.UpdatePickupPoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetByPaginatedCounter := mm_atomic.LoadUint64(&m.afterGetByPaginatedCounter)
+	afterUpdatePickupPointCounter := mm_atomic.LoadUint64(&m.afterUpdatePickupPointCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetByPaginatedMock.defaultExpectation != nil && afterGetByPaginatedCounter < 1 {
-		if m.GetByPaginatedMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderRepositoryMock.GetByPaginated at\n%s", m.GetByPaginatedMock.defaultExpectation.returnOrigin)
+	if m.UpdatePickupPointMock.defaultExpectation != nil && afterUpdatePickupPointCounter < 1 {
+		if m.UpdatePickupPointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.UpdatePickupPoint at\n%s", m.UpdatePickupPointMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderRepositoryMock.GetByPaginated at\n%s with params: %#v", m.GetByPaginatedMock.defaultExpectation.expectationOrigins.origin, *m.GetByPaginatedMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderRepositoryMock.UpdatePickupPoint at\n%s with params: %#v", m.UpdatePickupPointMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePickupPointMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetByPaginated != nil && afterGetByPaginatedCounter < 1 {
-		m.t.Errorf("Expected call to OrderRepositoryMock.GetByPaginated at\n%s", m.funcGetByPaginatedOrigin)
+	if m.funcUpdatePickupPoint != nil && afterUpdatePickupPointCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.UpdatePickupPoint at\n%s", m.funcUpdatePickupPointOrigin)
 	}
 
-	if !m.GetByPaginatedMock.invocationsDone() && afterGetByPaginatedCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderRepositoryMock.GetByPaginated at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetByPaginatedMock.expectedInvocations), m.GetByPaginatedMock.expectedInvocationsOrigin, afterGetByPaginatedCounter)
+	if !m.UpdatePickupPointMock.invocationsDone() && afterUpdatePickupPointCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.UpdatePickupPoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePickupPointMock.expectedInvocations), m.UpdatePickupPointMock.expectedInvocationsOrigin, afterUpdatePickupPointCounter)
 	}
 }
 
@@ -2568,19 +8846,53 @@ func (m *OrderRepositoryMock) MinimockGetByPaginatedInspect() {
 func (m *OrderRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddHandoverInspect()
+
 			m.MinimockAddOrUpdateInspect()
 
 			m.MinimockAddOrUpdateListInspect()
 
+			m.MinimockAddOrUpdatePackagingTypeInspect()
+
+			m.MinimockAddOrUpdateWithinCapacityInspect()
+
+			m.MinimockCreatePickupPointInspect()
+
 			m.MinimockDeleteInspect()
 
 			m.MinimockDeleteByInspect()
 
+			m.MinimockDeletePackagingTypeInspect()
+
 			m.MinimockGetInspect()
 
 			m.MinimockGetByInspect()
 
-			m.MinimockGetByPaginatedInspect()
+			m.MinimockGetExpiredIDsInspect()
+
+			m.MinimockGetHandoverInspect()
+
+			m.MinimockGetHistoryInspect()
+
+			m.MinimockGetPackagingTypeInspect()
+
+			m.MinimockGetPageInspect()
+
+			m.MinimockGetPickupPointInspect()
+
+			m.MinimockIssueListInspect()
+
+			m.MinimockListPackagingTypesInspect()
+
+			m.MinimockListPickupPointsInspect()
+
+			m.MinimockPickupPointExistsInspect()
+
+			m.MinimockUpdateInspect()
+
+			m.MinimockUpdateListInspect()
+
+			m.MinimockUpdatePickupPointInspect()
 		}
 	})
 }
@@ -2604,11 +8916,28 @@ func (m *OrderRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *OrderRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddHandoverDone() &&
 		m.MinimockAddOrUpdateDone() &&
 		m.MinimockAddOrUpdateListDone() &&
+		m.MinimockAddOrUpdatePackagingTypeDone() &&
+		m.MinimockAddOrUpdateWithinCapacityDone() &&
+		m.MinimockCreatePickupPointDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockDeleteByDone() &&
+		m.MinimockDeletePackagingTypeDone() &&
 		m.MinimockGetDone() &&
 		m.MinimockGetByDone() &&
-		m.MinimockGetByPaginatedDone()
+		m.MinimockGetExpiredIDsDone() &&
+		m.MinimockGetHandoverDone() &&
+		m.MinimockGetHistoryDone() &&
+		m.MinimockGetPackagingTypeDone() &&
+		m.MinimockGetPageDone() &&
+		m.MinimockGetPickupPointDone() &&
+		m.MinimockIssueListDone() &&
+		m.MinimockListPackagingTypesDone() &&
+		m.MinimockListPickupPointsDone() &&
+		m.MinimockPickupPointExistsDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockUpdateListDone() &&
+		m.MinimockUpdatePickupPointDone()
 }
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/archive"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"time"
+)
+
+var _ archive.Storage = (*storageFacade)(nil)
+
+// ArchiveOrders moves up to limit orders with the given statuses updated before the given time
+// and the orders deleted before it to orders_archive, frees their IDs and returns them.
+// Orders locked by other transactions are skipped.
+func (r *PgRepository) ArchiveOrders(ctx context.Context, tx pgx.Tx, statuses []order.Status, before, archivedAt time.Time, limit int) ([]*order.Order, error) {
+	var archived []*order.Order
+	err := pgxscan.Select(ctx, tx, &archived, `
+		WITH moved AS (
+			DELETE FROM orders WHERE id IN (
+				SELECT id FROM orders
+				WHERE (status = ANY($1) AND status_updated < $2) OR deleted_at < $2
+				ORDER BY status_updated LIMIT $4
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING `+orderColumns+`, deleted_at
+		)
+		INSERT INTO orders_archive (`+orderColumns+`, deleted_at, archived_at)
+		SELECT `+orderColumns+`, deleted_at, $3 FROM moved
+		RETURNING `+orderColumns,
+		statuses, before, archivedAt, limit)
+	if err != nil || len(archived) == 0 {
+		return archived, err
+	}
+
+	ids := make([]basetypes.ID, len(archived))
+	for i, o := range archived {
+		ids[i] = o.ID
+	}
+	_, err = tx.Exec(ctx, "DELETE FROM order_ids WHERE id = ANY($1)", ids)
+
+	return archived, err
+}
+
+func (s *storageFacade) ArchiveOrders(ctx context.Context, statuses []order.Status, before time.Time, limit int) (archived []*order.Order, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		archived, err = s.pgRepository.ArchiveOrders(ctx, tx, statuses, before, time.Now(), limit)
+		return err
+	})
+	return
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	orderRepo "github.com/vlad1028/order-manager/internal/order"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"time"
 )
 
 var _ orderRepo.Repository = (*storageFacade)(nil)
@@ -30,45 +32,146 @@ func (s *storageFacade) Get(ctx context.Context, id basetypes.ID) (o *order.Orde
 	return
 }
 
-func (s *storageFacade) Delete(ctx context.Context, id basetypes.ID) error {
-	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
-		return s.pgRepository.Delete(ctx, tx, id)
+func (s *storageFacade) Delete(ctx context.Context, id basetypes.ID) (o *order.Order, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		o, err = s.pgRepository.Delete(ctx, tx, id)
+		return err
 	})
+	return
 }
 
-func (s *storageFacade) AddOrUpdate(ctx context.Context, o *order.Order) (exists bool, err error) {
+func (s *storageFacade) AddOrUpdate(ctx context.Context, o *order.Order, events ...order.Event) (exists bool, err error) {
 	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
-		exists, err = s.pgRepository.AddOrUpdate(ctx, tx, o)
-		return err
+		var written bool
+		exists, written, err = s.pgRepository.AddOrUpdate(ctx, tx, o)
+		if err != nil {
+			return err
+		}
+		if !written {
+			// the order is a duplicate, its status didn't change and there is nothing to announce
+			return nil
+		}
+		if err = s.pgRepository.AddStatusChange(ctx, tx, o); err != nil {
+			return err
+		}
+		return s.addEvents(ctx, tx, events)
 	})
 	return
 }
 
-func (s *storageFacade) AddOrUpdateList(ctx context.Context, orders []*order.Order) error {
-	return s.txManager.RunRepeatableRead(ctx, func(tx pgx.Tx) error {
+func (s *storageFacade) Update(ctx context.Context, o *order.Order, events ...order.Event) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		if err := s.pgRepository.Update(ctx, tx, o); err != nil {
+			return err
+		}
+		if err := s.pgRepository.AddStatusChange(ctx, tx, o); err != nil {
+			return err
+		}
+		return s.addEvents(ctx, tx, events)
+	})
+}
+
+func (s *storageFacade) AddOrUpdateList(ctx context.Context, orders []*order.Order, events ...order.Event) error {
+	// read committed lets AddOrUpdate see the orders inserted by the transactions it waits for
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		written := make(map[basetypes.ID]bool, len(orders))
 		for _, o := range orders {
-			if _, err := s.pgRepository.AddOrUpdate(ctx, tx, o); err != nil {
+			ok, err := s.addOrUpdate(ctx, tx, o)
+			if err != nil {
 				return err
 			}
+			written[o.ID] = ok
+		}
+		return s.addEvents(ctx, tx, writtenEvents(events, written))
+	})
+}
+
+// addOrUpdate stores the order and records its status change if it was written.
+func (s *storageFacade) addOrUpdate(ctx context.Context, tx pgx.Tx, o *order.Order) (written bool, err error) {
+	_, written, err = s.pgRepository.AddOrUpdate(ctx, tx, o)
+	if err != nil || !written {
+		return written, err
+	}
+	return true, s.pgRepository.AddStatusChange(ctx, tx, o)
+}
+
+func (s *storageFacade) UpdateList(ctx context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error {
+	return s.txManager.RunSerializable(ctx, func(tx pgx.Tx) error {
+		_, err := s.updateList(ctx, tx, ids, update)
+		return err
+	})
+}
+
+func (s *storageFacade) IssueList(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error {
+	var redeemErr error
+	err := s.txManager.RunSerializable(ctx, func(tx pgx.Tx) error {
+		codes := &txPickupCodes{pgRepository: s.pgRepository, tx: tx}
+		if redeemErr = redeem(ctx, codes); redeemErr != nil {
+			// commit the failed attempt, or the lockout, redeem recorded
+			return nil
+		}
+
+		_, err := s.updateList(ctx, tx, ids, update)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return redeemErr
+}
+
+// updateList locks the orders, stores the ones update returns together with its events and returns them.
+func (s *storageFacade) updateList(ctx context.Context, tx pgx.Tx, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) ([]*order.Order, error) {
+	orders, err := s.pgRepository.GetListForUpdate(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, events, err := update(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range changed {
+		if _, err = s.addOrUpdate(ctx, tx, o); err != nil {
+			return nil, err
 		}
-		return nil
+	}
+	return changed, s.addEvents(ctx, tx, events)
+}
+
+func (s *storageFacade) GetHistory(ctx context.Context, id basetypes.ID) (history []*order.StatusChange, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		history, err = s.pgRepository.GetHistory(ctx, tx, id)
+		return err
 	})
+	return
 }
 
 func (s *storageFacade) GetBy(ctx context.Context, filter *order.Filter) (orders []*order.Order, err error) {
-	return s.GetByPaginated(ctx, filter, 0, -1)
+	return s.GetPage(ctx, filter, nil, nil, -1)
 }
 
-func (s *storageFacade) GetByPaginated(ctx context.Context, filter *order.Filter, offset uint, limit int) (orders []*order.Order, err error) {
+func (s *storageFacade) GetPage(ctx context.Context, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) (orders []*order.Order, err error) {
 	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
-		orders, err = s.pgRepository.GetByPaginated(ctx, tx, filter, offset, limit)
+		orders, err = s.pgRepository.GetPage(ctx, tx, filter, sort, after, limit)
 		return err
 	})
 	return
 }
 
-func (s *storageFacade) DeleteBy(ctx context.Context, filter *order.Filter) error {
-	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
-		return s.pgRepository.DeleteBy(ctx, tx, filter)
+func (s *storageFacade) GetExpiredIDs(ctx context.Context, timeToStore time.Duration, now time.Time, limit int) (ids []basetypes.ID, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		ids, err = s.pgRepository.GetExpiredIDs(ctx, tx, timeToStore, now, limit)
+		return err
 	})
+	return
+}
+
+func (s *storageFacade) DeleteBy(ctx context.Context, filter *order.Filter) (deleted []*order.Order, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		deleted, err = s.pgRepository.DeleteBy(ctx, tx, filter)
+		return err
+	})
+	return
 }
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	errors "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (r *PgRepository) AddHandover(ctx context.Context, tx pgx.Tx, h *order.Handover) error {
+	err := tx.QueryRow(ctx,
+		"INSERT INTO handovers (courier_id, pickup_point_id, signed_off_at) VALUES ($1, $2, $3) RETURNING id",
+		h.CourierID, h.PickupPointID, h.SignedOffAt,
+	).Scan(&h.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx,
+		"INSERT INTO handover_orders (handover_id, order_id) SELECT $1, unnest($2::bigint[])",
+		h.ID, h.OrderIDs)
+
+	return err
+}
+
+func (r *PgRepository) GetHandover(ctx context.Context, tx pgx.Tx, id uint64) (*order.Handover, error) {
+	var h order.Handover
+	err := pgxscan.Get(ctx, tx, &h, `
+		SELECT h.id, h.courier_id, h.pickup_point_id, h.signed_off_at,
+			COALESCE(array_agg(o.order_id ORDER BY o.order_id) FILTER (WHERE o.order_id IS NOT NULL), '{}') AS order_ids
+		FROM handovers h LEFT JOIN handover_orders o ON o.handover_id = h.id
+		WHERE h.id = $1
+		GROUP BY h.id`,
+		id)
+
+	if pgxscan.NotFound(err) {
+		return nil, errors.ErrHandoverNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+func (s *storageFacade) AddHandover(ctx context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error {
+	requested := h.OrderIDs // the transaction may be retried after h.OrderIDs is replaced
+	return s.txManager.RunSerializable(ctx, func(tx pgx.Tx) error {
+		changed, err := s.updateList(ctx, tx, requested, update)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]basetypes.ID, 0, len(changed))
+		for _, o := range changed {
+			ids = append(ids, o.ID)
+		}
+		h.OrderIDs = ids
+		return s.pgRepository.AddHandover(ctx, tx, h)
+	})
+}
+
+func (s *storageFacade) GetHandover(ctx context.Context, id uint64) (h *order.Handover, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		h, err = s.pgRepository.GetHandover(ctx, tx, id)
+		return err
+	})
+	return
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/notify"
+	"time"
+)
+
+var _ notify.Storage = (*storageFacade)(nil)
+
+// AddNotification adds a notification of the given kind to the owner of the order.
+// An order gets at most one notification of every kind.
+func (r *PgRepository) AddNotification(ctx context.Context, tx pgx.Tx, orderID basetypes.ID, kind string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO notifications (order_id, client_id, pickup_point_id, kind, expires_at)
+		SELECT id, client_id, pickup_point_id, $2, expires_at FROM orders WHERE id = $1
+		ON CONFLICT (order_id, kind) DO NOTHING`,
+		orderID, kind)
+
+	return err
+}
+
+func (r *PgRepository) GetPendingNotifications(ctx context.Context, tx pgx.Tx, limit int) ([]*notify.Notification, error) {
+	var notifications []*notify.Notification
+	err := pgxscan.Select(ctx, tx, &notifications, `
+		SELECT n.id, n.order_id, n.client_id, n.pickup_point_id, n.kind, n.expires_at, n.attempts,
+			COALESCE(o.status, '') AS order_status
+		FROM notifications n LEFT JOIN orders o ON o.id = n.order_id AND o.deleted_at IS NULL
+		WHERE n.status = $1 AND n.next_attempt_at <= NOW()
+		ORDER BY n.next_attempt_at, n.id LIMIT $2`,
+		notify.StatusPending, limit)
+
+	return notifications, err
+}
+
+func (r *PgRepository) MarkNotificationSent(ctx context.Context, tx pgx.Tx, id uint64) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE notifications SET status = $2, sent_at = NOW() WHERE id = $1",
+		id, notify.StatusSent)
+
+	return err
+}
+
+func (r *PgRepository) MarkNotificationFailed(ctx context.Context, tx pgx.Tx, id uint64, retryAt *time.Time, reason string) error {
+	status := notify.StatusPending
+	if retryAt == nil {
+		status = notify.StatusFailed
+	}
+
+	_, err := tx.Exec(ctx, `
+		UPDATE notifications
+		SET status = $2, attempts = attempts + 1, last_error = $3, next_attempt_at = COALESCE($4, next_attempt_at)
+		WHERE id = $1`,
+		id, status, reason, retryAt)
+
+	return err
+}
+
+func (r *PgRepository) CancelNotification(ctx context.Context, tx pgx.Tx, id uint64, reason string) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE notifications SET status = $2, last_error = $3 WHERE id = $1",
+		id, notify.StatusCancelled, reason)
+
+	return err
+}
+
+func (r *PgRepository) ScheduleExpiryReminders(ctx context.Context, tx pgx.Tx, timeToStore time.Duration, before time.Time) (int, error) {
+	result, err := tx.Exec(ctx, `
+		INSERT INTO notifications (order_id, client_id, pickup_point_id, kind, expires_at)
+		SELECT id, client_id, pickup_point_id, $1, deadline FROM (
+			SELECT id, client_id, pickup_point_id, COALESCE(expires_at, status_updated + $2::interval) AS deadline
+			FROM orders WHERE status = $3 AND deleted_at IS NULL
+		) stored
+		WHERE deadline > NOW() AND deadline <= $4
+		ON CONFLICT (order_id, kind) DO NOTHING`,
+		notify.KindExpiryReminder, timeToStore, order.Stored, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func (s *storageFacade) GetPendingNotifications(ctx context.Context, limit int) (notifications []*notify.Notification, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		notifications, err = s.pgRepository.GetPendingNotifications(ctx, tx, limit)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) MarkNotificationSent(ctx context.Context, id uint64) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.MarkNotificationSent(ctx, tx, id)
+	})
+}
+
+func (s *storageFacade) MarkNotificationFailed(ctx context.Context, id uint64, retryAt *time.Time, reason string) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.MarkNotificationFailed(ctx, tx, id, retryAt, reason)
+	})
+}
+
+func (s *storageFacade) CancelNotification(ctx context.Context, id uint64, reason string) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.CancelNotification(ctx, tx, id, reason)
+	})
+}
+
+func (s *storageFacade) ScheduleExpiryReminders(ctx context.Context, timeToStore time.Duration, before time.Time) (n int, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		n, err = s.pgRepository.ScheduleExpiryReminders(ctx, tx, timeToStore, before)
+		return err
+	})
+	return
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/outbox"
+	"time"
+)
+
+func (r *PgRepository) AddOutboxMessage(ctx context.Context, tx pgx.Tx, msg *outbox.Message) error {
+	_, err := tx.Exec(ctx,
+		"INSERT INTO outbox (key, payload, created_at) VALUES ($1, $2, NOW())",
+		msg.Key, msg.Payload)
+
+	return err
+}
+
+// GetPendingOutboxMessages returns up to limit messages which are due to be sent, in the order they were written.
+// Messages behind a message with the same key which waits for its next attempt are left out.
+func (r *PgRepository) GetPendingOutboxMessages(ctx context.Context, tx pgx.Tx, limit int) ([]*outbox.Message, error) {
+	var messages []*outbox.Message
+	err := pgxscan.Select(ctx, tx, &messages, `
+		SELECT id, key, payload, created_at, sent_at, attempts, next_attempt_at, dead_at FROM outbox o
+		WHERE sent_at IS NULL AND dead_at IS NULL AND next_attempt_at <= NOW()
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox w
+				WHERE w.key = o.key AND w.id < o.id AND w.sent_at IS NULL AND w.dead_at IS NULL AND w.next_attempt_at > NOW()
+			)
+		ORDER BY id LIMIT $1`,
+		limit)
+
+	return messages, err
+}
+
+func (r *PgRepository) MarkOutboxMessageSent(ctx context.Context, tx pgx.Tx, id uint64) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE outbox SET sent_at = NOW() WHERE id = $1",
+		id)
+
+	return err
+}
+
+// MarkOutboxMessageFailed counts a failed send attempt and postpones the next one by retryIn.
+func (r *PgRepository) MarkOutboxMessageFailed(ctx context.Context, tx pgx.Tx, id uint64, retryIn time.Duration) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE outbox SET attempts = attempts + 1, next_attempt_at = NOW() + $2::interval WHERE id = $1",
+		id, retryIn)
+
+	return err
+}
+
+// MarkOutboxMessageDead counts a failed send attempt and parks the message so it is never sent.
+func (r *PgRepository) MarkOutboxMessageDead(ctx context.Context, tx pgx.Tx, id uint64) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE outbox SET attempts = attempts + 1, dead_at = NOW() WHERE id = $1",
+		id)
+
+	return err
+}
+
+func (s *storageFacade) addEvents(ctx context.Context, tx pgx.Tx, events []order.Event) error {
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		msg := &outbox.Message{
+			Key:     e.OrderID.String(),
+			Payload: payload,
+		}
+		if err = s.pgRepository.AddOutboxMessage(ctx, tx, msg); err != nil {
+			return err
+		}
+		if err = s.pgRepository.AddNotification(ctx, tx, e.OrderID, e.Operation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writtenEvents drops the events of the orders which weren't written.
+func writtenEvents(events []order.Event, written map[basetypes.ID]bool) []order.Event {
+	kept := make([]order.Event, 0, len(events))
+	for _, e := range events {
+		if written[e.OrderID] {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+func (s *storageFacade) GetPendingMessages(ctx context.Context, limit int) (messages []*outbox.Message, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		messages, err = s.pgRepository.GetPendingOutboxMessages(ctx, tx, limit)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) MarkSent(ctx context.Context, id uint64) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.MarkOutboxMessageSent(ctx, tx, id)
+	})
+}
+
+func (s *storageFacade) MarkFailed(ctx context.Context, id uint64, retryIn time.Duration) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.MarkOutboxMessageFailed(ctx, tx, id, retryIn)
+	})
+}
+
+func (s *storageFacade) MarkDead(ctx context.Context, id uint64) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.MarkOutboxMessageDead(ctx, tx, id)
+	})
+}
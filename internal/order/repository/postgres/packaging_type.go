@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	errors "github.com/vlad1028/order-manager/internal/order"
+)
+
+const selectPackagingTypes = "SELECT name, weight_limit, cost, can_wrap, wraps, max_length, max_width, max_height FROM packaging_types "
+
+func (r *PgRepository) GetPackagingType(ctx context.Context, tx pgx.Tx, name string) (*order.PackagingType, error) {
+	var t order.PackagingType
+	err := pgxscan.Get(ctx, tx, &t, selectPackagingTypes+"WHERE name = $1", name)
+
+	if pgxscan.NotFound(err) {
+		return nil, errors.ErrPackagingNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *PgRepository) ListPackagingTypes(ctx context.Context, tx pgx.Tx) ([]*order.PackagingType, error) {
+	var types []*order.PackagingType
+	err := pgxscan.Select(ctx, tx, &types, selectPackagingTypes+"ORDER BY name")
+
+	return types, err
+}
+
+func (r *PgRepository) AddOrUpdatePackagingType(ctx context.Context, tx pgx.Tx, t *order.PackagingType) (exists bool, err error) {
+	wraps := t.Wraps
+	if wraps == nil {
+		wraps = []string{}
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO packaging_types (name, weight_limit, cost, can_wrap, wraps, max_length, max_width, max_height)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (name)
+		DO UPDATE SET
+			weight_limit = excluded.weight_limit,
+			cost = excluded.cost,
+			can_wrap = excluded.can_wrap,
+			wraps = excluded.wraps,
+			max_length = excluded.max_length,
+			max_width = excluded.max_width,
+			max_height = excluded.max_height
+		RETURNING (xmax != 0) AS exists`,
+		t.Name, t.WeightLimit, t.Cost, t.CanWrap, wraps, t.MaxLength, t.MaxWidth, t.MaxHeight,
+	).Scan(&exists)
+
+	return exists, err
+}
+
+func (r *PgRepository) DeletePackagingType(ctx context.Context, tx pgx.Tx, name string) error {
+	result, err := tx.Exec(ctx, "DELETE FROM packaging_types WHERE name = $1", name)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.ErrPackagingNotFound
+	}
+
+	return nil
+}
+
+func (s *storageFacade) GetPackagingType(ctx context.Context, name string) (t *order.PackagingType, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		t, err = s.pgRepository.GetPackagingType(ctx, tx, name)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) ListPackagingTypes(ctx context.Context) (types []*order.PackagingType, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		types, err = s.pgRepository.ListPackagingTypes(ctx, tx)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) AddOrUpdatePackagingType(ctx context.Context, t *order.PackagingType) (exists bool, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		exists, err = s.pgRepository.AddOrUpdatePackagingType(ctx, tx, t)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) DeletePackagingType(ctx context.Context, name string) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.DeletePackagingType(ctx, tx, name)
+	})
+}
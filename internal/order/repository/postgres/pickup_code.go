@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"time"
+)
+
+var _ verification.Storage = (*storageFacade)(nil)
+
+const pickupCodeQuery = "SELECT client_id, pickup_point_id, code_hash, salt, attempts, locked_until FROM pickup_codes WHERE client_id = $1 AND pickup_point_id = $2"
+
+func (r *PgRepository) GetPickupCode(ctx context.Context, tx pgx.Tx, clientID, pickupPointID basetypes.ID) (*verification.PickupCode, error) {
+	return r.getPickupCode(ctx, tx, pickupCodeQuery, clientID, pickupPointID)
+}
+
+// GetPickupCodeForUpdate works like GetPickupCode but locks the code until the end of tx.
+func (r *PgRepository) GetPickupCodeForUpdate(ctx context.Context, tx pgx.Tx, clientID, pickupPointID basetypes.ID) (*verification.PickupCode, error) {
+	return r.getPickupCode(ctx, tx, pickupCodeQuery+" FOR UPDATE", clientID, pickupPointID)
+}
+
+func (r *PgRepository) getPickupCode(ctx context.Context, tx pgx.Tx, query string, clientID, pickupPointID basetypes.ID) (*verification.PickupCode, error) {
+	var c verification.PickupCode
+	err := pgxscan.Get(ctx, tx, &c, query, clientID, pickupPointID)
+
+	if pgxscan.NotFound(err) {
+		return nil, verification.ErrPickupCodeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *PgRepository) SavePickupCode(ctx context.Context, tx pgx.Tx, c *verification.PickupCode) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO pickup_codes (client_id, pickup_point_id, code_hash, salt, attempts, locked_until, created_at)
+		VALUES ($1, $2, $3, $4, 0, NULL, NOW())
+		ON CONFLICT (client_id, pickup_point_id)
+		DO UPDATE SET
+			code_hash = excluded.code_hash,
+			salt = excluded.salt,
+			attempts = excluded.attempts,
+			locked_until = excluded.locked_until,
+			created_at = excluded.created_at`,
+		c.ClientID, c.PickupPointID, c.Hash, c.Salt)
+
+	return err
+}
+
+func (r *PgRepository) AddFailedAttempt(ctx context.Context, tx pgx.Tx, clientID, pickupPointID basetypes.ID) (attempts uint, err error) {
+	err = tx.QueryRow(ctx,
+		"UPDATE pickup_codes SET attempts = attempts + 1 WHERE client_id = $1 AND pickup_point_id = $2 RETURNING attempts",
+		clientID, pickupPointID,
+	).Scan(&attempts)
+
+	if err == pgx.ErrNoRows {
+		return 0, verification.ErrPickupCodeNotFound
+	}
+	return attempts, err
+}
+
+func (r *PgRepository) LockPickupCode(ctx context.Context, tx pgx.Tx, clientID, pickupPointID basetypes.ID, until time.Time) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE pickup_codes SET attempts = 0, locked_until = $3 WHERE client_id = $1 AND pickup_point_id = $2",
+		clientID, pickupPointID, until)
+
+	return err
+}
+
+func (r *PgRepository) DeletePickupCode(ctx context.Context, tx pgx.Tx, clientID, pickupPointID basetypes.ID) error {
+	result, err := tx.Exec(ctx,
+		"DELETE FROM pickup_codes WHERE client_id = $1 AND pickup_point_id = $2",
+		clientID, pickupPointID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return verification.ErrPickupCodeNotFound
+	}
+
+	return nil
+}
+
+func (s *storageFacade) GetPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) (c *verification.PickupCode, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		c, err = s.pgRepository.GetPickupCode(ctx, tx, clientID, pickupPointID)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) SavePickupCode(ctx context.Context, c *verification.PickupCode) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.SavePickupCode(ctx, tx, c)
+	})
+}
+
+func (s *storageFacade) AddFailedAttempt(ctx context.Context, clientID, pickupPointID basetypes.ID) (attempts uint, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		attempts, err = s.pgRepository.AddFailedAttempt(ctx, tx, clientID, pickupPointID)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) LockPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID, until time.Time) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.LockPickupCode(ctx, tx, clientID, pickupPointID, until)
+	})
+}
+
+func (s *storageFacade) DeletePickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.DeletePickupCode(ctx, tx, clientID, pickupPointID)
+	})
+}
+
+// txPickupCodes gives access to the pickup codes within tx and locks the codes it reads.
+type txPickupCodes struct {
+	pgRepository *PgRepository
+	tx           pgx.Tx
+}
+
+var _ verification.Storage = (*txPickupCodes)(nil)
+
+func (c *txPickupCodes) GetPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) (*verification.PickupCode, error) {
+	return c.pgRepository.GetPickupCodeForUpdate(ctx, c.tx, clientID, pickupPointID)
+}
+
+func (c *txPickupCodes) SavePickupCode(ctx context.Context, code *verification.PickupCode) error {
+	return c.pgRepository.SavePickupCode(ctx, c.tx, code)
+}
+
+func (c *txPickupCodes) AddFailedAttempt(ctx context.Context, clientID, pickupPointID basetypes.ID) (uint, error) {
+	return c.pgRepository.AddFailedAttempt(ctx, c.tx, clientID, pickupPointID)
+}
+
+func (c *txPickupCodes) LockPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID, until time.Time) error {
+	return c.pgRepository.LockPickupCode(ctx, c.tx, clientID, pickupPointID, until)
+}
+
+func (c *txPickupCodes) DeletePickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) error {
+	return c.pgRepository.DeletePickupCode(ctx, c.tx, clientID, pickupPointID)
+}
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/pickuppoint"
+	errors "github.com/vlad1028/order-manager/internal/order"
+)
+
+const selectPickupPoints = `
+	SELECT p.id, p.name, p.address, p.working_hours, p.max_orders, p.max_weight,
+		count(o.id) AS orders, coalesce(sum(o.weight), 0)::bigint AS weight
+	FROM pickup_points p
+	LEFT JOIN orders o ON o.pickup_point_id = p.id AND o.status IN ('stored', 'expired') AND o.deleted_at IS NULL
+`
+
+type pickupPointRow struct {
+	pickuppoint.PickupPoint
+	pickuppoint.Occupancy
+}
+
+func (r pickupPointRow) toModel() *pickuppoint.PickupPoint {
+	p := r.PickupPoint
+	p.Occupancy = r.Occupancy
+	return &p
+}
+
+func (r *PgRepository) GetPickupPoint(ctx context.Context, tx pgx.Tx, id basetypes.ID) (*pickuppoint.PickupPoint, error) {
+	var row pickupPointRow
+	err := pgxscan.Get(ctx, tx, &row,
+		selectPickupPoints+"WHERE p.id = $1 GROUP BY p.id",
+		id)
+
+	if pgxscan.NotFound(err) {
+		return nil, errors.ErrPickupPointNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toModel(), nil
+}
+
+func (r *PgRepository) PickupPointExists(ctx context.Context, tx pgx.Tx, id basetypes.ID) (exists bool, err error) {
+	err = tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pickup_points WHERE id = $1)", id).Scan(&exists)
+	return
+}
+
+func (r *PgRepository) ListPickupPoints(ctx context.Context, tx pgx.Tx) ([]*pickuppoint.PickupPoint, error) {
+	var rows []pickupPointRow
+	err := pgxscan.Select(ctx, tx, &rows,
+		selectPickupPoints+"GROUP BY p.id ORDER BY p.id")
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*pickuppoint.PickupPoint, len(rows))
+	for i, row := range rows {
+		points[i] = row.toModel()
+	}
+	return points, nil
+}
+
+func (r *PgRepository) CreatePickupPoint(ctx context.Context, tx pgx.Tx, p *pickuppoint.PickupPoint) error {
+	result, err := tx.Exec(ctx, `
+		INSERT INTO pickup_points (id, name, address, working_hours, max_orders, max_weight)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO NOTHING`,
+		p.ID, p.Name, p.Address, p.WorkingHours, p.MaxOrders, p.MaxWeight)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.ErrPickupPointExists
+	}
+
+	return nil
+}
+
+func (r *PgRepository) UpdatePickupPoint(ctx context.Context, tx pgx.Tx, p *pickuppoint.PickupPoint) error {
+	result, err := tx.Exec(ctx, `
+		UPDATE pickup_points
+		SET name = $2, address = $3, working_hours = $4, max_orders = $5, max_weight = $6
+		WHERE id = $1`,
+		p.ID, p.Name, p.Address, p.WorkingHours, p.MaxOrders, p.MaxWeight)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.ErrPickupPointNotFound
+	}
+
+	return nil
+}
+
+// LockPickupPoint locks the pickup point row until the end of tx so that capacity checks don't race.
+func (r *PgRepository) LockPickupPoint(ctx context.Context, tx pgx.Tx, id basetypes.ID) (*pickuppoint.PickupPoint, error) {
+	var p pickuppoint.PickupPoint
+	err := pgxscan.Get(ctx, tx, &p,
+		"SELECT id, name, address, working_hours, max_orders, max_weight FROM pickup_points WHERE id = $1 FOR UPDATE",
+		id)
+
+	if pgxscan.NotFound(err) {
+		return nil, errors.ErrPickupPointNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// GetOccupancy returns the occupancy of the pickup point not counting the given order.
+func (r *PgRepository) GetOccupancy(ctx context.Context, tx pgx.Tx, id basetypes.ID, except basetypes.ID) (*pickuppoint.Occupancy, error) {
+	var occupancy pickuppoint.Occupancy
+	err := pgxscan.Get(ctx, tx, &occupancy,
+		"SELECT count(*) AS orders, coalesce(sum(weight), 0)::bigint AS weight FROM orders WHERE pickup_point_id = $1 AND status IN ('stored', 'expired') AND deleted_at IS NULL AND id <> $2",
+		id, except)
+
+	return &occupancy, err
+}
+
+func (s *storageFacade) GetPickupPoint(ctx context.Context, id basetypes.ID) (p *pickuppoint.PickupPoint, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		p, err = s.pgRepository.GetPickupPoint(ctx, tx, id)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) PickupPointExists(ctx context.Context, id basetypes.ID) (exists bool, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		exists, err = s.pgRepository.PickupPointExists(ctx, tx, id)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) ListPickupPoints(ctx context.Context) (points []*pickuppoint.PickupPoint, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		points, err = s.pgRepository.ListPickupPoints(ctx, tx)
+		return err
+	})
+	return
+}
+
+func (s *storageFacade) CreatePickupPoint(ctx context.Context, p *pickuppoint.PickupPoint) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.CreatePickupPoint(ctx, tx, p)
+	})
+}
+
+func (s *storageFacade) UpdatePickupPoint(ctx context.Context, p *pickuppoint.PickupPoint) error {
+	return s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		return s.pgRepository.UpdatePickupPoint(ctx, tx, p)
+	})
+}
+
+func (s *storageFacade) AddOrUpdateWithinCapacity(ctx context.Context, o *order.Order, events ...order.Event) (exists bool, err error) {
+	err = s.txManager.Run(ctx, func(tx pgx.Tx) error {
+		p, err := s.pgRepository.LockPickupPoint(ctx, tx, o.PickupPointID)
+		if err != nil {
+			return err
+		}
+
+		// an order accepted again is reported as existing even if the pickup point is full
+		if o.Version == 0 {
+			exists, err = s.pgRepository.Exists(ctx, tx, o.ID)
+			if err != nil || exists {
+				return err
+			}
+		}
+
+		occupancy, err := s.pgRepository.GetOccupancy(ctx, tx, o.PickupPointID, o.ID)
+		if err != nil {
+			return err
+		}
+
+		if !p.CanStore(*occupancy, o.Weight) {
+			return errors.ErrPickupPointFull
+		}
+
+		var written bool
+		exists, written, err = s.pgRepository.AddOrUpdate(ctx, tx, o)
+		if err != nil {
+			return err
+		}
+		if !written {
+			return nil
+		}
+		if err = s.pgRepository.AddStatusChange(ctx, tx, o); err != nil {
+			return err
+		}
+		return s.addEvents(ctx, tx, events)
+	})
+	return
+}
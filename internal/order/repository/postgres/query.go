@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"strings"
+)
+
+// sortColumns maps the sort fields to the columns of the orders table.
+var sortColumns = map[order.SortField]string{
+	order.SortByStatusUpdated: "status_updated",
+	order.SortByWeight:        "weight",
+	order.SortByCost:          "cost",
+	order.SortByID:            "id",
+}
+
+// ordersQuery builds a query over the orders with numbered arguments.
+// It only sees orders which aren't deleted.
+type ordersQuery struct {
+	conditions []string
+	orderBy    []string
+	limit      string
+	args       []interface{}
+	archived   bool // whether to select from orders_archive too
+}
+
+// arg adds the argument and returns its placeholder.
+func (q *ordersQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// where adds the condition. Every %s in format is replaced with a placeholder of the next argument.
+func (q *ordersQuery) where(format string, args ...interface{}) {
+	placeholders := make([]interface{}, len(args))
+	for i, a := range args {
+		placeholders[i] = q.arg(a)
+	}
+	q.conditions = append(q.conditions, fmt.Sprintf(format, placeholders...))
+}
+
+// filter adds the conditions of the set criteria of f.
+func (q *ordersQuery) filter(f *order.Filter) {
+	q.archived = f.IncludeArchived
+
+	if f.ID != nil {
+		q.where("id = %s", *f.ID)
+	}
+	if f.ClientID != nil {
+		q.where("client_id = %s", *f.ClientID)
+	}
+	if f.PickUpPointID != nil {
+		q.where("pickup_point_id = %s", *f.PickUpPointID)
+	}
+	if f.Status != nil {
+		q.where("status = %s", *f.Status)
+	}
+	if len(f.Statuses) > 0 {
+		q.where("status = ANY(%s)", f.Statuses)
+	}
+
+	if f.StatusUpdated.From != nil {
+		q.where("status_updated >= %s", *f.StatusUpdated.From)
+	}
+	if f.StatusUpdated.To != nil {
+		q.where("status_updated < %s", *f.StatusUpdated.To)
+	}
+	q.uintRange("weight", f.Weight)
+	q.uintRange("cost", f.Cost)
+}
+
+func (q *ordersQuery) uintRange(column string, r order.UintRange) {
+	if r.Min != nil {
+		q.where(column+" >= %s", *r.Min)
+	}
+	if r.Max != nil {
+		q.where(column+" <= %s", *r.Max)
+	}
+}
+
+// sort orders the rows by keys, which must end with a unique key, and leaves out the rows up to after.
+func (q *ordersQuery) sort(keys []order.Sort, after *order.Cursor) {
+	for _, k := range keys {
+		column := sortColumns[k.Field]
+		if k.Desc {
+			column += " DESC"
+		}
+		q.orderBy = append(q.orderBy, column)
+	}
+
+	if after != nil {
+		q.after(keys, after)
+	}
+}
+
+// after adds the keyset condition for the rows following the cursor:
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ..., with < for descending keys.
+func (q *ordersQuery) after(keys []order.Sort, c *order.Cursor) {
+	var alternatives []string
+	var equal []string
+
+	for i, k := range keys {
+		column := sortColumns[k.Field]
+		value := q.arg(c.Value(k.Field))
+
+		op := ">"
+		if k.Desc {
+			op = "<"
+		}
+		alternatives = append(alternatives, strings.Join(append(equal, column+" "+op+" "+value), " AND "))
+		equal = append(equal, column+" = "+value)
+
+		if i == 0 && k.Field == order.SortByStatusUpdated {
+			// the planner starts the scan of the status_updated index by plain comparisons only
+			q.conditions = append(q.conditions, column+" "+op+"= "+value)
+		}
+	}
+
+	q.conditions = append(q.conditions, "("+strings.Join(alternatives, " OR ")+")")
+}
+
+// limitTo limits the number of rows. A non-positive limit leaves it unlimited.
+func (q *ordersQuery) limitTo(limit int) {
+	if limit > 0 {
+		q.limit = " LIMIT " + q.arg(limit)
+	}
+}
+
+// whereClause returns the WHERE clause of the conditions, which also leaves out deleted orders.
+func (q *ordersQuery) whereClause() string {
+	return " WHERE " + strings.Join(append([]string{"deleted_at IS NULL"}, q.conditions...), " AND ")
+}
+
+// selectRows returns the query selecting the columns and its arguments.
+func (q *ordersQuery) selectRows(columns string) (string, []interface{}) {
+	query := "SELECT " + columns + " FROM orders" + q.whereClause()
+	if q.archived {
+		query += " UNION ALL SELECT " + columns + " FROM orders_archive" + q.whereClause()
+	}
+
+	if len(q.orderBy) > 0 {
+		query += " ORDER BY " + strings.Join(q.orderBy, ", ")
+	}
+
+	return query + q.limit, q.args
+}
+
+// softDelete returns the query marking the orders as deleted and returning them, and its arguments.
+// Archived orders are left as they are.
+func (q *ordersQuery) softDelete() (string, []interface{}) {
+	return "UPDATE orders SET deleted_at = NOW()" + q.whereClause() + " RETURNING " + orderColumns, q.args
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+)
+
+func TestOrdersQuery_selectRows(t *testing.T) {
+	clientID := basetypes.ID(7)
+	minWeight := uint(20)
+	updated := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		filter    *order.Filter
+		sort      []order.Sort
+		after     *order.Cursor
+		limit     int
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name:      "All",
+			filter:    &order.Filter{},
+			limit:     -1,
+			wantQuery: "SELECT id FROM orders WHERE deleted_at IS NULL ORDER BY status_updated, id",
+		},
+		{
+			name: "FilteredPage",
+			filter: &order.Filter{
+				ClientID: &clientID,
+				Statuses: []order.Status{order.Stored, order.Expired},
+				Weight:   order.UintRange{Min: &minWeight},
+			},
+			after: &order.Cursor{StatusUpdated: updated, ID: 3},
+			limit: 10,
+			wantQuery: "SELECT id FROM orders WHERE deleted_at IS NULL AND client_id = $1 AND status = ANY($2) AND weight >= $3" +
+				" AND status_updated >= $4 AND (status_updated > $4 OR status_updated = $4 AND id > $5)" +
+				" ORDER BY status_updated, id LIMIT $6",
+			wantArgs: []interface{}{clientID, []order.Status{order.Stored, order.Expired}, minWeight, updated, basetypes.ID(3), 10},
+		},
+		{
+			name:   "SortedWithArchive",
+			filter: &order.Filter{IncludeArchived: true},
+			sort:   []order.Sort{{Field: order.SortByCost, Desc: true}},
+			after:  &order.Cursor{Cost: 100, ID: 3},
+			limit:  10,
+			wantQuery: "SELECT id FROM orders WHERE deleted_at IS NULL AND (cost < $1 OR cost = $1 AND id > $2)" +
+				" UNION ALL SELECT id FROM orders_archive WHERE deleted_at IS NULL AND (cost < $1 OR cost = $1 AND id > $2)" +
+				" ORDER BY cost DESC, id LIMIT $3",
+			wantArgs: []interface{}{uint(100), basetypes.ID(3), 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := order.SortKeys(tt.sort)
+			assert.NoError(t, err)
+
+			q := &ordersQuery{}
+			q.filter(tt.filter)
+			q.sort(keys, tt.after)
+			q.limitTo(tt.limit)
+			query, args := q.selectRows("id")
+
+			assert.Equal(t, tt.wantQuery, query)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
@@ -2,16 +2,16 @@ package postgres
 
 import (
 	"context"
-	"fmt"
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgx/v4"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	errors "github.com/vlad1028/order-manager/internal/order"
-	"reflect"
-	"strings"
+	"time"
 )
 
+const orderColumns = "id, client_id, pickup_point_id, status, status_updated, weight, length, width, height, cost, base_cost, expires_at, packaging, version"
+
 type PgRepository struct {
 }
 
@@ -22,9 +22,12 @@ func NewPgRepository() *PgRepository {
 func (r *PgRepository) Get(ctx context.Context, tx pgx.Tx, id basetypes.ID) (*order.Order, error) {
 	var o order.Order
 	err := pgxscan.Get(ctx, tx, &o,
-		"SELECT (id, client_id, pickup_point_id, status, status_updated, weight, cost) FROM orders WHERE id = $1",
+		"SELECT "+orderColumns+" FROM orders WHERE id = $1 AND deleted_at IS NULL",
 		id)
 
+	if pgxscan.NotFound(err) {
+		return nil, errors.ErrOrderNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -32,91 +35,184 @@ func (r *PgRepository) Get(ctx context.Context, tx pgx.Tx, id basetypes.ID) (*or
 	return &o, err
 }
 
-func (r *PgRepository) Delete(ctx context.Context, tx pgx.Tx, id basetypes.ID) error {
-	result, err := tx.Exec(ctx,
-		"DELETE FROM orders WHERE id = $1",
+// GetListForUpdate returns the orders with the given IDs and locks them until the end of tx.
+func (r *PgRepository) GetListForUpdate(ctx context.Context, tx pgx.Tx, ids []basetypes.ID) ([]*order.Order, error) {
+	var orders []*order.Order
+	err := pgxscan.Select(ctx, tx, &orders,
+		"SELECT "+orderColumns+" FROM orders WHERE id = ANY($1) AND deleted_at IS NULL FOR UPDATE",
+		ids)
+
+	return orders, err
+}
+
+// Delete marks the order as deleted and returns it. Deleted orders are left out of all queries and are archived eventually.
+func (r *PgRepository) Delete(ctx context.Context, tx pgx.Tx, id basetypes.ID) (*order.Order, error) {
+	var o order.Order
+	err := pgxscan.Get(ctx, tx, &o,
+		"UPDATE orders SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL RETURNING "+orderColumns,
 		id)
+
+	if pgxscan.NotFound(err) {
+		return nil, errors.ErrOrderNotFound
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if result.RowsAffected() == 0 {
-		return errors.ErrOrderNotFound
-	}
+	return &o, nil
+}
 
-	return nil
+// Exists reports whether the order ID is taken, even by an order which is deleted but not yet archived.
+func (r *PgRepository) Exists(ctx context.Context, tx pgx.Tx, id basetypes.ID) (exists bool, err error) {
+	err = tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM order_ids WHERE id = $1)", id).Scan(&exists)
+	return
 }
 
-func (r *PgRepository) AddOrUpdate(ctx context.Context, tx pgx.Tx, o *order.Order) (exists bool, err error) {
-	query := `
-        INSERT INTO orders (id, client_id, pickup_point_id, status, weight, cost, status_updated)
-		VALUES ($1, $2, $3, $4, $5, $6, NOW())
-		ON CONFLICT (id)
-		DO UPDATE SET 
-			status = excluded.cost,
-			status_updated = excluded.status_updated
-    RETURNING (xmax != 0) AS exists;
-    `
-
-	err = tx.QueryRow(
-		ctx, query,
-		o.ID, o.ClientID, o.PickupPointID, o.Status, o.Weight, o.Cost,
-	).Scan(&exists)
+// AddOrUpdate inserts the order if it has no version yet or updates it like Update otherwise
+// and reports whether a row was written. A written order gets the version and the status update time
+// it was stored with.
+// A new order whose ID is taken in order_ids, even by an order which is deleted but not yet archived,
+// is reported as existing and is left as it is. A concurrent insert of the same ID waits for tx.
+func (r *PgRepository) AddOrUpdate(ctx context.Context, tx pgx.Tx, o *order.Order) (exists, written bool, err error) {
+	if o.Version > 0 {
+		if err = r.Update(ctx, tx, o); err != nil {
+			return true, false, err
+		}
+		return true, true, nil
+	}
 
-	return exists, err
-}
+	packaging := o.Packaging
+	if packaging == nil {
+		packaging = []order.PackagingLayer{}
+	}
 
-func (r *PgRepository) GetBy(ctx context.Context, tx pgx.Tx, filter *order.Filter) ([]*order.Order, error) {
-	return r.GetByPaginated(ctx, tx, filter, 0, -1)
+	err = tx.QueryRow(ctx, `
+		WITH registered AS (
+			INSERT INTO order_ids (id) VALUES ($1) ON CONFLICT DO NOTHING RETURNING created_at
+		)
+		INSERT INTO orders (id, client_id, pickup_point_id, status, weight, length, width, height, cost, base_cost, expires_at, packaging, status_updated, version, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), 1, created_at FROM registered
+		RETURNING version, status_updated`,
+		o.ID, o.ClientID, o.PickupPointID, o.Status, o.Weight, o.Length, o.Width, o.Height, o.Cost, o.BaseCost, o.ExpiresAt, packaging,
+	).Scan(&o.Version, &o.StatusUpdated)
+	if err == pgx.ErrNoRows {
+		return true, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return false, true, nil
 }
 
-func (r *PgRepository) GetByPaginated(ctx context.Context, tx pgx.Tx, filter *order.Filter, offset uint, limit int) ([]*order.Order, error) {
-	var orders []*order.Order
-	query, args := buildFilterQueryPaginated(filter, "SELECT (id, client_id, pickup_point_id, status, status_updated, weight, cost)", offset, limit)
-	err := pgxscan.Select(ctx, tx, &orders, query, args...)
+// Update stores the status and the storage deadline of the order if it still has the version o.Version
+// and sets o.Version and o.StatusUpdated to the stored ones. It fails with ErrConcurrentModification
+// if the order was changed since it was read and with ErrOrderNotFound if it is missing or deleted.
+func (r *PgRepository) Update(ctx context.Context, tx pgx.Tx, o *order.Order) error {
+	err := tx.QueryRow(ctx, `
+		UPDATE orders SET status = $2, expires_at = $3, status_updated = NOW(), version = version + 1
+		WHERE id = $1 AND version = $4 AND deleted_at IS NULL
+		RETURNING version, status_updated`,
+		o.ID, o.Status, o.ExpiresAt, o.Version,
+	).Scan(&o.Version, &o.StatusUpdated)
+	if err != pgx.ErrNoRows {
+		return err
+	}
 
-	return orders, err
+	var exists bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM orders WHERE id = $1 AND deleted_at IS NULL)",
+		o.ID,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return errors.ErrConcurrentModification
+	}
+	return errors.ErrOrderNotFound
 }
 
-func (r *PgRepository) DeleteBy(ctx context.Context, tx pgx.Tx, filter *order.Filter) error {
-	query, args := buildFilterQuery(filter, "DELETE")
-	_, err := tx.Exec(ctx, query, args...)
+func (r *PgRepository) AddStatusChange(ctx context.Context, tx pgx.Tx, o *order.Order) error {
+	_, err := tx.Exec(ctx,
+		"INSERT INTO order_status_history (order_id, status, pickup_point_id, changed_at) VALUES ($1, $2, $3, NOW())",
+		o.ID, o.Status, o.PickupPointID)
 
 	return err
 }
 
-func buildFilterQuery(filter *order.Filter, operation string) (string, []interface{}) {
-	return buildFilterQueryPaginated(filter, operation, 0, -1)
+// GetHistory returns the status changes of the order, which may have none, and fails with ErrOrderNotFound
+// if the order is neither stored nor archived.
+func (r *PgRepository) GetHistory(ctx context.Context, tx pgx.Tx, id basetypes.ID) ([]*order.StatusChange, error) {
+	var history []*order.StatusChange
+	err := pgxscan.Select(ctx, tx, &history,
+		"SELECT order_id, status, pickup_point_id, changed_at FROM order_status_history WHERE order_id = $1 ORDER BY changed_at, id",
+		id)
+	if err != nil || len(history) != 0 {
+		return history, err
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM orders WHERE id = $1 AND deleted_at IS NULL)
+			OR EXISTS (SELECT 1 FROM orders_archive WHERE id = $1 AND deleted_at IS NULL)`,
+		id,
+	).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.ErrOrderNotFound
+	}
+	return history, nil
+}
+
+func (r *PgRepository) GetBy(ctx context.Context, tx pgx.Tx, filter *order.Filter) ([]*order.Order, error) {
+	return r.GetPage(ctx, tx, filter, nil, nil, -1)
 }
 
-func buildFilterQueryPaginated(filter *order.Filter, operation string, offset uint, limit int) (string, []interface{}) {
-	query := operation + " FROM orders WHERE"
-	var conditions []string
-	var args []interface{}
+// GetPage returns up to limit orders matching filter which follow the cursor after, sorted by the keys.
+// The keys are completed with order.SortKeys. A nil cursor starts from the first order
+// and a non-positive limit returns all of them.
+func (r *PgRepository) GetPage(ctx context.Context, tx pgx.Tx, filter *order.Filter, sort []order.Sort, after *order.Cursor, limit int) ([]*order.Order, error) {
+	keys, err := order.SortKeys(sort)
+	if err != nil {
+		return nil, err
+	}
 
-	v := reflect.ValueOf(filter).Elem()
-	t := v.Type()
+	q := &ordersQuery{}
+	q.filter(filter)
+	q.sort(keys, after)
+	q.limitTo(limit)
+	query, args := q.selectRows(orderColumns)
 
-	for i := 0; i < v.NumField(); i++ {
-		fieldValue := v.Field(i)
-		fieldType := t.Field(i)
-		columnName := fieldType.Tag.Get("db")
+	var orders []*order.Order
+	err = pgxscan.Select(ctx, tx, &orders, query, args...)
 
-		if !fieldValue.IsNil() {
-			conditions = append(conditions, fmt.Sprintf("%s = $%d", columnName, len(args)+1))
-			args = append(args, fieldValue.Interface())
-		}
-	}
+	return orders, err
+}
 
-	if len(conditions) > 0 {
-		query += " " + strings.Join(conditions, " AND ")
-	} else {
-		query += " TRUE" // no filters
-	}
+// GetExpiredIDs returns IDs of stored orders whose storage deadline is before now, the oldest deadlines first.
+func (r *PgRepository) GetExpiredIDs(ctx context.Context, tx pgx.Tx, timeToStore time.Duration, now time.Time, limit int) ([]basetypes.ID, error) {
+	var ids []basetypes.ID
+	err := pgxscan.Select(ctx, tx, &ids, `
+		SELECT id FROM (
+			SELECT id, COALESCE(expires_at, status_updated + $2::interval) AS deadline FROM orders WHERE status = $1 AND deleted_at IS NULL
+		) stored
+		WHERE deadline <= $3
+		ORDER BY deadline LIMIT $4`,
+		order.Stored, timeToStore, now, limit)
+
+	return ids, err
+}
 
-	if limit > 0 {
-		query += fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
-	}
+// DeleteBy marks the orders matching filter as deleted like Delete and returns them.
+func (r *PgRepository) DeleteBy(ctx context.Context, tx pgx.Tx, filter *order.Filter) ([]*order.Order, error) {
+	q := &ordersQuery{}
+	q.filter(filter)
+	query, args := q.softDelete()
+	var deleted []*order.Order
+	err := pgxscan.Select(ctx, tx, &deleted, query, args...)
 
-	return query, args
+	return deleted, err
 }
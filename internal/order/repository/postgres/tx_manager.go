@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	errors "github.com/vlad1028/order-manager/internal/order"
 )
 
 type TxManager struct {
@@ -15,12 +19,30 @@ func NewTxManager(pool *pgxpool.Pool) *TxManager {
 	return &TxManager{pool: pool}
 }
 
-func (m *TxManager) RunSerializable(ctx context.Context, fn func(tx pgx.Tx) error) error {
+// serializableAttempts is the number of times RunSerializable runs a transaction that fails to serialize.
+const serializableAttempts = 3
+
+// serializationFailure is the SQLSTATE of transactions conflicting with concurrent serializable ones.
+const serializationFailure = "40001"
+
+// RunSerializable runs fn in a serializable transaction and retries it if it conflicts with concurrent ones,
+// so fn may be called several times. If all attempts conflict, it fails with ErrConcurrentModification.
+func (m *TxManager) RunSerializable(ctx context.Context, fn func(tx pgx.Tx) error) (err error) {
 	opts := pgx.TxOptions{
 		IsoLevel:   pgx.Serializable,
 		AccessMode: pgx.ReadWrite,
 	}
-	return m.pool.BeginTxFunc(ctx, opts, fn)
+	for attempt := 0; attempt < serializableAttempts; attempt++ {
+		if err = m.pool.BeginTxFunc(ctx, opts, fn); !isSerializationFailure(err) {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: %w", errors.ErrConcurrentModification, err)
+}
+
+func isSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	return stderrors.As(err, &pgErr) && pgErr.Code == serializationFailure
 }
 
 func (m *TxManager) RunRepeatableRead(ctx context.Context, fn func(tx pgx.Tx) error) error {
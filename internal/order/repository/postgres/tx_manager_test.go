@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSerializationFailure(t *testing.T) {
+	assert.True(t, isSerializationFailure(&pgconn.PgError{Code: "40001"}))
+	assert.True(t, isSerializationFailure(fmt.Errorf("commit: %w", &pgconn.PgError{Code: "40001"})))
+	assert.False(t, isSerializationFailure(&pgconn.PgError{Code: "23505"}))
+	assert.False(t, isSerializationFailure(errors.New("connection refused")))
+	assert.False(t, isSerializationFailure(nil))
+}
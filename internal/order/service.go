@@ -2,8 +2,11 @@ package order
 
 import (
 	"context"
+	"time"
+
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/pickuppoint"
 )
 
 type Service interface {
@@ -13,16 +16,39 @@ type Service interface {
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
 	AcceptReturn(context.Context, *AcceptReturnRequest) (*AcceptReturnResponse, error)
 	GetReturned(context.Context, *GetReturnedRequest) (*GetReturnedResponse, error)
+	SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*CreatePickupPointResponse, error)
+	UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error)
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error)
+	SavePackagingType(context.Context, *SavePackagingTypeRequest) (*SavePackagingTypeResponse, error)
+	DeletePackagingType(context.Context, *DeletePackagingTypeRequest) (*DeletePackagingTypeResponse, error)
+	ListPackagingTypes(context.Context, *ListPackagingTypesRequest) (*ListPackagingTypesResponse, error)
+	SuggestPackaging(context.Context, *SuggestPackagingRequest) (*SuggestPackagingResponse, error)
+	GetCourierManifest(context.Context, *GetCourierManifestRequest) (*GetCourierManifestResponse, error)
+	CourierHandover(context.Context, *CourierHandoverRequest) (*CourierHandoverResponse, error)
 }
 
+// IssueMode defines what happens to a batch some orders of which can't be issued.
+type IssueMode int
+
+const (
+	IssueAllOrNothing IssueMode = iota // no order is issued, ErrIssueRejected is returned
+	IssueBestEffort                    // the rest of the orders are issued
+)
+
 type (
 	AcceptOrderRequest struct {
-		ID        basetypes.ID
-		ClientID  basetypes.ID
-		Weight    uint
-		Cost      uint
-		Packaging order.Packaging
-		AddFilm   bool
+		ID         basetypes.ID
+		ClientID   basetypes.ID
+		Weight     uint
+		Dimensions order.Dimensions
+		Cost       uint
+		Packaging  []string   // packaging types from the outermost layer to the innermost one
+		AddFilm    bool       // adds a film layer under the given ones
+		ExpiresAt  *time.Time // nil means the default storage period
+
+		PickupPointID basetypes.ID // 0 means the service default
 	}
 	AcceptOrderResponse struct {
 	}
@@ -30,6 +56,8 @@ type (
 	AcceptReturnRequest struct {
 		ClientID basetypes.ID
 		OrderID  basetypes.ID
+
+		PickupPointID basetypes.ID // 0 means the service default
 	}
 	AcceptReturnResponse struct {
 	}
@@ -43,23 +71,119 @@ type (
 	GetOrdersRequest struct {
 		ClientID  basetypes.ID
 		LocalOnly bool
+
+		PickupPointID basetypes.ID // 0 means the service default
+		PageToken     string       // next page token of the previous page, empty for the first page
+		PageSize      int          // 0 means all orders
 	}
 	GetOrdersResponse struct {
-		Orders []*order.Order
+		Orders        []*order.Order
+		NextPageToken string // empty on the last page
 	}
 
 	GetReturnedRequest struct {
-		Page    int
-		PerPage int
+		PageToken string // next page token of the previous page, empty for the first page
+		PerPage   int
 	}
 	GetReturnedResponse struct {
-		Orders []*order.Order
+		Orders        []*order.Order
+		NextPageToken string // empty on the last page
+	}
+
+	SearchOrdersRequest struct {
+		Filter    order.Filter
+		Sort      []order.Sort // the status update time and ID if empty
+		PageToken string       // next page token of the previous page, empty for the first page
+		PageSize  int          // 0 means all orders
+	}
+	SearchOrdersResponse struct {
+		Orders        []*order.Order
+		NextPageToken string // empty on the last page
 	}
 
 	IssueOrderRequest struct {
-		IDs []basetypes.ID
+		IDs      []basetypes.ID
+		ClientID basetypes.ID
+		Code     string // pickup code sent to the client
+		Mode     IssueMode
+
+		PickupPointID basetypes.ID // 0 means the service default
 	}
 	IssueOrderResponse struct {
-		Orders []*order.Order
+		Orders  []*order.Order      // issued orders
+		Results []order.IssueResult // one per distinct requested ID in the request order
+	}
+
+	GetOrderHistoryRequest struct {
+		OrderID basetypes.ID
+	}
+	GetOrderHistoryResponse struct {
+		History []*order.StatusChange
+	}
+
+	CreatePickupPointRequest struct {
+		PickupPoint *pickuppoint.PickupPoint
+	}
+	CreatePickupPointResponse struct {
+		PickupPoint *pickuppoint.PickupPoint
+	}
+
+	UpdatePickupPointRequest struct {
+		PickupPoint *pickuppoint.PickupPoint
+	}
+	UpdatePickupPointResponse struct {
+		PickupPoint *pickuppoint.PickupPoint
+	}
+
+	ListPickupPointsRequest struct {
+	}
+	ListPickupPointsResponse struct {
+		PickupPoints []*pickuppoint.PickupPoint
+	}
+
+	SavePackagingTypeRequest struct {
+		PackagingType *order.PackagingType
+	}
+	SavePackagingTypeResponse struct {
+		Created bool
+	}
+
+	DeletePackagingTypeRequest struct {
+		Name string
+	}
+	DeletePackagingTypeResponse struct {
+	}
+
+	ListPackagingTypesRequest struct {
+	}
+	ListPackagingTypesResponse struct {
+		PackagingTypes []*order.PackagingType
+	}
+
+	SuggestPackagingRequest struct {
+		Weight     uint
+		Dimensions order.Dimensions
+		Required   []string // packaging types the chain must contain
+	}
+	SuggestPackagingResponse struct {
+		Layers []*order.PackagingType // from the outermost layer to the innermost one
+		Cost   uint
+	}
+
+	GetCourierManifestRequest struct {
+		PickupPointID basetypes.ID // 0 means the service default
+	}
+	GetCourierManifestResponse struct {
+		Orders []*order.Order // returned and expired orders to hand over
+	}
+
+	CourierHandoverRequest struct {
+		CourierID basetypes.ID
+		OrderIDs  []basetypes.ID // empty means the whole manifest
+
+		PickupPointID basetypes.ID // 0 means the service default
+	}
+	CourierHandoverResponse struct {
+		Handover *order.Handover
 	}
 )
@@ -2,32 +2,46 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	orderServise "github.com/vlad1028/order-manager/internal/order"
+	"log"
+	"slices"
 	"time"
 )
 
 func (s *Service) AcceptOrder(ctx context.Context, req *orderServise.AcceptOrderRequest) (resp *orderServise.AcceptOrderResponse, err error) {
 	resp = &orderServise.AcceptOrderResponse{}
 
-	o := order.NewOrder(req.ID, req.ClientID, s.ID, req.Weight, req.Cost)
-
-	pack, err := newPackaging(req.Packaging, req.AddFilm)
+	ppID, err := s.pickupPoint(ctx, req.PickupPointID)
 	if err != nil {
 		return resp, err
 	}
 
-	if err = o.ApplyPackaging(pack); err != nil {
+	o := &order.Order{
+		ID:            req.ID,
+		ClientID:      req.ClientID,
+		PickupPointID: ppID,
+		Weight:        req.Weight,
+		Dimensions:    req.Dimensions,
+		Cost:          req.Cost,
+		BaseCost:      req.Cost,
+		ExpiresAt:     req.ExpiresAt,
+	}
+	if o.ExpiresAt != nil && !o.ExpiresAt.After(time.Now()) {
+		return resp, orderServise.ErrExpiresInPast
+	}
+	if err = s.states.Transition(o, order.Stored, s.newTransitionContext(ppID, req.ClientID)); err != nil {
 		return resp, err
 	}
 
-	exists, err := s.addOrUpdate(ctx, o)
+	pack, err := s.newPackaging(ctx, req.Packaging, req.AddFilm)
 	if err != nil {
 		return resp, err
 	}
 
-	if exists {
-		return resp, orderServise.ErrOrderExists
+	if err = o.ApplyPackaging(pack); err != nil {
+		return resp, err
 	}
 
 	event := order.Event{
@@ -35,16 +49,51 @@ func (s *Service) AcceptOrder(ctx context.Context, req *orderServise.AcceptOrder
 		Operation: "accept",
 		Timestamp: time.Now().UTC(),
 	}
-	s.sendEvent(event)
+	exists, err := s.addWithinCapacity(ctx, o, event)
+	if err != nil {
+		return resp, err
+	}
+
+	if exists {
+		return resp, orderServise.ErrOrderExists
+	}
+
+	// the order is stored anyway, the client gets a code with the next accepted or issued order
+	if err = s.codes.Issue(ctx, o.ClientID, ppID); err != nil {
+		log.Printf("failed to issue pickup code to client %d: %v", o.ClientID, err)
+	}
 
 	return resp, nil
 }
 
-func newPackaging(p order.Packaging, addFilm bool) (pack order.Packaging, err error) {
+// filmPackaging is the packaging type put under the given layers when AddFilm is requested.
+const filmPackaging = "film"
+
+// newPackaging builds the packaging chain where every layer wraps the next one.
+func (s *Service) newPackaging(ctx context.Context, layers []string, addFilm bool) (order.Packaging, error) {
 	if addFilm {
-		p, err = applyAdditionalPack(p, order.NewFilm())
+		if len(layers) == 0 {
+			return nil, orderServise.ErrNoPrimaryPack
+		}
+		layers = append(slices.Clone(layers), filmPackaging)
+	}
+
+	var pack order.Packaging
+	for i := len(layers) - 1; i >= 0; i-- {
+		p, err := s.packaging.New(ctx, layers[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if pack == nil {
+			pack = p
+			continue
+		}
+		if pack, err = applyAdditionalPack(p, pack); err != nil {
+			return nil, fmt.Errorf("%w: %s can't wrap %s", err, layers[i], layers[i+1])
+		}
 	}
-	return p, err
+	return pack, nil
 }
 
 func applyAdditionalPack(p1 order.Packaging, p2 order.Packaging) (order.Packaging, error) {
@@ -52,7 +101,7 @@ func applyAdditionalPack(p1 order.Packaging, p2 order.Packaging) (order.Packagin
 		return nil, orderServise.ErrNoPrimaryPack
 	}
 
-	if w, ok := p1.(order.Wrapper); !ok {
+	if w, ok := p1.(order.Wrapper); !ok || !w.CanWrap(p2) {
 		return nil, orderServise.ErrAdditionalPackNotAllowed
 	} else {
 		w.Wrap(p2)
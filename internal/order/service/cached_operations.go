@@ -2,21 +2,29 @@ package service
 
 import (
 	"context"
+	"errors"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	models "github.com/vlad1028/order-manager/internal/models/order"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
 	"log"
+	"slices"
 )
 
+// updateAttempts is the number of times an order update is tried before a concurrent modification is reported.
+const updateAttempts = 3
+
 func (s *Service) genCacheKey(orderID basetypes.ID) string {
 	return "order:" + orderID.String()
 }
 
 func (s *Service) getOrder(ctx context.Context, orderID basetypes.ID) (*models.Order, error) {
-	cachedOrder, found := s.cache.Get(ctx, s.genCacheKey(orderID))
-	if found {
-		return cachedOrder, nil
-	}
+	return s.cache.Load(ctx, s.genCacheKey(orderID), func(ctx context.Context) (*models.Order, error) {
+		return s.repo.Get(ctx, orderID)
+	})
+}
 
+// getStoredOrder reads the order from the repository bypassing the cache and caches it.
+func (s *Service) getStoredOrder(ctx context.Context, orderID basetypes.ID) (*models.Order, error) {
 	o, err := s.repo.Get(ctx, orderID)
 	if err != nil {
 		return nil, err
@@ -26,26 +34,134 @@ func (s *Service) getOrder(ctx context.Context, orderID basetypes.ID) (*models.O
 	return o, nil
 }
 
-func (s *Service) addOrUpdate(ctx context.Context, o *models.Order) (exists bool, err error) {
-	exists, err = s.repo.AddOrUpdate(ctx, o)
-	if err != nil {
+// updateOrder reads the order, lets change modify it and stores it together with the events change returns.
+// The order is stored only if nobody modified it since it was read. Otherwise it's read again from the repository,
+// as the cached copy may be stale, and change is applied to the fresh copy, up to updateAttempts times.
+// change must have no side effects besides modifying the order.
+func (s *Service) updateOrder(ctx context.Context, orderID basetypes.ID, change func(*models.Order) ([]models.Event, error)) error {
+	get := s.getOrder
+	for attempt := 1; ; attempt++ {
+		o, err := get(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		previous := o.Status
+		events, err := change(o)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.Update(ctx, o, events...)
+		if err == nil {
+			s.invalidateWrittenOrders(ctx, map[basetypes.ID]models.Status{o.ID: previous}, o)
+			return nil
+		}
+		if !errors.Is(err, orderServise.ErrConcurrentModification) {
+			return err
+		}
+
+		s.invalidateOrderCache(ctx, orderID)
+		if attempt == updateAttempts {
+			return err
+		}
+		get = s.getStoredOrder
+	}
+}
+
+func (s *Service) addWithinCapacity(ctx context.Context, o *models.Order, events ...models.Event) (exists bool, err error) {
+	exists, err = s.repo.AddOrUpdateWithinCapacity(ctx, o, events...)
+	if err != nil || exists {
 		return exists, err
 	}
 
-	s.setOrderCache(ctx, o)
-	return exists, nil
+	s.invalidateWrittenOrders(ctx, nil, o)
+	return false, nil
 }
 
-func (s *Service) addOrUpdateList(ctx context.Context, orders []*models.Order) error {
-	err := s.repo.AddOrUpdateList(ctx, orders)
-	if err != nil {
-		return err
+// invalidateWrittenOrders invalidates the cached copies of the orders after they were written to the repository
+// and the cached lists they may have entered or left. previous holds the statuses the orders
+// had before the write, new orders have none.
+// The copies are invalidated on all replicas and aren't replaced: the written structs only carry the columns
+// the write returned, so the reads following the write load the orders from the repository instead.
+func (s *Service) invalidateWrittenOrders(ctx context.Context, previous map[basetypes.ID]models.Status, orders ...*models.Order) {
+	ids := make([]basetypes.ID, 0, len(orders))
+	var tags []string
+	for _, o := range orders {
+		ids = append(ids, o.ID)
+		tags = append(tags, clientTag(o.ClientID), statusTag(o.Status), allOrdersTag)
+		if status, ok := previous[o.ID]; ok {
+			tags = append(tags, statusTag(status))
+		}
 	}
+	s.invalidateOrderCache(ctx, ids...)
 
-	for _, o := range orders {
-		s.setOrderCache(ctx, o)
+	slices.Sort(tags)
+	if err := s.queries.InvalidateTags(ctx, slices.Compact(tags)...); err != nil {
+		log.Printf("Failed to invalidate cached order lists: %v", err)
+	}
+}
+
+// InvalidateOrders drops the cached copies of the orders which were deleted or archived
+// and the cached lists they were in on all replicas.
+func (s *Service) InvalidateOrders(ctx context.Context, orders ...*models.Order) {
+	s.invalidateWrittenOrders(ctx, nil, orders...)
+}
+
+// previousStatuses wraps update to record the statuses the orders had before update changed them.
+func previousStatuses(update func([]*models.Order) ([]*models.Order, []models.Event, error)) (func([]*models.Order) ([]*models.Order, []models.Event, error), map[basetypes.ID]models.Status) {
+	previous := make(map[basetypes.ID]models.Status)
+	return func(found []*models.Order) ([]*models.Order, []models.Event, error) {
+		for _, o := range found {
+			previous[o.ID] = o.Status
+		}
+		return update(found)
+	}, previous
+}
+
+// Tags of the cached lists of orders. The lists of a client are tagged with the client and are invalidated
+// by the changes of the client's orders. The other lists are tagged with the statuses they are filtered by,
+// or with allOrdersTag if they aren't, and are invalidated by the orders entering or leaving the statuses.
+const allOrdersTag = "status:any"
+
+func clientTag(id basetypes.ID) string {
+	return "client:" + id.String()
+}
+
+func statusTag(status models.Status) string {
+	return "status:" + string(status)
+}
+
+// queryTags returns the tags of the lists of orders matching filter.
+func queryTags(filter *models.Filter) []string {
+	switch {
+	case filter.ClientID != nil:
+		return []string{clientTag(*filter.ClientID)}
+	case filter.Status != nil:
+		return []string{statusTag(*filter.Status)}
+	case len(filter.Statuses) > 0:
+		tags := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			tags = append(tags, statusTag(status))
+		}
+		slices.Sort(tags)
+		return slices.Compact(tags)
+	}
+	return []string{allOrdersTag}
+}
+
+func (s *Service) invalidateOrderCache(ctx context.Context, orderIDs ...basetypes.ID) {
+	if len(orderIDs) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		keys = append(keys, s.genCacheKey(id))
+	}
+	if err := s.cache.Invalidate(ctx, keys...); err != nil {
+		log.Printf("Failed to invalidate cached orders: %v", err)
 	}
-	return nil
 }
 
 func (s *Service) setOrderCache(ctx context.Context, o *models.Order) {
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+	"time"
+)
+
+func (s *Service) CourierHandover(ctx context.Context, req *orderServise.CourierHandoverRequest) (resp *orderServise.CourierHandoverResponse, err error) {
+	resp = &orderServise.CourierHandoverResponse{}
+
+	ppID, err := s.pickupPoint(ctx, req.PickupPointID)
+	if err != nil {
+		return resp, err
+	}
+
+	ids := uniqueIDs(req.OrderIDs)
+	if len(ids) == 0 {
+		manifest, err := s.manifest(ctx, ppID)
+		if err != nil {
+			return resp, err
+		}
+		for _, o := range manifest {
+			ids = append(ids, o.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return resp, orderServise.ErrNothingToHandOver
+	}
+
+	h := &order.Handover{
+		CourierID:     req.CourierID,
+		PickupPointID: ppID,
+		SignedOffAt:   time.Now().UTC(),
+		OrderIDs:      ids,
+	}
+	var handedOver []*order.Order
+	update, previous := previousStatuses(func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+		if err := s.handOver(ids, found, h); err != nil {
+			return nil, nil, err
+		}
+		handedOver = found
+		return found, newOrderEvents(found, "handover"), nil
+	})
+	if err = s.repo.AddHandover(ctx, h, update); err != nil {
+		return resp, err
+	}
+
+	s.invalidateWrittenOrders(ctx, previous, handedOver...)
+	resp.Handover = h
+
+	return resp, nil
+}
+
+// handOver cancels all the requested orders or none of them if any can't be handed over.
+func (s *Service) handOver(ids []basetypes.ID, found []*order.Order, h *order.Handover) error {
+	byID := make(map[basetypes.ID]*order.Order, len(found))
+	for _, o := range found {
+		byID[o.ID] = o
+	}
+
+	c := s.newTransitionContext(h.PickupPointID, 0)
+	c.Now = h.SignedOffAt
+	for _, id := range ids {
+		o, ok := byID[id]
+		if !ok {
+			return fmt.Errorf("%w: %d", orderServise.ErrOrderNotFound, id)
+		}
+		if o.PickupPointID != h.PickupPointID {
+			return fmt.Errorf("%w: order %d: %w", orderServise.ErrCantHandOver, id, orderServise.ErrWrongPickupPoint)
+		}
+		if !o.MustLeave(c.TimeToStore, c.Now) {
+			return fmt.Errorf("%w: order %d is %s", orderServise.ErrCantHandOver, id, o.Status)
+		}
+		if err := s.states.Transition(o, order.Canceled, c); err != nil {
+			return fmt.Errorf("%w: %w", orderServise.ErrCantHandOver, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) CreatePickupPoint(ctx context.Context, req *orderServise.CreatePickupPointRequest) (resp *orderServise.CreatePickupPointResponse, err error) {
+	resp = &orderServise.CreatePickupPointResponse{}
+
+	if err = s.repo.CreatePickupPoint(ctx, req.PickupPoint); err != nil {
+		return resp, err
+	}
+
+	resp.PickupPoint, err = s.repo.GetPickupPoint(ctx, req.PickupPoint.ID)
+	return resp, err
+}
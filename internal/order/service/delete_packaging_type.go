@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) DeletePackagingType(ctx context.Context, req *orderServise.DeletePackagingTypeRequest) (resp *orderServise.DeletePackagingTypeResponse, err error) {
+	resp = &orderServise.DeletePackagingTypeResponse{}
+
+	types, err := s.repo.ListPackagingTypes(ctx)
+	if err != nil {
+		return resp, err
+	}
+	for _, t := range types {
+		if t.Name != req.Name && slices.Contains(t.Wraps, req.Name) {
+			return resp, orderServise.ErrPackagingInUse
+		}
+	}
+
+	return resp, s.repo.DeletePackagingType(ctx, req.Name)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	"time"
+)
+
+// expireBatchSize is the maximum number of orders expired within one transaction.
+const expireBatchSize = 100
+
+// ExpireOrders moves stored orders past their storage deadline to order.Expired and returns the number of them.
+func (s *Service) ExpireOrders(ctx context.Context) (int, error) {
+	var total int
+	for {
+		ids, err := s.repo.GetExpiredIDs(ctx, s.timeToStore, time.Now().UTC(), expireBatchSize)
+		if err != nil || len(ids) == 0 {
+			return total, err
+		}
+
+		var expired []*order.Order
+		update, previous := previousStatuses(func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+			expired = s.expire(found)
+			return expired, newOrderEvents(expired, "expire"), nil
+		})
+		if err = s.repo.UpdateList(ctx, ids, update); err != nil {
+			return total, err
+		}
+
+		s.invalidateWrittenOrders(ctx, previous, expired...)
+		total += len(expired)
+
+		// stop if none of the batch could be expired, otherwise it would be fetched again
+		if len(ids) < expireBatchSize || len(expired) == 0 {
+			return total, nil
+		}
+	}
+}
+
+// expire moves the orders that are still expired to order.Expired and returns them.
+func (s *Service) expire(orders []*order.Order) []*order.Order {
+	c := s.newTransitionContext(0, 0)
+	expired := make([]*order.Order, 0, len(orders))
+	for _, o := range orders {
+		if s.states.Transition(o, order.Expired, c) == nil {
+			expired = append(expired, o)
+		}
+	}
+	return expired
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+	"time"
+)
+
+func (s *Service) GetCourierManifest(ctx context.Context, req *orderServise.GetCourierManifestRequest) (resp *orderServise.GetCourierManifestResponse, err error) {
+	resp = &orderServise.GetCourierManifestResponse{}
+
+	ppID, err := s.pickupPoint(ctx, req.PickupPointID)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Orders, err = s.manifest(ctx, ppID)
+	return resp, err
+}
+
+// manifest returns the orders that must leave the Pick Up Point: the returned ones, the expired ones
+// and the stored ones past their storage deadline that haven't been expired yet.
+func (s *Service) manifest(ctx context.Context, pickupPointID basetypes.ID) ([]*order.Order, error) {
+	var manifest []*order.Order
+	now := time.Now().UTC()
+
+	for _, status := range []order.Status{order.Returned, order.Expired, order.Stored} {
+		orders, err := s.repo.GetBy(ctx, &order.Filter{PickUpPointID: &pickupPointID, Status: &status})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range orders {
+			if o.MustLeave(s.timeToStore, now) {
+				manifest = append(manifest, o)
+			}
+		}
+	}
+	return manifest, nil
+}
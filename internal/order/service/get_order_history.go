@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) GetOrderHistory(ctx context.Context, req *orderServise.GetOrderHistoryRequest) (resp *orderServise.GetOrderHistoryResponse, err error) {
+	resp = &orderServise.GetOrderHistoryResponse{}
+
+	history, err := s.repo.GetHistory(ctx, req.OrderID)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.History = history
+	return resp, nil
+}
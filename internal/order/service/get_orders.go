@@ -14,10 +14,26 @@ func (s *Service) GetOrders(ctx context.Context, req *orderServise.GetOrdersRequ
 	}
 
 	if req.LocalOnly {
-		filter.PickUpPointID = &s.ID
+		ppID, err := s.pickupPoint(ctx, req.PickupPointID)
+		if err != nil {
+			return &orderServise.GetOrdersResponse{}, err
+		}
+		filter.PickUpPointID = &ppID
 	}
 
-	orders, err := s.repo.GetBy(ctx, filter)
+	orders, next, err := s.getPage(ctx, filter, nil, req.PageToken, req.PageSize)
+	s.fillStorageDeadlines(orders)
 
-	return &orderServise.GetOrdersResponse{Orders: orders}, err
+	return &orderServise.GetOrdersResponse{Orders: orders, NextPageToken: next}, err
+}
+
+// fillStorageDeadlines sets the default storage deadline for stored and expired orders without their own one.
+// Orders in other statuses aren't kept at the pickup point and have no deadline.
+func (s *Service) fillStorageDeadlines(orders []*order.Order) {
+	for _, o := range orders {
+		if o.ExpiresAt == nil && (o.Status == order.Stored || o.Status == order.Expired) {
+			deadline := o.StorageDeadline(s.timeToStore)
+			o.ExpiresAt = &deadline
+		}
+	}
 }
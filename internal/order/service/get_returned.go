@@ -7,11 +7,13 @@ import (
 )
 
 func (s *Service) GetReturned(ctx context.Context, req *orderServise.GetReturnedRequest) (resp *orderServise.GetReturnedResponse, err error) {
+	resp = &orderServise.GetReturnedResponse{}
+
 	returned := order.Returned
 	filter := &order.Filter{
 		Status: &returned,
 	}
-	orders, err := s.repo.GetByPaginated(ctx, filter, uint(req.Page), req.PerPage)
+	resp.Orders, resp.NextPageToken, err = s.getPage(ctx, filter, nil, req.PageToken, req.PerPage)
 
-	return &orderServise.GetReturnedResponse{Orders: orders}, err
+	return resp, err
 }
@@ -3,84 +3,139 @@ package service
 import (
 	"context"
 	"errors"
-	"fmt"
 	"github.com/vlad1028/order-manager/internal/metrics"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	orderService "github.com/vlad1028/order-manager/internal/order"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"log"
 	"slices"
 	"time"
 )
 
+// errNothingIssued rolls back an issue that didn't issue any orders.
+var errNothingIssued = errors.New("no orders issued")
+
 func (s *Service) IssueOrder(ctx context.Context, req *orderService.IssueOrderRequest) (resp *orderService.IssueOrderResponse, err error) {
 	resp = &orderService.IssueOrderResponse{}
 
-	o, err := s.getOrder(ctx, req.IDs[0])
+	ppID, err := s.pickupPoint(ctx, req.PickupPointID)
 	if err != nil {
 		return resp, err
 	}
 
-	orders, err := s.repo.GetBy(ctx, &order.Filter{ClientID: &o.ClientID})
-	if err != nil {
-		return resp, err
+	redeem := func(ctx context.Context, codes verification.Storage) error {
+		return s.codes.Redeem(ctx, codes, req.ClientID, ppID, req.Code)
 	}
 
-	issuedOrders := filterByIds(orders, req.IDs)
-	issuedOrders, err = filterStored(issuedOrders, s.ID)
-	if err != nil {
-		return resp, err
-	}
+	ids := uniqueIDs(req.IDs)
+	update, previous := previousStatuses(func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+		issued, results := s.issue(ids, found, ppID, req.ClientID)
+		resp.Orders, resp.Results = issued, results
 
-	setIssueDate(issuedOrders)
-	err = s.addOrUpdateList(ctx, issuedOrders)
+		if len(issued) != len(ids) && req.Mode == orderService.IssueAllOrNothing {
+			return nil, nil, orderService.ErrIssueRejected
+		}
+		if len(issued) == 0 {
+			// roll back the redeemed code, the client can use it once the orders can be issued
+			return nil, nil, errNothingIssued
+		}
+		return issued, newOrderEvents(issued, "issue"), nil
+	})
+	err = s.repo.IssueList(ctx, ids, redeem, update)
+	if errors.Is(err, errNothingIssued) {
+		return resp, nil
+	}
 	if err != nil {
+		resp.Orders = nil
 		return resp, err
 	}
 
-	sendIssueEvents(s, issuedOrders)
-	metrics.AddIssuedOrdersTotal(len(issuedOrders), "issued")
+	s.invalidateWrittenOrders(ctx, previous, resp.Orders...)
+	metrics.AddIssuedOrdersTotal(len(resp.Orders), "issued")
+
+	if len(resp.Orders) != 0 {
+		s.renewPickupCode(ctx, req.ClientID, ppID)
+	}
 
-	resp.Orders = issuedOrders
 	return resp, nil
 }
 
-func sendIssueEvents(s *Service, orders []*order.Order) {
+// newOrderEvents returns an event of the operation for every order.
+func newOrderEvents(orders []*order.Order, operation string) []order.Event {
+	events := make([]order.Event, 0, len(orders))
 	for _, o := range orders {
-		event := order.Event{
+		events = append(events, order.Event{
 			OrderID:   o.ID,
-			Operation: "issue",
+			Operation: operation,
 			Timestamp: time.Now().UTC(),
-		}
-		s.sendEvent(event)
+		})
 	}
+	return events
 }
 
-func filterByIds(orders []*order.Order, ids []basetypes.ID) []*order.Order {
-	filtered := make([]*order.Order, 0, len(ids))
-	for _, o := range orders {
-		if slices.Contains(ids, o.ID) {
-			filtered = append(filtered, o)
+func uniqueIDs(ids []basetypes.ID) []basetypes.ID {
+	unique := make([]basetypes.ID, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
 		}
 	}
-	return filtered
+	return unique
 }
 
-func setIssueDate(orders []*order.Order) {
-	for _, o := range orders {
-		o.SetStatus(order.ReachedClient)
+// renewPickupCode sends a new pickup code in place of the redeemed one if the client still has orders to receive.
+// Failures don't affect the issued orders and are only logged.
+func (s *Service) renewPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) {
+	stored := order.Stored
+	remaining, err := s.repo.GetBy(ctx, &order.Filter{
+		ClientID:      &clientID,
+		PickUpPointID: &pickupPointID,
+		Status:        &stored,
+	})
+	if err != nil {
+		log.Printf("failed to get remaining orders of client %d: %v", clientID, err)
+		return
+	}
+	if len(remaining) == 0 {
+		return
+	}
+
+	if err = s.codes.Issue(ctx, clientID, pickupPointID); err != nil {
+		log.Printf("failed to issue pickup code to client %d: %v", clientID, err)
 	}
 }
 
-func filterStored(orders []*order.Order, ppid basetypes.ID) (filtered []*order.Order, err error) {
-	err = nil
+// issue moves the found orders that can be issued to order.ReachedClient and returns them
+// together with the result for every requested ID.
+func (s *Service) issue(ids []basetypes.ID, found []*order.Order, pickupPointID, clientID basetypes.ID) ([]*order.Order, []order.IssueResult) {
+	byID := make(map[basetypes.ID]*order.Order, len(found))
+	for _, o := range found {
+		byID[o.ID] = o
+	}
+
+	c := s.newTransitionContext(pickupPointID, clientID)
+	issued := make([]*order.Order, 0, len(ids))
+	results := make([]order.IssueResult, len(ids))
+	for i, id := range ids {
+		results[i] = order.IssueResult{OrderID: id, Reason: s.issueOne(byID[id], c)}
+		if results[i].Issued() {
+			issued = append(issued, byID[id])
+		}
+	}
+	return issued, results
+}
 
-	filtered = make([]*order.Order, 0, len(orders))
-	for _, o := range orders {
-		if o.Status == order.Stored && o.PickupPointID == ppid {
-			filtered = append(filtered, o)
-		} else {
-			err = errors.Join(err, fmt.Errorf("order[%v] is not stored", o.ID))
+// issueOne moves the order to order.ReachedClient and returns order.Issued or the reason it can't be issued.
+func (s *Service) issueOne(o *order.Order, c *order.TransitionContext) order.IssueReason {
+	if o == nil {
+		return order.IssueNotFound
+	}
+	// the status of an order of another client or Pick Up Point isn't revealed
+	for _, guard := range []order.Guard{order.OwnedByClient, order.AtPickupPoint} {
+		if err := guard(o, c); err != nil {
+			return order.IssueReasonOf(err)
 		}
 	}
-	return filtered, err
+	return order.IssueReasonOf(s.states.Transition(o, order.ReachedClient, c))
 }
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) ListPackagingTypes(ctx context.Context, _ *orderServise.ListPackagingTypesRequest) (resp *orderServise.ListPackagingTypesResponse, err error) {
+	types, err := s.repo.ListPackagingTypes(ctx)
+
+	return &orderServise.ListPackagingTypesResponse{PackagingTypes: types}, err
+}
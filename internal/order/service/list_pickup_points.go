@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) ListPickupPoints(ctx context.Context, _ *orderServise.ListPickupPointsRequest) (resp *orderServise.ListPickupPointsResponse, err error) {
+	points, err := s.repo.ListPickupPoints(ctx)
+
+	return &orderServise.ListPickupPointsResponse{PickupPoints: points}, err
+}
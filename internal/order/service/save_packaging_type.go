@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) SavePackagingType(ctx context.Context, req *orderServise.SavePackagingTypeRequest) (resp *orderServise.SavePackagingTypeResponse, err error) {
+	resp = &orderServise.SavePackagingTypeResponse{}
+
+	t := req.PackagingType
+	for _, name := range t.Wraps {
+		if name == t.Name {
+			continue
+		}
+		if _, err = s.repo.GetPackagingType(ctx, name); err != nil {
+			return resp, err
+		}
+	}
+
+	exists, err := s.repo.AddOrUpdatePackagingType(ctx, t)
+	resp.Created = !exists
+	return resp, err
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) SearchOrders(ctx context.Context, req *orderServise.SearchOrdersRequest) (resp *orderServise.SearchOrdersResponse, err error) {
+	resp = &orderServise.SearchOrdersResponse{}
+
+	filter := req.Filter
+	resp.Orders, resp.NextPageToken, err = s.getPage(ctx, &filter, req.Sort, req.PageToken, req.PageSize)
+	s.fillStorageDeadlines(resp.Orders)
+
+	return resp, err
+}
+
+// getPage returns up to size orders matching filter sorted by the keys that follow the page token
+// and the token of the next page. The next page token is empty if there are no more orders.
+// A non-positive size returns all the orders.
+func (s *Service) getPage(ctx context.Context, filter *order.Filter, sort []order.Sort, token string, size int) ([]*order.Order, string, error) {
+	keys, err := order.SortKeys(sort)
+	if err != nil {
+		return nil, "", err
+	}
+	query := order.QueryKey(filter, keys)
+	after, err := order.ParsePageToken(token, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := -1
+	if size > 0 {
+		limit = size + 1 // one extra order tells whether there is a next page
+	}
+	orders, err := s.queries.LoadQuery(ctx, pageQuery(query, token, limit), queryTags(filter), func(ctx context.Context) ([]*order.Order, error) {
+		return s.repo.GetPage(ctx, filter, sort, after, limit)
+	})
+	if err != nil || size <= 0 || len(orders) <= size {
+		return orders, "", err
+	}
+
+	orders = orders[:size]
+	return orders, order.CursorOf(orders[size-1]).Token(query), nil
+}
+
+// pageQuery returns the canonical encoding of a page of the list of orders the query identifies,
+// which identifies the page in the cache.
+func pageQuery(query string, token string, limit int) string {
+	return fmt.Sprintf("%s;after=%s;limit=%d", query, token, limit)
+}
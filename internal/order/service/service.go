@@ -7,40 +7,92 @@ import (
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	models "github.com/vlad1028/order-manager/internal/models/order"
 	"github.com/vlad1028/order-manager/internal/order"
+	"github.com/vlad1028/order-manager/internal/verification"
 )
 
-// MessageSender defines the interface for sending messages to a message broker (like Kafka).
-type MessageSender interface {
-	SendMessage(key, value []byte) error
-}
-
 // CachedOrders defines the interface for a key-value cache for orders.
 type CachedOrders interface {
 	Get(ctx context.Context, key string) (*models.Order, bool)
+	// Set caches the order unless a newer version of it is already cached.
 	Set(ctx context.Context, key string, value *models.Order) error
+	// Invalidate drops the cached values of the keys on all replicas.
+	Invalidate(ctx context.Context, keys ...string) error
+	// Load returns the cached order or the one load returns, caching it. Concurrent loads of a key may be
+	// coalesced, and ErrOrderNotFound may be returned without calling load for orders recently not found.
+	Load(ctx context.Context, key string, load func(context.Context) (*models.Order, error)) (*models.Order, error)
+}
+
+// CachedQueries defines the interface for a cache of lists of orders invalidated by tags.
+type CachedQueries interface {
+	// LoadQuery returns the cached list of the query or the one load returns, caching it with the tags.
+	LoadQuery(ctx context.Context, query string, tags []string, load func(context.Context) ([]*models.Order, error)) ([]*models.Order, error)
+	// InvalidateTags drops the cached lists with any of the tags.
+	InvalidateTags(ctx context.Context, tags ...string) error
+}
+
+// PickupCodes defines the interface for the one-time codes clients confirm receiving orders with.
+type PickupCodes interface {
+	// Issue sends a code to the client unless the client already has an active one at the pickup point.
+	Issue(ctx context.Context, clientID, pickupPointID basetypes.ID) error
+	// Redeem verifies the code of the client at the pickup point against storage and consumes it if it's valid.
+	Redeem(ctx context.Context, storage verification.Storage, clientID, pickupPointID basetypes.ID, code string) error
 }
 
 var _ order.Service = (*Service)(nil)
 
 // Service implements the business logic for managing orders.
-// It orchestrates interactions between the database, cache, and message broker.
+// It orchestrates interactions between the database and cache.
+// Events are written to the outbox together with the orders and published by kafka.OutboxRelay.
 type Service struct {
-	ID               basetypes.ID     // Unique identifier for the pickup point (ПВЗ).
-	timeToStore      time.Duration    // Default duration to store an order.
-	timeToMakeReturn time.Duration    // Time window within which a customer can return an order.
-	repo             order.Repository // Repository for database operations.
-	kafkaProducer    MessageSender    // Producer to send events to Kafka.
-	cache            CachedOrders     // Cache for frequently accessed orders.
+	ID               basetypes.ID             // Default pickup point (ПВЗ) for requests that don't specify one.
+	timeToStore      time.Duration            // Default duration to store an order.
+	timeToMakeReturn time.Duration            // Time window within which a customer can return an order.
+	repo             order.Repository         // Repository for database operations.
+	cache            CachedOrders             // Cache for frequently accessed orders.
+	queries          CachedQueries            // Cache for frequently requested lists of orders.
+	states           *models.StateMachine     // State machine every status change goes through.
+	packaging        *models.PackagingFactory // Builds packaging of the types from the catalogue.
+	codes            PickupCodes              // Pickup codes required to issue orders.
 }
 
 // NewOrderService creates and returns a new Service instance.
-func NewOrderService(id basetypes.ID, timeToStore, timeToMakeReturn time.Duration, r order.Repository, kafkaProducer MessageSender, cache CachedOrders) *Service {
+func NewOrderService(id basetypes.ID, timeToStore, timeToMakeReturn time.Duration, r order.Repository, cache CachedOrders, queries CachedQueries, codes PickupCodes) *Service {
 	return &Service{
 		ID:               id,
 		timeToStore:      timeToStore,
 		timeToMakeReturn: timeToMakeReturn,
 		repo:             r,
-		kafkaProducer:    kafkaProducer,
 		cache:            cache,
+		queries:          queries,
+		states:           models.NewStateMachine(models.Transitions),
+		packaging:        models.NewPackagingFactory(r),
+		codes:            codes,
+	}
+}
+
+// pickupPoint returns the Pick Up Point the request is served for and checks it is registered.
+func (s *Service) pickupPoint(ctx context.Context, id basetypes.ID) (basetypes.ID, error) {
+	if id == 0 {
+		id = s.ID
+	}
+
+	exists, err := s.repo.PickupPointExists(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, order.ErrPickupPointNotFound
+	}
+	return id, nil
+}
+
+// newTransitionContext returns the context status transitions performed by the Pick Up Point are checked against.
+func (s *Service) newTransitionContext(pickupPointID, clientID basetypes.ID) *models.TransitionContext {
+	return &models.TransitionContext{
+		Now:              time.Now().UTC(),
+		PickupPointID:    pickupPointID,
+		ClientID:         clientID,
+		TimeToStore:      s.timeToStore,
+		TimeToMakeReturn: s.timeToMakeReturn,
 	}
 }
@@ -2,26 +2,45 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/vlad1028/order-manager/internal/cache"
-	"github.com/vlad1028/order-manager/internal/kafka"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	orderInterfaces "github.com/vlad1028/order-manager/internal/order"
 	"github.com/vlad1028/order-manager/internal/order/repository/mock"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"slices"
 	"testing"
 	"time"
 )
 
 func newTestService(r orderInterfaces.Repository) *Service {
-	return newTestServiceWithMessageSender(r, kafka.NewMockProducer())
+	return NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, r, cache.NewCacheMock(), cache.NewCacheMock(), testPickupCodes{})
 }
 
-func newTestServiceWithMessageSender(r orderInterfaces.Repository, producer MessageSender) *Service {
-	return NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, r, producer, cache.NewCacheMock())
+// testPickupCode is the only pickup code testPickupCodes accepts.
+const testPickupCode = "123456"
+
+type testPickupCodes struct{}
+
+func (testPickupCodes) Issue(context.Context, basetypes.ID, basetypes.ID) error {
+	return nil
+}
+
+func (testPickupCodes) Redeem(_ context.Context, _ verification.Storage, _, _ basetypes.ID, code string) error {
+	if code != testPickupCode {
+		return orderInterfaces.ErrInvalidPickupCode
+	}
+	return nil
+}
+
+// newTestRepository returns a repository mock where the default pickup point is registered.
+func newTestRepository(ctrl *minimock.Controller) *mock.OrderRepositoryMock {
+	r := mock.NewOrderRepositoryMock(ctrl)
+	r.PickupPointExistsMock.Optional().Return(true, nil)
+	return r
 }
 
 func TestOrderService_GetOrders(t *testing.T) {
@@ -61,8 +80,8 @@ func TestOrderService_GetOrders(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderRepo := mock.NewOrderRepositoryMock(ctrl)
-			orderRepo.GetByMock.Return(tt.mockResults.get, nil)
+			orderRepo := newTestRepository(ctrl)
+			orderRepo.GetPageMock.Return(tt.mockResults.get, nil)
 
 			m := newTestService(orderRepo)
 			_, err := m.GetOrders(ctx, &request)
@@ -72,103 +91,296 @@ func TestOrderService_GetOrders(t *testing.T) {
 }
 
 func TestOrderService_GetReturned(t *testing.T) {
-	type mockResults struct {
-		get []*order.Order
-	}
-
 	ctrl := minimock.NewController(t)
 	ctx := context.Background()
 
-	request := orderInterfaces.GetReturnedRequest{Page: 0, PerPage: -1}
+	now := time.Now().UTC()
 	exampleOrders := []*order.Order{
-		{ID: 1},
-		{ID: 2},
-		{ID: 3},
+		{ID: 1, StatusUpdated: now},
+		{ID: 2, StatusUpdated: now},
+		{ID: 3, StatusUpdated: now.Add(time.Second)},
 	}
+	returned := order.Returned
+	query := order.QueryKey(&order.Filter{Status: &returned}, order.DefaultSort)
 
 	tests := []struct {
-		name        string
-		request     *orderInterfaces.GetReturnedRequest
-		mockResults mockResults
-		wantErr     assert.ErrorAssertionFunc
+		name      string
+		request   *orderInterfaces.GetReturnedRequest
+		get       []*order.Order
+		wantOrder []*order.Order
+		wantNext  string
+		wantErr   assert.ErrorAssertionFunc
 	}{
 		{
-			"Success",
-			&request,
-			mockResults{
-				get: exampleOrders,
-			},
+			"All",
+			&orderInterfaces.GetReturnedRequest{PerPage: -1},
+			exampleOrders,
+			exampleOrders,
+			"",
 			assert.NoError,
 		},
+		{
+			"FirstPage",
+			&orderInterfaces.GetReturnedRequest{PerPage: 2},
+			exampleOrders,
+			exampleOrders[:2],
+			order.CursorOf(exampleOrders[1]).Token(query),
+			assert.NoError,
+		},
+		{
+			"LastPage",
+			&orderInterfaces.GetReturnedRequest{PageToken: order.CursorOf(exampleOrders[1]).Token(query), PerPage: 2},
+			exampleOrders[2:],
+			exampleOrders[2:],
+			"",
+			assert.NoError,
+		},
+		{
+			"InvalidToken",
+			&orderInterfaces.GetReturnedRequest{PageToken: "not a token", PerPage: 2},
+			nil,
+			nil,
+			"",
+			func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(t, err, orderInterfaces.ErrInvalidPageToken)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderRepo := mock.NewOrderRepositoryMock(ctrl)
-			orderRepo.GetByPaginatedMock.Return(tt.mockResults.get, nil)
+			orderRepo := newTestRepository(ctrl)
+			orderRepo.GetPageMock.Optional().Set(func(_ context.Context, _ *order.Filter, _ []order.Sort, after *order.Cursor, limit int) ([]*order.Order, error) {
+				wantAfter, err := order.ParsePageToken(tt.request.PageToken, query)
+				assert.NoError(t, err)
+				assert.Equal(t, wantAfter, after)
+				if limit > 0 && limit < len(tt.get) {
+					return tt.get[:limit], nil
+				}
+				return tt.get, nil
+			})
 
 			m := newTestService(orderRepo)
-			_, gotErr := m.GetReturned(ctx, tt.request)
-			tt.wantErr(t, gotErr)
+			resp, gotErr := m.GetReturned(ctx, tt.request)
+			if !tt.wantErr(t, gotErr) || gotErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantOrder, resp.Orders)
+			assert.Equal(t, tt.wantNext, resp.NextPageToken)
 		})
 	}
 }
 
-func TestOrderService_IssueOrder(t *testing.T) {
-	type mockResults struct {
-		get    *order.Order
-		getby  []*order.Order
-		add    error
-		remove error
+func TestOrderService_SearchOrders(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	pickupPointID := basetypes.ID(1)
+	minWeight := uint(20)
+	req := &orderInterfaces.SearchOrdersRequest{
+		Filter: order.Filter{
+			PickUpPointID: &pickupPointID,
+			Statuses:      []order.Status{order.Stored},
+			Weight:        order.UintRange{Min: &minWeight},
+		},
+		Sort:     []order.Sort{{Field: order.SortByCost}},
+		PageSize: 1,
 	}
 
+	orderRepo := newTestRepository(ctrl)
+	orderRepo.GetPageMock.Set(func(_ context.Context, f *order.Filter, sort []order.Sort, after *order.Cursor, limit int) ([]*order.Order, error) {
+		assert.Equal(t, req.Filter, *f)
+		assert.Equal(t, req.Sort, sort)
+		assert.Nil(t, after)
+		assert.Equal(t, 2, limit)
+		return []*order.Order{{ID: 1, Status: order.Stored, Weight: 30, Cost: 10}, {ID: 2, Status: order.Stored, Weight: 25, Cost: 20}}, nil
+	})
+
+	m := newTestService(orderRepo)
+	resp, err := m.SearchOrders(ctx, req)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Orders, 1)
+	assert.NotNil(t, resp.Orders[0].ExpiresAt)
+
+	keys, err := order.SortKeys(req.Sort)
+	assert.NoError(t, err)
+	cursor, err := order.ParsePageToken(resp.NextPageToken, order.QueryKey(&req.Filter, keys))
+	assert.NoError(t, err)
+	assert.Equal(t, order.CursorOf(resp.Orders[0]), cursor)
+}
+
+func TestOrderService_SearchOrdersStorageDeadlines(t *testing.T) {
 	ctrl := minimock.NewController(t)
 	ctx := context.Background()
 
-	request := orderInterfaces.IssueOrderRequest{
-		IDs: []basetypes.ID{1, 2, 3},
+	orderRepo := newTestRepository(ctrl)
+	orderRepo.GetPageMock.Return([]*order.Order{
+		{ID: 1, Status: order.Stored},
+		{ID: 2, Status: order.Expired},
+		{ID: 3, Status: order.ReachedClient},
+		{ID: 4, Status: order.Returned},
+		{ID: 5, Status: order.Canceled},
+	}, nil)
+
+	m := newTestService(orderRepo)
+	resp, err := m.SearchOrders(ctx, &orderInterfaces.SearchOrdersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Orders, 5)
+	assert.NotNil(t, resp.Orders[0].ExpiresAt)
+	assert.NotNil(t, resp.Orders[1].ExpiresAt)
+	for _, o := range resp.Orders[2:] {
+		assert.Nil(t, o.ExpiresAt, "order %d isn't stored", o.ID)
 	}
+}
 
-	exampleOrdersSuccess := []*order.Order{
-		{ID: 1, Status: order.Stored},
-		{ID: 2, Status: order.Stored},
-		{ID: 3, Status: order.Stored},
+func TestOrderService_GetOrderHistory(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	request := orderInterfaces.GetOrderHistoryRequest{OrderID: 1}
+	exampleHistory := []*order.StatusChange{
+		{OrderID: 1, Status: order.Stored},
+		{OrderID: 1, Status: order.ReachedClient},
 	}
-	exampleOrdersError := []*order.Order{
-		{ID: 4, Status: order.Stored},
-		{ID: 5, Status: order.Stored},
-		{ID: 6, Status: order.Stored},
+
+	tests := []struct {
+		name       string
+		mockResult []*order.StatusChange
+		mockErr    error
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			"Success",
+			exampleHistory,
+			nil,
+			assert.NoError,
+		},
+		{
+			"NoChanges",
+			[]*order.StatusChange{},
+			nil,
+			assert.NoError,
+		},
+		{
+			"NotFound",
+			nil,
+			orderInterfaces.ErrOrderNotFound,
+			assert.Error,
+		},
+		{
+			"ReposError",
+			nil,
+			fmt.Errorf("error"),
+			assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderRepo := newTestRepository(ctrl)
+			orderRepo.GetHistoryMock.Return(tt.mockResult, tt.mockErr)
+
+			m := newTestService(orderRepo)
+			resp, err := m.GetOrderHistory(ctx, &request)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.mockResult, resp.History)
+		})
+	}
+}
+
+func TestOrderService_IssueOrder(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	newOrders := func() []*order.Order {
+		return []*order.Order{
+			{ID: 1, ClientID: 1, Status: order.Stored, StatusUpdated: time.Now()},
+			{ID: 2, ClientID: 1, Status: order.Stored, StatusUpdated: time.Now()},
+			{ID: 3, ClientID: 2, Status: order.Stored, StatusUpdated: time.Now()},
+			{ID: 4, ClientID: 1, PickupPointID: 7, Status: order.Stored, StatusUpdated: time.Now()},
+			{ID: 5, ClientID: 1, Status: order.ReachedClient, StatusUpdated: time.Now()},
+			{ID: 6, ClientID: 1, Status: order.Stored, StatusUpdated: time.Now().AddDate(0, 0, -8)},
+			{ID: 7, ClientID: 1, Status: order.Expired, StatusUpdated: time.Now()},
+			{ID: 8, ClientID: 2, Status: order.Returned, StatusUpdated: time.Now()},
+			{ID: 10, ClientID: 2, Status: order.Expired, StatusUpdated: time.Now()},
+		}
 	}
 
 	tests := []struct {
 		name        string
 		request     *orderInterfaces.IssueOrderRequest
-		mockResults mockResults
-		wantErr     assert.ErrorAssertionFunc
+		repoErr     error
+		wantResults []order.IssueReason
+		wantIssued  int
+		wantErr     error
 	}{
 		{
 			"Success",
-			&request,
-			mockResults{
-				get:    exampleOrdersSuccess[0],
-				getby:  exampleOrdersSuccess,
-				add:    nil,
-				remove: nil,
-			},
-			assert.NoError,
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1, 2}, ClientID: 1, Code: testPickupCode},
+			nil,
+			[]order.IssueReason{order.Issued, order.Issued},
+			2,
+			nil,
+		},
+		{
+			"AllOrNothingRejected",
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1, 3}, ClientID: 1, Code: testPickupCode},
+			nil,
+			[]order.IssueReason{order.Issued, order.IssueWrongClient},
+			0,
+			orderInterfaces.ErrIssueRejected,
+		},
+		{
+			"BestEffort",
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1, 3, 4, 5, 6, 7, 9}, ClientID: 1, Code: testPickupCode, Mode: orderInterfaces.IssueBestEffort},
+			nil,
+			[]order.IssueReason{order.Issued, order.IssueWrongClient, order.IssueWrongPickupPoint, order.IssueNotStored, order.IssueExpired, order.IssueExpired, order.IssueNotFound},
+			1,
+			nil,
+		},
+		{
+			"OtherClient",
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1, 3}, ClientID: 2, Code: testPickupCode, Mode: orderInterfaces.IssueBestEffort},
+			nil,
+			[]order.IssueReason{order.IssueWrongClient, order.Issued},
+			1,
+			nil,
+		},
+		{
+			"OtherClientNotStored",
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{8, 10}, ClientID: 1, Code: testPickupCode, Mode: orderInterfaces.IssueBestEffort},
+			nil,
+			[]order.IssueReason{order.IssueWrongClient, order.IssueWrongClient},
+			0,
+			nil,
+		},
+		{
+			"DuplicateIDs",
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1, 1}, ClientID: 1, Code: testPickupCode},
+			nil,
+			[]order.IssueReason{order.Issued},
+			1,
+			nil,
 		},
 		{
 			"RepoError",
-			&request,
-			mockResults{
-				get:    exampleOrdersError[0],
-				getby:  exampleOrdersError,
-				add:    fmt.Errorf("error"),
-				remove: nil,
-			},
-			assert.Error,
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1}, ClientID: 1, Code: testPickupCode},
+			fmt.Errorf("error"),
+			[]order.IssueReason{order.Issued},
+			0,
+			nil,
+		},
+		{
+			"InvalidCode",
+			&orderInterfaces.IssueOrderRequest{IDs: []basetypes.ID{1}, ClientID: 1, Code: "000000"},
+			nil,
+			[]order.IssueReason{},
+			0,
+			orderInterfaces.ErrInvalidPickupCode,
 		},
 	}
 
@@ -176,14 +388,45 @@ func TestOrderService_IssueOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderRepo := mock.NewOrderRepositoryMock(ctrl)
-			orderRepo.GetMock.Return(tt.mockResults.get, nil)
-			orderRepo.GetByMock.Return(tt.mockResults.getby, nil)
-			orderRepo.AddOrUpdateListMock.Return(tt.mockResults.add)
+			orderRepo := newTestRepository(ctrl)
+			orderRepo.IssueListMock.Optional().Set(func(ctx context.Context, ids []basetypes.ID, redeem func(context.Context, verification.Storage) error, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error {
+				if err := redeem(ctx, nil); err != nil {
+					return err
+				}
+				var found []*order.Order
+				for _, o := range newOrders() {
+					if slices.Contains(ids, o.ID) {
+						found = append(found, o)
+					}
+				}
+				if _, _, err := update(found); err != nil {
+					return err
+				}
+				return tt.repoErr
+			})
+			orderRepo.GetByMock.Optional().Return(nil, nil)
 
 			m := newTestService(orderRepo)
-			_, gotErr := m.IssueOrder(ctx, tt.request)
-			tt.wantErr(t, gotErr)
+			resp, err := m.IssueOrder(ctx, tt.request)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.repoErr != nil:
+				assert.ErrorIs(t, err, tt.repoErr)
+			default:
+				assert.NoError(t, err)
+			}
+
+			reasons := make([]order.IssueReason, len(resp.Results))
+			for i, r := range resp.Results {
+				reasons[i] = r.Reason
+			}
+			assert.Equal(t, tt.wantResults, reasons)
+			assert.Len(t, resp.Orders, tt.wantIssued)
+			for _, o := range resp.Orders {
+				assert.Equal(t, order.ReachedClient, o.Status)
+			}
 		})
 	}
 }
@@ -205,6 +448,9 @@ func TestOrderService_AcceptOrder(t *testing.T) {
 		Packaging: nil,
 		AddFilm:   false,
 	}
+	expiresInPast := time.Now().Add(-time.Hour)
+	var expiredOrderRequest = exampleOrderRequest
+	expiredOrderRequest.ExpiresAt = &expiresInPast
 
 	tests := []struct {
 		name       string
@@ -239,14 +485,32 @@ func TestOrderService_AcceptOrder(t *testing.T) {
 			},
 			assert.Error,
 		},
+		{
+			"PickupPointFull",
+			&exampleOrderRequest,
+			mockResult{
+				false,
+				orderInterfaces.ErrPickupPointFull,
+			},
+			assert.Error,
+		},
+		{
+			"ExpiresInPast",
+			&expiredOrderRequest,
+			mockResult{
+				false,
+				nil,
+			},
+			assert.Error,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderRepo := mock.NewOrderRepositoryMock(ctrl)
-			orderRepo.AddOrUpdateMock.Optional().Return(tt.mockResult.exists, tt.mockResult.err)
+			orderRepo := newTestRepository(ctrl)
+			orderRepo.AddOrUpdateWithinCapacityMock.Optional().Return(tt.mockResult.exists, tt.mockResult.err)
 
 			m := newTestService(orderRepo)
 			_, err := m.AcceptOrder(ctx, tt.request)
@@ -335,10 +599,10 @@ func TestOrderService_AcceptReturn(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderRepo := mock.NewOrderRepositoryMock(ctrl)
+			orderRepo := newTestRepository(ctrl)
 			orderRepo.GetMock.Optional().Return(tt.mockResults.get, nil)
-			orderRepo.AddOrUpdateMock.Optional().Return(false, tt.mockResults.add)
-			orderRepo.DeleteMock.Optional().Return(tt.mockResults.remove)
+			orderRepo.UpdateMock.Optional().Return(tt.mockResults.add)
+			orderRepo.DeleteMock.Optional().Return(nil, tt.mockResults.remove)
 
 			m := newTestService(orderRepo)
 			_, err := m.AcceptReturn(ctx, tt.request)
@@ -411,10 +675,10 @@ func TestOrderService_CancelOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderRepo := mock.NewOrderRepositoryMock(ctrl)
+			orderRepo := newTestRepository(ctrl)
 			orderRepo.GetMock.Optional().Return(tt.mockResults.get, nil)
-			orderRepo.AddOrUpdateMock.Optional().Return(false, tt.mockResults.add)
-			orderRepo.DeleteMock.Optional().Return(tt.mockResults.remove)
+			orderRepo.UpdateMock.Optional().Return(tt.mockResults.add)
+			orderRepo.DeleteMock.Optional().Return(nil, tt.mockResults.remove)
 
 			m := newTestService(orderRepo)
 			_, err := m.CancelOrder(ctx, tt.request)
@@ -423,43 +687,445 @@ func TestOrderService_CancelOrder(t *testing.T) {
 	}
 }
 
-func TestSendEvent(t *testing.T) {
+func TestOrderService_CancelOrderRetriesConcurrentModification(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		conflicts int
+		wantErr   error
+	}{
+		{"RetriedAfterConflict", 2, nil},
+		{"GivesUpAfterAttempts", updateAttempts, orderInterfaces.ErrConcurrentModification},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := minimock.NewController(t)
+			orderRepo := newTestRepository(ctrl)
+
+			reads := 0
+			orderRepo.GetMock.Set(func(context.Context, basetypes.ID) (*order.Order, error) {
+				reads++
+				return &order.Order{ID: 1, Status: order.Returned, Version: uint64(reads)}, nil
+			})
+			updates := 0
+			orderRepo.UpdateMock.Set(func(_ context.Context, o *order.Order, _ ...order.Event) error {
+				updates++
+				assert.Equal(t, order.Canceled, o.Status)
+				assert.Equal(t, uint64(updates), o.Version, "every attempt must use a fresh copy")
+				if updates <= tt.conflicts {
+					return orderInterfaces.ErrConcurrentModification
+				}
+				return nil
+			})
+
+			_, err := newTestService(orderRepo).CancelOrder(ctx, &orderInterfaces.CancelOrderRequest{ID: 1})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, min(tt.conflicts+1, updateAttempts), updates)
+		})
+	}
+}
+
+// memoryCache is a cache of a single replica which records invalidated keys.
+type memoryCache struct {
+	orders      map[string]*order.Order
+	invalidated []string
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (*order.Order, bool) {
+	o, ok := c.orders[key]
+	return o, ok
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value *order.Order) error {
+	c.orders[key] = value
+	return nil
+}
+
+func (c *memoryCache) Load(ctx context.Context, key string, load func(context.Context) (*order.Order, error)) (*order.Order, error) {
+	if o, ok := c.Get(ctx, key); ok {
+		return o, nil
+	}
+	o, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o, c.Set(ctx, key, o)
+}
+
+func (c *memoryCache) Invalidate(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		delete(c.orders, key)
+	}
+	c.invalidated = append(c.invalidated, keys...)
+	return nil
+}
+
+func TestOrderService_CancelOrderInvalidatesStaleCache(t *testing.T) {
+	ctx := context.Background()
 	ctrl := minimock.NewController(t)
-	mockProducer := kafka.NewMockProducer()
-	mockRepo := mock.NewOrderRepositoryMock(ctrl)
-	orderService := newTestServiceWithMessageSender(mockRepo, mockProducer)
+	orderRepo := newTestRepository(ctrl)
 
-	events := []order.Event{
-		{
-			OrderID:   basetypes.ID(123),
-			Operation: "accept",
-			Timestamp: time.Now().UTC(),
-		},
-		{
-			OrderID:   basetypes.ID(124),
-			Operation: "return",
-			Timestamp: time.Now().UTC(),
-		},
-		{
-			OrderID:   basetypes.ID(125),
-			Operation: "issue",
-			Timestamp: time.Now().UTC(),
-		},
+	orderRepo.GetMock.Return(&order.Order{ID: 1, Status: order.Returned, Version: 2}, nil)
+	orderRepo.UpdateMock.Set(func(_ context.Context, o *order.Order, _ ...order.Event) error {
+		if o.Version != 2 {
+			return orderInterfaces.ErrConcurrentModification
+		}
+		o.Version++
+		return nil
+	})
+
+	stale := &order.Order{ID: 1, Status: order.Returned, Version: 1}
+	c := &memoryCache{orders: map[string]*order.Order{"order:1": stale}}
+	s := NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, orderRepo, c, cache.NewCacheMock(), testPickupCodes{})
+
+	_, err := s.CancelOrder(ctx, &orderInterfaces.CancelOrderRequest{ID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"order:1", "order:1"}, c.invalidated, "the stale copy and the one invalidated by the write")
+
+	_, ok := c.Get(ctx, "order:1")
+	assert.False(t, ok, "the written order is loaded from the repository by the next read")
+}
+
+func TestOrderService_CancelJustAcceptedOrder(t *testing.T) {
+	ctx := context.Background()
+	ctrl := minimock.NewController(t)
+	orderRepo := newTestRepository(ctrl)
+
+	var stored order.Order
+	orderRepo.AddOrUpdateWithinCapacityMock.Set(func(_ context.Context, o *order.Order, _ ...order.Event) (bool, error) {
+		o.Version, o.StatusUpdated = 1, time.Now()
+		stored = *o
+		return false, nil
+	})
+	orderRepo.GetMock.Optional().Set(func(_ context.Context, _ basetypes.ID) (*order.Order, error) {
+		o := stored
+		return &o, nil
+	})
+
+	c := &memoryCache{orders: map[string]*order.Order{}}
+	s := NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, orderRepo, c, cache.NewCacheMock(), testPickupCodes{})
+
+	_, err := s.AcceptOrder(ctx, &orderInterfaces.AcceptOrderRequest{ID: 1, ClientID: 1, Weight: 5, Cost: 10})
+	assert.NoError(t, err)
+
+	_, err = s.CancelOrder(ctx, &orderInterfaces.CancelOrderRequest{ID: 1})
+	assert.ErrorIs(t, err, orderInterfaces.ErrCantCancel, "the storage period of the order has just started")
+}
+
+// memoryQueries is a cache of lists of orders of a single replica.
+type memoryQueries struct {
+	lists map[string][]*order.Order
+	tags  map[string][]string // queries by tag
+}
+
+func (c *memoryQueries) LoadQuery(ctx context.Context, query string, tags []string, load func(context.Context) ([]*order.Order, error)) ([]*order.Order, error) {
+	if orders, ok := c.lists[query]; ok {
+		return orders, nil
+	}
+	orders, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.lists[query] = orders
+	for _, tag := range tags {
+		c.tags[tag] = append(c.tags[tag], query)
 	}
+	return orders, nil
+}
 
-	for _, e := range events {
-		orderService.sendEvent(e)
+func (c *memoryQueries) InvalidateTags(_ context.Context, tags ...string) error {
+	for _, tag := range tags {
+		for _, query := range c.tags[tag] {
+			delete(c.lists, query)
+		}
+		delete(c.tags, tag)
 	}
+	return nil
+}
 
-	assert.Equal(t, len(events), len(mockProducer.Messages))
+func TestOrderService_CachesOrderLists(t *testing.T) {
+	ctx := context.Background()
+	ctrl := minimock.NewController(t)
+	orderRepo := newTestRepository(ctrl)
 
-	for i, e := range events {
-		msg := mockProducer.Messages[i]
-		var receivedEvent order.Event
-		err := json.Unmarshal(msg.Value, &receivedEvent)
+	returned := &order.Order{ID: 1, ClientID: 2, Status: order.Returned, Version: 1}
+	loads := 0
+	orderRepo.GetPageMock.Set(func(_ context.Context, f *order.Filter, _ []order.Sort, _ *order.Cursor, _ int) ([]*order.Order, error) {
+		loads++
+		if *f.Status == returned.Status {
+			return []*order.Order{returned}, nil
+		}
+		return nil, nil
+	})
+	orderRepo.GetMock.Return(returned, nil)
+	orderRepo.UpdateMock.Return(nil)
+
+	queries := &memoryQueries{lists: map[string][]*order.Order{}, tags: map[string][]string{}}
+	s := NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, orderRepo, cache.NewCacheMock(), queries, testPickupCodes{})
+	getReturned := func() []*order.Order {
+		resp, err := s.GetReturned(ctx, &orderInterfaces.GetReturnedRequest{PerPage: 10})
 		assert.NoError(t, err)
-		assert.Equal(t, e.OrderID, receivedEvent.OrderID)
-		assert.Equal(t, e.Operation, receivedEvent.Operation)
-		assert.Equal(t, e.Timestamp, receivedEvent.Timestamp)
+		return resp.Orders
 	}
+
+	assert.Len(t, getReturned(), 1)
+	assert.Len(t, getReturned(), 1)
+	assert.Equal(t, 1, loads, "the second page comes from the cache")
+
+	_, err := s.GetOrders(ctx, &orderInterfaces.GetOrdersRequest{ClientID: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, loads)
+
+	_, err = s.CancelOrder(ctx, &orderInterfaces.CancelOrderRequest{ID: returned.ID})
+	assert.NoError(t, err)
+	otherClient, stored := basetypes.ID(3), order.Stored
+	assert.Contains(t, queries.lists, pageQuery(order.QueryKey(&order.Filter{ClientID: &otherClient, Status: &stored}, order.DefaultSort), "", -1),
+		"lists of other clients stay cached")
+
+	assert.Empty(t, getReturned(), "the canceled order left the list of returned ones")
+	assert.Equal(t, 3, loads)
+}
+
+func TestOrderService_InvalidateOrders(t *testing.T) {
+	ctx := context.Background()
+	ctrl := minimock.NewController(t)
+	orderRepo := newTestRepository(ctrl)
+
+	archived := &order.Order{ID: 1, ClientID: 2, Status: order.Returned, Version: 3}
+	orderRepo.GetPageMock.Return([]*order.Order{archived}, nil)
+
+	c := &memoryCache{orders: map[string]*order.Order{"order:1": archived}}
+	queries := &memoryQueries{lists: map[string][]*order.Order{}, tags: map[string][]string{}}
+	s := NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, orderRepo, c, queries, testPickupCodes{})
+
+	_, err := s.GetReturned(ctx, &orderInterfaces.GetReturnedRequest{PerPage: 10})
+	assert.NoError(t, err)
+	assert.Len(t, queries.lists, 1)
+
+	s.InvalidateOrders(ctx, archived)
+	assert.Equal(t, []string{"order:1"}, c.invalidated)
+	assert.Empty(t, queries.lists, "the list of returned orders is invalidated")
+}
+
+func TestOrderService_AcceptOrderWritesEvent(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	orderRepo := newTestRepository(ctrl)
+	orderRepo.AddOrUpdateWithinCapacityMock.Set(func(_ context.Context, o *order.Order, events ...order.Event) (bool, error) {
+		assert.Len(t, events, 1)
+		assert.Equal(t, o.ID, events[0].OrderID)
+		assert.Equal(t, "accept", events[0].Operation)
+		return false, nil
+	})
+
+	m := newTestService(orderRepo)
+	_, err := m.AcceptOrder(ctx, &orderInterfaces.AcceptOrderRequest{ID: 123, ClientID: 1, Weight: 5, Cost: 10})
+	assert.NoError(t, err)
+}
+
+func TestOrderService_UnknownPickupPoint(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	orderRepo := mock.NewOrderRepositoryMock(ctrl)
+	orderRepo.PickupPointExistsMock.Expect(ctx, 42).Return(false, nil)
+
+	m := newTestService(orderRepo)
+	_, err := m.AcceptOrder(ctx, &orderInterfaces.AcceptOrderRequest{ID: 1, ClientID: 1, Weight: 5, PickupPointID: 42})
+	assert.ErrorIs(t, err, orderInterfaces.ErrPickupPointNotFound)
+}
+
+func TestOrderService_AcceptOrderPackaging(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	catalog := map[string]*order.PackagingType{
+		"box":  {Name: "box", WeightLimit: 30, Cost: 20, CanWrap: true},
+		"bag":  {Name: "bag", WeightLimit: 10, Cost: 5, CanWrap: true, Wraps: []string{"film"}},
+		"film": {Name: "film", Cost: 1},
+	}
+
+	tests := []struct {
+		name      string
+		packaging []string
+		addFilm   bool
+		wantCost  uint
+		wantErr   error
+	}{
+		{"NoPackaging", nil, false, 10, nil},
+		{"Box", []string{"box"}, false, 30, nil},
+		{"BoxWithFilm", []string{"box"}, true, 31, nil},
+		{"BoxBagFilm", []string{"box", "bag", "film"}, false, 36, nil},
+		{"BagBox", []string{"bag", "box"}, false, 0, orderInterfaces.ErrAdditionalPackNotAllowed},
+		{"FilmWithFilm", []string{"film"}, true, 0, orderInterfaces.ErrAdditionalPackNotAllowed},
+		{"FilmWithoutPrimary", nil, true, 0, orderInterfaces.ErrNoPrimaryPack},
+		{"Unknown", []string{"box", "crate"}, false, 0, orderInterfaces.ErrPackagingNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderRepo := newTestRepository(ctrl)
+			orderRepo.GetPackagingTypeMock.Optional().Set(func(_ context.Context, name string) (*order.PackagingType, error) {
+				if pt, ok := catalog[name]; ok {
+					return pt, nil
+				}
+				return nil, orderInterfaces.ErrPackagingNotFound
+			})
+			var stored *order.Order
+			orderRepo.AddOrUpdateWithinCapacityMock.Optional().Set(func(_ context.Context, o *order.Order, _ ...order.Event) (bool, error) {
+				stored = o
+				return false, nil
+			})
+
+			m := newTestService(orderRepo)
+			_, err := m.AcceptOrder(ctx, &orderInterfaces.AcceptOrderRequest{
+				ID: 1, ClientID: 1, Weight: 5, Cost: 10, Packaging: tt.packaging, AddFilm: tt.addFilm,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCost, stored.Cost)
+			assert.Equal(t, uint(10), stored.BaseCost)
+		})
+	}
+}
+
+func TestOrderService_SuggestPackaging(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	orderRepo := newTestRepository(ctrl)
+	orderRepo.ListPackagingTypesMock.Return([]*order.PackagingType{
+		{Name: "box", WeightLimit: 30, Cost: 20, CanWrap: true, Wraps: []string{"film"}},
+		{Name: "film", Cost: 1},
+	}, nil)
+
+	m := newTestService(orderRepo)
+
+	resp, err := m.SuggestPackaging(ctx, &orderInterfaces.SuggestPackagingRequest{Weight: 5, Required: []string{"box", "film"}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(21), resp.Cost)
+	assert.Len(t, resp.Layers, 2)
+
+	_, err = m.SuggestPackaging(ctx, &orderInterfaces.SuggestPackagingRequest{Weight: 50, Required: []string{"box"}})
+	assert.ErrorIs(t, err, orderInterfaces.ErrNoSuitablePackaging)
+}
+
+func TestOrderService_ExpireOrders(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	orders := []*order.Order{
+		{ID: 1, Status: order.Stored, StatusUpdated: time.Now().AddDate(0, 0, -8)},
+		// issued after it was found
+		{ID: 2, Status: order.ReachedClient, StatusUpdated: time.Now()},
+	}
+
+	orderRepo := newTestRepository(ctrl)
+	orderRepo.GetExpiredIDsMock.Return([]basetypes.ID{1, 2}, nil)
+	var events []order.Event
+	orderRepo.UpdateListMock.Set(func(_ context.Context, ids []basetypes.ID, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error {
+		assert.Equal(t, []basetypes.ID{1, 2}, ids)
+		var err error
+		_, events, err = update(orders)
+		return err
+	})
+
+	m := newTestService(orderRepo)
+	n, err := m.ExpireOrders(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, order.Expired, orders[0].Status)
+	assert.Equal(t, order.ReachedClient, orders[1].Status)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "expire", events[0].Operation)
+}
+
+func TestOrderService_CourierHandover(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	ctx := context.Background()
+
+	newOrders := func() []*order.Order {
+		return []*order.Order{
+			{ID: 1, Status: order.Returned, StatusUpdated: time.Now()},
+			{ID: 2, Status: order.Expired, StatusUpdated: time.Now()},
+			{ID: 3, Status: order.Stored, StatusUpdated: time.Now().AddDate(0, 0, -8)},
+			{ID: 4, Status: order.Stored, StatusUpdated: time.Now()},
+			{ID: 5, Status: order.Returned, PickupPointID: 7, StatusUpdated: time.Now()},
+		}
+	}
+	newRepository := func() *mock.OrderRepositoryMock {
+		orderRepo := newTestRepository(ctrl)
+		orderRepo.GetByMock.Optional().Set(func(_ context.Context, f *order.Filter) ([]*order.Order, error) {
+			var found []*order.Order
+			for _, o := range newOrders() {
+				if o.Status == *f.Status && o.PickupPointID == *f.PickUpPointID {
+					found = append(found, o)
+				}
+			}
+			return found, nil
+		})
+		orderRepo.AddHandoverMock.Optional().Set(func(_ context.Context, h *order.Handover, update func([]*order.Order) ([]*order.Order, []order.Event, error)) error {
+			var found []*order.Order
+			for _, o := range newOrders() {
+				if slices.Contains(h.OrderIDs, o.ID) {
+					found = append(found, o)
+				}
+			}
+			changed, _, err := update(found)
+			if err != nil {
+				return err
+			}
+			for _, o := range changed {
+				assert.Equal(t, order.Canceled, o.Status)
+			}
+			h.ID = 1
+			return nil
+		})
+		return orderRepo
+	}
+
+	t.Run("Manifest", func(t *testing.T) {
+		m := newTestService(newRepository())
+
+		resp, err := m.GetCourierManifest(ctx, &orderInterfaces.GetCourierManifestRequest{})
+		assert.NoError(t, err)
+
+		ids := make([]basetypes.ID, len(resp.Orders))
+		for i, o := range resp.Orders {
+			ids[i] = o.ID
+		}
+		assert.Equal(t, []basetypes.ID{1, 2, 3}, ids)
+	})
+
+	t.Run("WholeManifest", func(t *testing.T) {
+		m := newTestService(newRepository())
+
+		resp, err := m.CourierHandover(ctx, &orderInterfaces.CourierHandoverRequest{CourierID: 9})
+		assert.NoError(t, err)
+		assert.Equal(t, basetypes.ID(9), resp.Handover.CourierID)
+		assert.Equal(t, []basetypes.ID{1, 2, 3}, resp.Handover.OrderIDs)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		m := newTestService(newRepository())
+
+		_, err := m.CourierHandover(ctx, &orderInterfaces.CourierHandoverRequest{CourierID: 9, OrderIDs: []basetypes.ID{1, 4}})
+		assert.ErrorIs(t, err, orderInterfaces.ErrCantHandOver)
+
+		_, err = m.CourierHandover(ctx, &orderInterfaces.CourierHandoverRequest{CourierID: 9, OrderIDs: []basetypes.ID{1, 5}})
+		assert.ErrorIs(t, err, orderInterfaces.ErrWrongPickupPoint)
+
+		_, err = m.CourierHandover(ctx, &orderInterfaces.CourierHandoverRequest{CourierID: 9, OrderIDs: []basetypes.ID{1, 6}})
+		assert.ErrorIs(t, err, orderInterfaces.ErrOrderNotFound)
+	})
 }
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"github.com/vlad1028/order-manager/internal/models/order"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) SuggestPackaging(ctx context.Context, req *orderServise.SuggestPackagingRequest) (resp *orderServise.SuggestPackagingResponse, err error) {
+	resp = &orderServise.SuggestPackagingResponse{}
+
+	types, err := s.repo.ListPackagingTypes(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	parcel := &order.Order{Weight: req.Weight, Dimensions: req.Dimensions}
+	layers, ok := order.SuggestPackaging(types, parcel, req.Required)
+	if !ok {
+		return resp, orderServise.ErrNoSuitablePackaging
+	}
+
+	resp.Layers = layers
+	for _, l := range layers {
+		resp.Cost += l.Cost
+	}
+	return resp, nil
+}
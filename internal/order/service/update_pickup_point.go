@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	orderServise "github.com/vlad1028/order-manager/internal/order"
+)
+
+func (s *Service) UpdatePickupPoint(ctx context.Context, req *orderServise.UpdatePickupPointRequest) (resp *orderServise.UpdatePickupPointResponse, err error) {
+	resp = &orderServise.UpdatePickupPointResponse{}
+
+	if err = s.repo.UpdatePickupPoint(ctx, req.PickupPoint); err != nil {
+		return resp, err
+	}
+
+	resp.PickupPoint, err = s.repo.GetPickupPoint(ctx, req.PickupPoint.ID)
+	return resp, err
+}
@@ -0,0 +1,39 @@
+package verification
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+)
+
+// WriterNotifier writes pickup codes to w instead of delivering them to clients.
+// It is meant for local runs and tests.
+type WriterNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+// NewFileNotifier returns a WriterNotifier appending pickup codes to the file at path.
+func NewFileNotifier(path string) (*WriterNotifier, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterNotifier(f), nil
+}
+
+func (n *WriterNotifier) NotifyPickupCode(_ context.Context, clientID, pickupPointID basetypes.ID, code string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, err := fmt.Fprintf(n.w, "client %d: pickup code %s for orders at pickup point %d\n", clientID, code, pickupPointID)
+	return err
+}
@@ -0,0 +1,187 @@
+package verification
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+)
+
+var (
+	ErrPickupCodeNotFound = errors.New("pickup code not found")
+	ErrInvalidPickupCode  = errors.New("invalid pickup code")
+	ErrPickupCodeLocked   = errors.New("too many invalid pickup code attempts, try again later")
+)
+
+// codeLength is the number of digits in a pickup code.
+const codeLength = 6
+
+// PickupCode is a one-time code a client confirms receiving orders at a pickup point with.
+// Only the salted hash of the code is stored.
+type PickupCode struct {
+	ClientID      basetypes.ID `db:"client_id"`
+	PickupPointID basetypes.ID `db:"pickup_point_id"`
+	Hash          string       `db:"code_hash"`
+	Salt          string       `db:"salt"`
+	Attempts      uint         `db:"attempts"`     // failed attempts since the code was issued or the last lockout
+	LockedUntil   *time.Time   `db:"locked_until"` // nil if the code is not locked
+}
+
+// Storage defines the interface for keeping pickup codes.
+type Storage interface {
+	// GetPickupCode returns ErrPickupCodeNotFound if the client has no active code at the pickup point.
+	GetPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) (*PickupCode, error)
+	// SavePickupCode replaces the code of the client at the pickup point.
+	SavePickupCode(ctx context.Context, c *PickupCode) error
+	// AddFailedAttempt increments the failed attempts of the code and returns their new number.
+	AddFailedAttempt(ctx context.Context, clientID, pickupPointID basetypes.ID) (uint, error)
+	// LockPickupCode locks the code until the given time and resets its failed attempts.
+	LockPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID, until time.Time) error
+	DeletePickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID) error
+}
+
+// Notifier delivers pickup codes to clients.
+type Notifier interface {
+	NotifyPickupCode(ctx context.Context, clientID, pickupPointID basetypes.ID, code string) error
+}
+
+// Verifier issues pickup codes and checks the codes clients present.
+// After maxAttempts invalid codes in a row the code is locked for the lockout period.
+type Verifier struct {
+	storage     Storage
+	notifier    Notifier
+	maxAttempts uint          // Invalid attempts allowed before the code is locked.
+	lockout     time.Duration // Duration the code stays locked for.
+}
+
+func NewVerifier(storage Storage, notifier Notifier) *Verifier {
+	return &Verifier{
+		storage:     storage,
+		notifier:    notifier,
+		maxAttempts: 5,
+		lockout:     15 * time.Minute,
+	}
+}
+
+// Issue sends a new pickup code to the client unless the client already has an active one at the pickup point.
+func (v *Verifier) Issue(ctx context.Context, clientID, pickupPointID basetypes.ID) error {
+	_, err := v.storage.GetPickupCode(ctx, clientID, pickupPointID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrPickupCodeNotFound) {
+		return err
+	}
+
+	code, err := randomDigits(codeLength)
+	if err != nil {
+		return err
+	}
+	salt, err := randomSalt()
+	if err != nil {
+		return err
+	}
+
+	c := &PickupCode{
+		ClientID:      clientID,
+		PickupPointID: pickupPointID,
+		Hash:          hashCode(code, salt),
+		Salt:          salt,
+	}
+	if err = v.storage.SavePickupCode(ctx, c); err != nil {
+		return err
+	}
+
+	if err = v.notifier.NotifyPickupCode(ctx, clientID, pickupPointID, code); err != nil {
+		// the client can't know the code, so let the next Issue send another one
+		return errors.Join(err, v.storage.DeletePickupCode(ctx, clientID, pickupPointID))
+	}
+	return nil
+}
+
+// Verify returns nil if the code is the active pickup code of the client at the pickup point.
+func (v *Verifier) Verify(ctx context.Context, clientID, pickupPointID basetypes.ID, code string) error {
+	return v.verify(ctx, v.storage, clientID, pickupPointID, code)
+}
+
+// Redeem verifies the code like Verify but against storage and consumes it if it's valid.
+// storage is meant to be bound to the transaction issuing the orders and to lock the code it reads,
+// so the code can't be used twice and parallel guesses can't get past maxAttempts.
+func (v *Verifier) Redeem(ctx context.Context, storage Storage, clientID, pickupPointID basetypes.ID, code string) error {
+	if err := v.verify(ctx, storage, clientID, pickupPointID, code); err != nil {
+		return err
+	}
+	return storage.DeletePickupCode(ctx, clientID, pickupPointID)
+}
+
+func (v *Verifier) verify(ctx context.Context, storage Storage, clientID, pickupPointID basetypes.ID, code string) error {
+	c, err := storage.GetPickupCode(ctx, clientID, pickupPointID)
+	if errors.Is(err, ErrPickupCodeNotFound) {
+		return ErrInvalidPickupCode
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if c.LockedUntil != nil && c.LockedUntil.After(now) {
+		return fmt.Errorf("%w: locked until %s", ErrPickupCodeLocked, c.LockedUntil.Format(time.RFC3339))
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashCode(code, c.Salt)), []byte(c.Hash)) == 1 {
+		return nil
+	}
+
+	attempts, err := storage.AddFailedAttempt(ctx, clientID, pickupPointID)
+	if err != nil {
+		return err
+	}
+	if attempts >= v.maxAttempts {
+		if err = storage.LockPickupCode(ctx, clientID, pickupPointID, now.Add(v.lockout)); err != nil {
+			return err
+		}
+		return ErrPickupCodeLocked
+	}
+	return ErrInvalidPickupCode
+}
+
+// Consume invalidates the pickup code of the client at the pickup point.
+func (v *Verifier) Consume(ctx context.Context, clientID, pickupPointID basetypes.ID) error {
+	err := v.storage.DeletePickupCode(ctx, clientID, pickupPointID)
+	if errors.Is(err, ErrPickupCodeNotFound) {
+		return nil
+	}
+	return err
+}
+
+func hashCode(code, salt string) string {
+	sum := sha256.Sum256([]byte(salt + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomDigits(n int) (string, error) {
+	digits := make([]byte, n)
+	for i := range digits {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		digits[i] = byte('0' + d.Int64())
+	}
+	return string(digits), nil
+}
+
+func randomSalt() (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(salt), nil
+}
@@ -0,0 +1,158 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad1028/order-manager/internal/models/basetypes"
+)
+
+type key struct {
+	clientID, pickupPointID basetypes.ID
+}
+
+type memoryStorage struct {
+	codes map[key]*PickupCode
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{codes: make(map[key]*PickupCode)}
+}
+
+func (s *memoryStorage) GetPickupCode(_ context.Context, clientID, pickupPointID basetypes.ID) (*PickupCode, error) {
+	c, ok := s.codes[key{clientID, pickupPointID}]
+	if !ok {
+		return nil, ErrPickupCodeNotFound
+	}
+	cp := *c
+	return &cp, nil
+}
+
+func (s *memoryStorage) SavePickupCode(_ context.Context, c *PickupCode) error {
+	cp := *c
+	s.codes[key{c.ClientID, c.PickupPointID}] = &cp
+	return nil
+}
+
+func (s *memoryStorage) AddFailedAttempt(_ context.Context, clientID, pickupPointID basetypes.ID) (uint, error) {
+	c, ok := s.codes[key{clientID, pickupPointID}]
+	if !ok {
+		return 0, ErrPickupCodeNotFound
+	}
+	c.Attempts++
+	return c.Attempts, nil
+}
+
+func (s *memoryStorage) LockPickupCode(_ context.Context, clientID, pickupPointID basetypes.ID, until time.Time) error {
+	c, ok := s.codes[key{clientID, pickupPointID}]
+	if !ok {
+		return ErrPickupCodeNotFound
+	}
+	c.Attempts = 0
+	c.LockedUntil = &until
+	return nil
+}
+
+func (s *memoryStorage) DeletePickupCode(_ context.Context, clientID, pickupPointID basetypes.ID) error {
+	if _, ok := s.codes[key{clientID, pickupPointID}]; !ok {
+		return ErrPickupCodeNotFound
+	}
+	delete(s.codes, key{clientID, pickupPointID})
+	return nil
+}
+
+var codePattern = regexp.MustCompile(`pickup code (\d+)`)
+
+// lastCode returns the last pickup code written to the notifier output.
+func lastCode(t *testing.T, out *bytes.Buffer) string {
+	matches := codePattern.FindAllStringSubmatch(out.String(), -1)
+	require.NotEmpty(t, matches)
+	return matches[len(matches)-1][1]
+}
+
+func TestVerifier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("IssueAndVerify", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		storage := newMemoryStorage()
+		v := NewVerifier(storage, NewWriterNotifier(out))
+
+		require.NoError(t, v.Issue(ctx, 1, 2))
+		code := lastCode(t, out)
+		assert.Len(t, code, codeLength)
+		assert.NotContains(t, storage.codes[key{1, 2}].Hash, code)
+
+		assert.NoError(t, v.Verify(ctx, 1, 2, code))
+		assert.ErrorIs(t, v.Verify(ctx, 1, 3, code), ErrInvalidPickupCode)
+		assert.ErrorIs(t, v.Verify(ctx, 2, 2, code), ErrInvalidPickupCode)
+	})
+
+	t.Run("IssueKeepsActiveCode", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		v := NewVerifier(newMemoryStorage(), NewWriterNotifier(out))
+
+		require.NoError(t, v.Issue(ctx, 1, 2))
+		code := lastCode(t, out)
+		require.NoError(t, v.Issue(ctx, 1, 2))
+
+		assert.Equal(t, 1, bytes.Count(out.Bytes(), []byte("\n")))
+		assert.NoError(t, v.Verify(ctx, 1, 2, code))
+	})
+
+	t.Run("Lockout", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		v := NewVerifier(newMemoryStorage(), NewWriterNotifier(out))
+
+		require.NoError(t, v.Issue(ctx, 1, 2))
+		code := lastCode(t, out)
+		wrong := "x" + code[1:]
+
+		for i := uint(1); i < v.maxAttempts; i++ {
+			assert.ErrorIs(t, v.Verify(ctx, 1, 2, wrong), ErrInvalidPickupCode)
+		}
+		assert.ErrorIs(t, v.Verify(ctx, 1, 2, wrong), ErrPickupCodeLocked)
+		assert.ErrorIs(t, v.Verify(ctx, 1, 2, code), ErrPickupCodeLocked)
+
+		v.lockout = 0
+		require.NoError(t, v.Issue(ctx, 3, 2))
+		for i := uint(0); i < v.maxAttempts; i++ {
+			_ = v.Verify(ctx, 3, 2, wrong)
+		}
+		assert.NoError(t, v.Verify(ctx, 3, 2, lastCode(t, out)))
+	})
+
+	t.Run("Consume", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		v := NewVerifier(newMemoryStorage(), NewWriterNotifier(out))
+
+		require.NoError(t, v.Issue(ctx, 1, 2))
+		code := lastCode(t, out)
+
+		require.NoError(t, v.Consume(ctx, 1, 2))
+		assert.ErrorIs(t, v.Verify(ctx, 1, 2, code), ErrInvalidPickupCode)
+		assert.NoError(t, v.Consume(ctx, 1, 2))
+
+		require.NoError(t, v.Issue(ctx, 1, 2))
+		assert.NoError(t, v.Verify(ctx, 1, 2, lastCode(t, out)))
+	})
+	t.Run("Redeem", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		storage := newMemoryStorage()
+		v := NewVerifier(storage, NewWriterNotifier(out))
+
+		require.NoError(t, v.Issue(ctx, 1, 2))
+		code := lastCode(t, out)
+
+		assert.ErrorIs(t, v.Redeem(ctx, storage, 1, 2, "x"+code[1:]), ErrInvalidPickupCode)
+		assert.Equal(t, uint(1), storage.codes[key{1, 2}].Attempts)
+
+		assert.NoError(t, v.Redeem(ctx, storage, 1, 2, code))
+		assert.ErrorIs(t, v.Redeem(ctx, storage, 1, 2, code), ErrInvalidPickupCode)
+	})
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists outbox (
+    id bigserial not null,
+    key text not null,
+    payload json not null,
+    created_at timestamptz not null default now(),
+    sent_at timestamptz,
+    primary key (id)
+);
+
+create index if not exists idx_outbox_pending on outbox (id) where sent_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists outbox;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists order_status_history (
+    id bigserial not null,
+    order_id bigint not null,
+    status text not null,
+    pickup_point_id bigint not null,
+    changed_at timestamptz not null default now(),
+    primary key (id)
+);
+
+create index if not exists idx_order_status_history_order_id on order_status_history (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists order_status_history;
+-- +goose StatementEnd
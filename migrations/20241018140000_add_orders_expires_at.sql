@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders add column if not exists expires_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table orders drop column if exists expires_at;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists pickup_points (
+    id bigint not null,
+    primary key (id)
+);
+
+-- the default pickup point of single-point deployments
+insert into pickup_points (id) values (0) on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists pickup_points;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table pickup_points
+    add column if not exists name text not null default '',
+    add column if not exists address text not null default '',
+    add column if not exists working_hours text not null default '',
+    add column if not exists max_orders bigint not null default 0,
+    add column if not exists max_weight bigint not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table pickup_points
+    drop column if exists name,
+    drop column if exists address,
+    drop column if exists working_hours,
+    drop column if exists max_orders,
+    drop column if exists max_weight;
+-- +goose StatementEnd
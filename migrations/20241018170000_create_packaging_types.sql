@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists packaging_types (
+    name text not null,
+    weight_limit bigint not null default 0, -- 0 means no limit
+    cost bigint not null default 0,
+    can_wrap boolean not null default false,
+    wraps text[] not null default '{}', -- types it may wrap, empty means any
+    primary key (name)
+);
+
+insert into packaging_types (name, weight_limit, cost, can_wrap, wraps) values
+    ('bag', 10, 5, true, '{film}'),
+    ('box', 30, 20, true, '{film}'),
+    ('film', 0, 1, false, '{}')
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists packaging_types;
+-- +goose StatementEnd
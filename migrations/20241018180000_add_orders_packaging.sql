@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders
+    add column if not exists base_cost bigint,
+    add column if not exists packaging jsonb not null default '[]';
+
+-- the packaging of existing orders is unknown, so their whole cost is accounted as the base one
+update orders set base_cost = cost where base_cost is null;
+
+alter table orders alter column base_cost set not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table orders
+    drop column if exists base_cost,
+    drop column if exists packaging;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a box may hold a bag, e.g. box,bag,film
+update packaging_types set wraps = array_append(wraps, 'bag')
+where name = 'box' and can_wrap and wraps <> '{}' and not 'bag' = any(wraps);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+update packaging_types set wraps = array_remove(wraps, 'bag') where name = 'box';
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders
+    add column if not exists length bigint not null default 0,
+    add column if not exists width bigint not null default 0,
+    add column if not exists height bigint not null default 0;
+
+alter table packaging_types
+    add column if not exists max_length bigint not null default 0,
+    add column if not exists max_width bigint not null default 0,
+    add column if not exists max_height bigint not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table packaging_types
+    drop column if exists max_length,
+    drop column if exists max_width,
+    drop column if exists max_height;
+
+alter table orders
+    drop column if exists length,
+    drop column if exists width,
+    drop column if exists height;
+-- +goose StatementEnd
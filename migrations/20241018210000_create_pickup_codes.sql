@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists pickup_codes (
+    client_id bigint not null,
+    pickup_point_id bigint not null,
+    code_hash text not null,
+    salt text not null,
+    attempts bigint not null default 0,
+    locked_until timestamptz,
+    created_at timestamptz not null default now(),
+    primary key (client_id, pickup_point_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists pickup_codes;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists notifications (
+    id bigserial not null,
+    order_id bigint not null,
+    client_id bigint not null,
+    pickup_point_id bigint not null,
+    kind text not null,
+    expires_at timestamptz,
+    status text not null default 'pending',
+    attempts bigint not null default 0,
+    last_error text,
+    next_attempt_at timestamptz not null default now(),
+    created_at timestamptz not null default now(),
+    sent_at timestamptz,
+    primary key (id),
+    unique (order_id, kind)
+);
+
+create index if not exists idx_notifications_pending on notifications (next_attempt_at) where status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists notifications;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists handovers (
+    id bigserial not null,
+    courier_id bigint not null,
+    pickup_point_id bigint not null,
+    signed_off_at timestamptz not null,
+    primary key (id)
+);
+
+create table if not exists handover_orders (
+    handover_id bigint not null references handovers (id),
+    order_id bigint not null,
+    primary key (handover_id, order_id)
+);
+
+create index if not exists idx_handover_orders_order_id on handover_orders (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists handover_orders;
+drop table if exists handovers;
+-- +goose StatementEnd
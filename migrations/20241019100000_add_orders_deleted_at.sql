@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders add column if not exists deleted_at timestamptz;
+
+create index if not exists idx_orders_deleted_at on orders (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_orders_deleted_at;
+alter table orders drop column if exists deleted_at;
+-- +goose StatementEnd
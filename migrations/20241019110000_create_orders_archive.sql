@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- terminal orders moved out of orders, partitioned by the month they were archived in.
+-- The partition manager creates the monthly partitions, the default one catches the rest.
+create table if not exists orders_archive (
+    id bigint not null,
+    client_id bigint not null,
+    pickup_point_id bigint not null,
+    status text not null,
+    status_updated timestamptz not null,
+    weight bigint not null,
+    length bigint not null,
+    width bigint not null,
+    height bigint not null,
+    cost bigint not null,
+    base_cost bigint not null,
+    expires_at timestamptz,
+    packaging jsonb not null,
+    deleted_at timestamptz,
+    archived_at timestamptz not null,
+    primary key (id, archived_at)
+) partition by range (archived_at);
+
+create table if not exists orders_archive_default partition of orders_archive default;
+
+create index if not exists idx_orders_archive_client_id on orders_archive (client_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists orders_archive;
+-- +goose StatementEnd
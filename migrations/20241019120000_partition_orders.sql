@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- orders are partitioned by the month of the last status update, so lists ordered or filtered by it
+-- only scan the recent partitions. A status update moves the order to the partition of the current month.
+-- Primary keys of partitioned tables must contain the partition key, so the uniqueness of IDs
+-- is kept by the repository.
+alter table orders rename to orders_unpartitioned;
+
+create table orders (like orders_unpartitioned including defaults) partition by range (status_updated);
+alter table orders add primary key (id, status_updated);
+
+create table orders_default partition of orders default;
+
+do $$
+declare
+    m timestamptz;
+begin
+    for m in
+        select generate_series(
+            date_trunc('month', coalesce((select min(status_updated) from orders_unpartitioned), now()), 'UTC'),
+            date_trunc('month', now(), 'UTC') + interval '2 months',
+            interval '1 month')
+    loop
+        execute format('create table orders_%s partition of orders for values from (%L) to (%L)',
+            to_char(m at time zone 'UTC', 'YYYYMM'), m, m + interval '1 month');
+    end loop;
+end
+$$;
+
+insert into orders select * from orders_unpartitioned;
+drop table orders_unpartitioned;
+
+create index if not exists idx_orders_client_id on orders (client_id);
+create index if not exists idx_orders_pickup_point_id on orders (pickup_point_id);
+create index if not exists idx_orders_status on orders (status);
+create index if not exists idx_orders_deleted_at on orders (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+create table orders_unpartitioned (like orders including defaults);
+insert into orders_unpartitioned select * from orders;
+drop table orders;
+alter table orders_unpartitioned rename to orders;
+alter table orders add primary key (id);
+
+create index if not exists idx_orders_cid_hash on orders using hash (client_id);
+create index if not exists idx_orders_ppid_hash on orders using hash (pickup_point_id);
+create index if not exists idx_orders_status_hash on orders using hash (status);
+create index if not exists idx_orders_deleted_at on orders (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
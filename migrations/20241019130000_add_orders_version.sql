@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version is incremented by every update of an order, so an update can check the order wasn't changed since it was read
+alter table orders add column if not exists version bigint not null default 1;
+alter table orders_archive add column if not exists version bigint not null default 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table orders_archive drop column if exists version;
+alter table orders drop column if exists version;
+-- +goose StatementEnd
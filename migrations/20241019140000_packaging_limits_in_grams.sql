@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the limits were seeded in kilograms, the orders are weighed in grams
+update packaging_types set weight_limit = weight_limit * 1000 where name in ('bag', 'box') and weight_limit in (10, 30);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+update packaging_types set weight_limit = weight_limit / 1000 where name in ('bag', 'box') and weight_limit in (10000, 30000);
+-- +goose StatementEnd
@@ -0,0 +1,93 @@
+-- +goose Up
+-- +goose StatementBegin
+-- orders were partitioned by the status update time, so every status change moved an order to another partition,
+-- and the primary keys, which must contain the partition key, didn't keep IDs unique.
+-- Now they are partitioned by the month of creation, which never changes, and order_ids keeps IDs unique:
+-- every order references its ID together with its creation time, and an ID has a single creation time.
+-- The IDs stay taken until the orders are archived.
+create table if not exists order_ids (
+    id bigint primary key,
+    created_at timestamptz not null default now(),
+    unique (id, created_at)
+);
+
+create table orders_by_status_updated (like orders including defaults);
+alter table orders_by_status_updated add column created_at timestamptz not null default now();
+-- an ID taken twice keeps its latest version
+insert into orders_by_status_updated
+select distinct on (id) * from orders order by id, version desc, status_updated desc;
+update orders_by_status_updated o set created_at = least(o.status_updated,
+    coalesce((select min(h.changed_at) from order_status_history h where h.order_id = o.id), o.status_updated));
+drop table orders;
+
+create table orders (like orders_by_status_updated including defaults) partition by range (created_at);
+alter table orders add primary key (id, created_at);
+
+create table orders_default partition of orders default;
+
+do $$
+declare
+    m timestamptz;
+begin
+    for m in
+        select generate_series(
+            date_trunc('month', coalesce((select min(created_at) from orders_by_status_updated), now()), 'UTC'),
+            date_trunc('month', now(), 'UTC') + interval '2 months',
+            interval '1 month')
+    loop
+        execute format('create table orders_%s partition of orders for values from (%L) to (%L)',
+            to_char(m at time zone 'UTC', 'YYYYMM'), m, m + interval '1 month');
+    end loop;
+end
+$$;
+
+insert into order_ids (id, created_at) select id, created_at from orders_by_status_updated;
+insert into orders select * from orders_by_status_updated;
+drop table orders_by_status_updated;
+
+alter table orders add foreign key (id, created_at) references order_ids (id, created_at);
+
+create index if not exists idx_orders_client_id on orders (client_id);
+create index if not exists idx_orders_pickup_point_id on orders (pickup_point_id);
+create index if not exists idx_orders_status on orders (status);
+create index if not exists idx_orders_status_updated on orders (status_updated, id);
+create index if not exists idx_orders_deleted_at on orders (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+create table orders_by_created_at (like orders including defaults);
+insert into orders_by_created_at select * from orders;
+drop table orders;
+drop table order_ids;
+alter table orders_by_created_at drop column created_at;
+
+create table orders (like orders_by_created_at including defaults) partition by range (status_updated);
+alter table orders add primary key (id, status_updated);
+
+create table orders_default partition of orders default;
+
+do $$
+declare
+    m timestamptz;
+begin
+    for m in
+        select generate_series(
+            date_trunc('month', coalesce((select min(status_updated) from orders_by_created_at), now()), 'UTC'),
+            date_trunc('month', now(), 'UTC') + interval '2 months',
+            interval '1 month')
+    loop
+        execute format('create table orders_%s partition of orders for values from (%L) to (%L)',
+            to_char(m at time zone 'UTC', 'YYYYMM'), m, m + interval '1 month');
+    end loop;
+end
+$$;
+
+insert into orders select * from orders_by_created_at;
+drop table orders_by_created_at;
+
+create index if not exists idx_orders_client_id on orders (client_id);
+create index if not exists idx_orders_pickup_point_id on orders (pickup_point_id);
+create index if not exists idx_orders_status on orders (status);
+create index if not exists idx_orders_deleted_at on orders (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
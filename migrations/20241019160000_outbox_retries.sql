@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A message that failed to be sent waits until next_attempt_at, and after too many attempts
+-- it is parked as dead so it no longer blocks the following messages with its key.
+alter table outbox add column attempts int not null default 0;
+alter table outbox add column next_attempt_at timestamptz not null default now();
+alter table outbox add column dead_at timestamptz;
+
+drop index if exists idx_outbox_pending;
+create index if not exists idx_outbox_pending on outbox (id) where sent_at is null and dead_at is null;
+create index if not exists idx_outbox_pending_key on outbox (key, id) where sent_at is null and dead_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_outbox_pending_key;
+drop index if exists idx_outbox_pending;
+create index if not exists idx_outbox_pending on outbox (id) where sent_at is null;
+
+alter table outbox drop column dead_at;
+alter table outbox drop column next_attempt_at;
+alter table outbox drop column attempts;
+-- +goose StatementEnd
@@ -97,6 +97,79 @@ func (m *Order) validate(all bool) error {
 
 	// no validation rules for Cost
 
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BaseCost
+
+	for idx, item := range m.GetPackaging() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Packaging[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Packaging[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationError{
+					field:  fmt.Sprintf("Packaging[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Length
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Version
+
 	if len(errors) > 0 {
 		return OrderMultiError(errors)
 	}
@@ -174,83 +247,182 @@ var _ interface {
 	ErrorName() string
 } = OrderValidationError{}
 
-// Validate checks the field values on AcceptOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptOrderRequest) Validate() error {
+// Validate checks the field values on PackagingLayer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PackagingLayer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AcceptOrderRequestMultiError, or nil if none found.
-func (m *AcceptOrderRequest) ValidateAll() error {
+// ValidateAll checks the field values on PackagingLayer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackagingLayerMultiError,
+// or nil if none found.
+func (m *PackagingLayer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptOrderRequest) validate(all bool) error {
+func (m *PackagingLayer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetId() <= 0 {
-		err := AcceptOrderRequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Type
+
+	// no validation rules for Cost
+
+	if len(errors) > 0 {
+		return PackagingLayerMultiError(errors)
 	}
 
-	if m.GetClientId() <= 0 {
-		err := AcceptOrderRequestValidationError{
-			field:  "ClientId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// PackagingLayerMultiError is an error wrapping multiple validation errors
+// returned by PackagingLayer.ValidateAll() if the designated constraints
+// aren't met.
+type PackagingLayerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackagingLayerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.GetWeight() <= 0 {
-		err := AcceptOrderRequestValidationError{
-			field:  "Weight",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// AllErrors returns a list of validation violation errors.
+func (m PackagingLayerMultiError) AllErrors() []error { return m }
+
+// PackagingLayerValidationError is the validation error returned by
+// PackagingLayer.Validate if the designated constraints aren't met.
+type PackagingLayerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackagingLayerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackagingLayerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackagingLayerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackagingLayerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackagingLayerValidationError) ErrorName() string { return "PackagingLayerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackagingLayerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	// no validation rules for Cost
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for AddFilm
+	return fmt.Sprintf(
+		"invalid %sPackagingLayer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	if m.Packaging != nil {
-		// no validation rules for Packaging
+var _ error = PackagingLayerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackagingLayerValidationError{}
+
+// Validate checks the field values on Handover with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Handover) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Handover with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HandoverMultiError, or nil
+// if none found.
+func (m *Handover) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Handover) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CourierId
+
+	// no validation rules for PickupPointId
+
+	if all {
+		switch v := interface{}(m.GetSignedOffAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HandoverValidationError{
+					field:  "SignedOffAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HandoverValidationError{
+					field:  "SignedOffAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignedOffAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HandoverValidationError{
+				field:  "SignedOffAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
+	// no validation rules for OrderIds
+
 	if len(errors) > 0 {
-		return AcceptOrderRequestMultiError(errors)
+		return HandoverMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by AcceptOrderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AcceptOrderRequestMultiError []error
+// HandoverMultiError is an error wrapping multiple validation errors returned
+// by Handover.ValidateAll() if the designated constraints aren't met.
+type HandoverMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptOrderRequestMultiError) Error() string {
+func (m HandoverMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -259,11 +431,11 @@ func (m AcceptOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptOrderRequestMultiError) AllErrors() []error { return m }
+func (m HandoverMultiError) AllErrors() []error { return m }
 
-// AcceptOrderRequestValidationError is the validation error returned by
-// AcceptOrderRequest.Validate if the designated constraints aren't met.
-type AcceptOrderRequestValidationError struct {
+// HandoverValidationError is the validation error returned by
+// Handover.Validate if the designated constraints aren't met.
+type HandoverValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -271,24 +443,22 @@ type AcceptOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptOrderRequestValidationError) Field() string { return e.field }
+func (e HandoverValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptOrderRequestValidationError) Reason() string { return e.reason }
+func (e HandoverValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptOrderRequestValidationError) Cause() error { return e.cause }
+func (e HandoverValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptOrderRequestValidationError) Key() bool { return e.key }
+func (e HandoverValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptOrderRequestValidationError) ErrorName() string {
-	return "AcceptOrderRequestValidationError"
-}
+func (e HandoverValidationError) ErrorName() string { return "HandoverValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AcceptOrderRequestValidationError) Error() string {
+func (e HandoverValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -300,14 +470,3853 @@ func (e AcceptOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptOrderRequest.%s: %s%s",
+		"invalid %sHandover.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandoverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandoverValidationError{}
+
+// Validate checks the field values on OrderStatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderStatusChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderStatusChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderStatusChangeMultiError, or nil if none found.
+func (m *OrderStatusChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderStatusChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	// no validation rules for PickupPointId
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderStatusChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderStatusChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderStatusChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderStatusChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderStatusChangeMultiError is an error wrapping multiple validation errors
+// returned by OrderStatusChange.ValidateAll() if the designated constraints
+// aren't met.
+type OrderStatusChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderStatusChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderStatusChangeMultiError) AllErrors() []error { return m }
+
+// OrderStatusChangeValidationError is the validation error returned by
+// OrderStatusChange.Validate if the designated constraints aren't met.
+type OrderStatusChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderStatusChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderStatusChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderStatusChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderStatusChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderStatusChangeValidationError) ErrorName() string {
+	return "OrderStatusChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderStatusChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderStatusChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderStatusChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderStatusChangeValidationError{}
+
+// Validate checks the field values on AcceptOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptOrderRequestMultiError, or nil if none found.
+func (m *AcceptOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetClientId() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "ClientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeight() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Weight",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cost
+
+	// no validation rules for AddFilm
+
+	if all {
+		switch v := interface{}(m.GetStorageUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "StorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "StorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderRequestValidationError{
+				field:  "StorageUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PackagingType
+
+	for idx, item := range m.GetPackagingLayers() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := AcceptOrderRequestValidationError{
+				field:  fmt.Sprintf("PackagingLayers[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Length
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if m.Packaging != nil {
+		// no validation rules for Packaging
+	}
+
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
+	}
+
+	if len(errors) > 0 {
+		return AcceptOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by AcceptOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AcceptOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptOrderRequestMultiError) AllErrors() []error { return m }
+
+// AcceptOrderRequestValidationError is the validation error returned by
+// AcceptOrderRequest.Validate if the designated constraints aren't met.
+type AcceptOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOrderRequestValidationError) ErrorName() string {
+	return "AcceptOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOrderRequestValidationError{}
+
+// Validate checks the field values on AcceptOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptOrderResponseMultiError, or nil if none found.
+func (m *AcceptOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmpty()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "Empty",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "Empty",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmpty()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderResponseValidationError{
+				field:  "Empty",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptOrderResponseMultiError) AllErrors() []error { return m }
+
+// AcceptOrderResponseValidationError is the validation error returned by
+// AcceptOrderResponse.Validate if the designated constraints aren't met.
+type AcceptOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOrderResponseValidationError) ErrorName() string {
+	return "AcceptOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOrderResponseValidationError{}
+
+// Validate checks the field values on AcceptReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptReturnRequestMultiError, or nil if none found.
+func (m *AcceptReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClientId() <= 0 {
+		err := AcceptReturnRequestValidationError{
+			field:  "ClientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderId() <= 0 {
+		err := AcceptReturnRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
+	}
+
+	if len(errors) > 0 {
+		return AcceptReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptReturnRequestMultiError) AllErrors() []error { return m }
+
+// AcceptReturnRequestValidationError is the validation error returned by
+// AcceptReturnRequest.Validate if the designated constraints aren't met.
+type AcceptReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptReturnRequestValidationError) ErrorName() string {
+	return "AcceptReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptReturnRequestValidationError{}
+
+// Validate checks the field values on AcceptReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptReturnResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptReturnResponseMultiError, or nil if none found.
+func (m *AcceptReturnResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptReturnResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmpty()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptReturnResponseValidationError{
+					field:  "Empty",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptReturnResponseValidationError{
+					field:  "Empty",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmpty()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptReturnResponseValidationError{
+				field:  "Empty",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptReturnResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptReturnResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptReturnResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptReturnResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptReturnResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptReturnResponseMultiError) AllErrors() []error { return m }
+
+// AcceptReturnResponseValidationError is the validation error returned by
+// AcceptReturnResponse.Validate if the designated constraints aren't met.
+type AcceptReturnResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptReturnResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptReturnResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptReturnResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptReturnResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptReturnResponseValidationError) ErrorName() string {
+	return "AcceptReturnResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptReturnResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptReturnResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptReturnResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptReturnResponseValidationError{}
+
+// Validate checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderRequestMultiError, or nil if none found.
+func (m *CancelOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CancelOrderRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+
+// CancelOrderRequestValidationError is the validation error returned by
+// CancelOrderRequest.Validate if the designated constraints aren't met.
+type CancelOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderRequestValidationError) ErrorName() string {
+	return "CancelOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderRequestValidationError{}
+
+// Validate checks the field values on CancelOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderResponseMultiError, or nil if none found.
+func (m *CancelOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEmpty()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelOrderResponseValidationError{
+					field:  "Empty",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelOrderResponseValidationError{
+					field:  "Empty",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmpty()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelOrderResponseValidationError{
+				field:  "Empty",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderResponseMultiError) AllErrors() []error { return m }
+
+// CancelOrderResponseValidationError is the validation error returned by
+// CancelOrderResponse.Validate if the designated constraints aren't met.
+type CancelOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderResponseValidationError) ErrorName() string {
+	return "CancelOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderResponseValidationError{}
+
+// Validate checks the field values on GetOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrdersRequestMultiError, or nil if none found.
+func (m *GetOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClientId() <= 0 {
+		err := GetOrdersRequestValidationError{
+			field:  "ClientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for LocalOnly
+
+	// no validation rules for PageToken
+
+	// no validation rules for PageSize
+
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
+	}
+
+	if len(errors) > 0 {
+		return GetOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrdersRequestMultiError) AllErrors() []error { return m }
+
+// GetOrdersRequestValidationError is the validation error returned by
+// GetOrdersRequest.Validate if the designated constraints aren't met.
+type GetOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrdersRequestValidationError) ErrorName() string { return "GetOrdersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrdersRequestValidationError{}
+
+// Validate checks the field values on GetOrdersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrdersResponseMultiError, or nil if none found.
+func (m *GetOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrdersResponseMultiError) AllErrors() []error { return m }
+
+// GetOrdersResponseValidationError is the validation error returned by
+// GetOrdersResponse.Validate if the designated constraints aren't met.
+type GetOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrdersResponseValidationError) ErrorName() string {
+	return "GetOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrdersResponseValidationError{}
+
+// Validate checks the field values on GetReturnedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnedRequestMultiError, or nil if none found.
+func (m *GetReturnedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPerPage() <= 0 {
+		err := GetReturnedRequestValidationError{
+			field:  "PerPage",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetReturnedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnedRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReturnedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReturnedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnedRequestMultiError) AllErrors() []error { return m }
+
+// GetReturnedRequestValidationError is the validation error returned by
+// GetReturnedRequest.Validate if the designated constraints aren't met.
+type GetReturnedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnedRequestValidationError) ErrorName() string {
+	return "GetReturnedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnedRequestValidationError{}
+
+// Validate checks the field values on GetReturnedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnedResponseMultiError, or nil if none found.
+func (m *GetReturnedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReturnedResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReturnedResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReturnedResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetReturnedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnedResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReturnedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReturnedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnedResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnedResponseValidationError is the validation error returned by
+// GetReturnedResponse.Validate if the designated constraints aren't met.
+type GetReturnedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnedResponseValidationError) ErrorName() string {
+	return "GetReturnedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnedResponseValidationError{}
+
+// Validate checks the field values on OrderSort with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderSort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderSort with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderSortMultiError, or nil
+// if none found.
+func (m *OrderSort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderSort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := OrderSortField_name[int32(m.GetField())]; !ok {
+		err := OrderSortValidationError{
+			field:  "Field",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Desc
+
+	if len(errors) > 0 {
+		return OrderSortMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderSortMultiError is an error wrapping multiple validation errors returned
+// by OrderSort.ValidateAll() if the designated constraints aren't met.
+type OrderSortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderSortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderSortMultiError) AllErrors() []error { return m }
+
+// OrderSortValidationError is the validation error returned by
+// OrderSort.Validate if the designated constraints aren't met.
+type OrderSortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderSortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderSortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderSortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderSortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderSortValidationError) ErrorName() string { return "OrderSortValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderSortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderSort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderSortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderSortValidationError{}
+
+// Validate checks the field values on SearchOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchOrdersRequestMultiError, or nil if none found.
+func (m *SearchOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, ok := OrderStatus_name[int32(item)]; !ok {
+			err := SearchOrdersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatusUpdatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "StatusUpdatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "StatusUpdatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusUpdatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "StatusUpdatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatusUpdatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "StatusUpdatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "StatusUpdatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusUpdatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "StatusUpdatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSort() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchOrdersRequestValidationError{
+						field:  fmt.Sprintf("Sort[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchOrdersRequestValidationError{
+						field:  fmt.Sprintf("Sort[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchOrdersRequestValidationError{
+					field:  fmt.Sprintf("Sort[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeArchived
+
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
+	}
+
+	if m.MinWeight != nil {
+		// no validation rules for MinWeight
+	}
+
+	if m.MaxWeight != nil {
+		// no validation rules for MaxWeight
+	}
+
+	if m.MinCost != nil {
+		// no validation rules for MinCost
+	}
+
+	if m.MaxCost != nil {
+		// no validation rules for MaxCost
+	}
+
+	if len(errors) > 0 {
+		return SearchOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchOrdersRequestMultiError) AllErrors() []error { return m }
+
+// SearchOrdersRequestValidationError is the validation error returned by
+// SearchOrdersRequest.Validate if the designated constraints aren't met.
+type SearchOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchOrdersRequestValidationError) ErrorName() string {
+	return "SearchOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchOrdersRequestValidationError{}
+
+// Validate checks the field values on SearchOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchOrdersResponseMultiError, or nil if none found.
+func (m *SearchOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchOrdersResponseMultiError) AllErrors() []error { return m }
+
+// SearchOrdersResponseValidationError is the validation error returned by
+// SearchOrdersResponse.Validate if the designated constraints aren't met.
+type SearchOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchOrdersResponseValidationError) ErrorName() string {
+	return "SearchOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchOrdersResponseValidationError{}
+
+// Validate checks the field values on IssueOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IssueOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueOrderRequestMultiError, or nil if none found.
+func (m *IssueOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := IssueOrderRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := IssueOrderRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClientId() <= 0 {
+		err := IssueOrderRequestValidationError{
+			field:  "ClientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IssueMode_name[int32(m.GetMode())]; !ok {
+		err := IssueOrderRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := IssueOrderRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
+	}
+
+	if len(errors) > 0 {
+		return IssueOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueOrderRequestMultiError) AllErrors() []error { return m }
+
+// IssueOrderRequestValidationError is the validation error returned by
+// IssueOrderRequest.Validate if the designated constraints aren't met.
+type IssueOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueOrderRequestValidationError) ErrorName() string {
+	return "IssueOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueOrderRequestValidationError{}
+
+// Validate checks the field values on IssueResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueResultMultiError, or
+// nil if none found.
+func (m *IssueResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return IssueResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueResultMultiError is an error wrapping multiple validation errors
+// returned by IssueResult.ValidateAll() if the designated constraints aren't
+// met.
+type IssueResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueResultMultiError) AllErrors() []error { return m }
+
+// IssueResultValidationError is the validation error returned by
+// IssueResult.Validate if the designated constraints aren't met.
+type IssueResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueResultValidationError) ErrorName() string { return "IssueResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueResultValidationError{}
+
+// Validate checks the field values on IssueOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueOrderResponseMultiError, or nil if none found.
+func (m *IssueOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssueOrderResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssueOrderResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IssueOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by IssueOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IssueOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueOrderResponseMultiError) AllErrors() []error { return m }
+
+// IssueOrderResponseValidationError is the validation error returned by
+// IssueOrderResponse.Validate if the designated constraints aren't met.
+type IssueOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueOrderResponseValidationError) ErrorName() string {
+	return "IssueOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueOrderResponseValidationError{}
+
+// Validate checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := GetOrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryRequestValidationError{}
+
+// Validate checks the field values on GetOrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryResponseValidationError{}
+
+// Validate checks the field values on PickupPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PickupPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PickupPointMultiError, or
+// nil if none found.
+func (m *PickupPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Address
+
+	// no validation rules for WorkingHours
+
+	// no validation rules for MaxOrders
+
+	// no validation rules for MaxWeight
+
+	// no validation rules for StoredOrders
+
+	// no validation rules for StoredWeight
+
+	if len(errors) > 0 {
+		return PickupPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupPointMultiError is an error wrapping multiple validation errors
+// returned by PickupPoint.ValidateAll() if the designated constraints aren't
+// met.
+type PickupPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupPointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupPointMultiError) AllErrors() []error { return m }
+
+// PickupPointValidationError is the validation error returned by
+// PickupPoint.Validate if the designated constraints aren't met.
+type PickupPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupPointValidationError) ErrorName() string { return "PickupPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupPointValidationError{}
+
+// Validate checks the field values on CreatePickupPointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePickupPointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePickupPointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePickupPointRequestMultiError, or nil if none found.
+func (m *CreatePickupPointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePickupPointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CreatePickupPointRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreatePickupPointRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := CreatePickupPointRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WorkingHours
+
+	// no validation rules for MaxOrders
+
+	// no validation rules for MaxWeight
+
+	if len(errors) > 0 {
+		return CreatePickupPointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePickupPointRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePickupPointRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePickupPointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePickupPointRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePickupPointRequestMultiError) AllErrors() []error { return m }
+
+// CreatePickupPointRequestValidationError is the validation error returned by
+// CreatePickupPointRequest.Validate if the designated constraints aren't met.
+type CreatePickupPointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePickupPointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePickupPointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePickupPointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePickupPointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePickupPointRequestValidationError) ErrorName() string {
+	return "CreatePickupPointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePickupPointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePickupPointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePickupPointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePickupPointRequestValidationError{}
+
+// Validate checks the field values on CreatePickupPointResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePickupPointResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePickupPointResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePickupPointResponseMultiError, or nil if none found.
+func (m *CreatePickupPointResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePickupPointResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPickupPoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePickupPointResponseValidationError{
+					field:  "PickupPoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePickupPointResponseValidationError{
+					field:  "PickupPoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPickupPoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePickupPointResponseValidationError{
+				field:  "PickupPoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePickupPointResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePickupPointResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePickupPointResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreatePickupPointResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePickupPointResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePickupPointResponseMultiError) AllErrors() []error { return m }
+
+// CreatePickupPointResponseValidationError is the validation error returned by
+// CreatePickupPointResponse.Validate if the designated constraints aren't met.
+type CreatePickupPointResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePickupPointResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePickupPointResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePickupPointResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePickupPointResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePickupPointResponseValidationError) ErrorName() string {
+	return "CreatePickupPointResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePickupPointResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePickupPointResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePickupPointResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePickupPointResponseValidationError{}
+
+// Validate checks the field values on UpdatePickupPointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePickupPointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePickupPointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePickupPointRequestMultiError, or nil if none found.
+func (m *UpdatePickupPointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePickupPointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdatePickupPointRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdatePickupPointRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := UpdatePickupPointRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WorkingHours
+
+	// no validation rules for MaxOrders
+
+	// no validation rules for MaxWeight
+
+	if len(errors) > 0 {
+		return UpdatePickupPointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePickupPointRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePickupPointRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePickupPointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePickupPointRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePickupPointRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePickupPointRequestValidationError is the validation error returned by
+// UpdatePickupPointRequest.Validate if the designated constraints aren't met.
+type UpdatePickupPointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePickupPointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePickupPointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePickupPointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePickupPointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePickupPointRequestValidationError) ErrorName() string {
+	return "UpdatePickupPointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePickupPointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePickupPointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePickupPointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePickupPointRequestValidationError{}
+
+// Validate checks the field values on UpdatePickupPointResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePickupPointResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePickupPointResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePickupPointResponseMultiError, or nil if none found.
+func (m *UpdatePickupPointResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePickupPointResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPickupPoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePickupPointResponseValidationError{
+					field:  "PickupPoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePickupPointResponseValidationError{
+					field:  "PickupPoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPickupPoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePickupPointResponseValidationError{
+				field:  "PickupPoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePickupPointResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePickupPointResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePickupPointResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdatePickupPointResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePickupPointResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePickupPointResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePickupPointResponseValidationError is the validation error returned by
+// UpdatePickupPointResponse.Validate if the designated constraints aren't met.
+type UpdatePickupPointResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePickupPointResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePickupPointResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePickupPointResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePickupPointResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePickupPointResponseValidationError) ErrorName() string {
+	return "UpdatePickupPointResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePickupPointResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePickupPointResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePickupPointResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePickupPointResponseValidationError{}
+
+// Validate checks the field values on ListPickupPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPickupPointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPickupPointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPickupPointsRequestMultiError, or nil if none found.
+func (m *ListPickupPointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPickupPointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPickupPointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPickupPointsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPickupPointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPickupPointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPickupPointsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPickupPointsRequestMultiError) AllErrors() []error { return m }
+
+// ListPickupPointsRequestValidationError is the validation error returned by
+// ListPickupPointsRequest.Validate if the designated constraints aren't met.
+type ListPickupPointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPickupPointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPickupPointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPickupPointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPickupPointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPickupPointsRequestValidationError) ErrorName() string {
+	return "ListPickupPointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPickupPointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPickupPointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPickupPointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPickupPointsRequestValidationError{}
+
+// Validate checks the field values on ListPickupPointsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPickupPointsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPickupPointsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPickupPointsResponseMultiError, or nil if none found.
+func (m *ListPickupPointsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPickupPointsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPickupPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPickupPointsResponseValidationError{
+						field:  fmt.Sprintf("PickupPoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPickupPointsResponseValidationError{
+						field:  fmt.Sprintf("PickupPoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPickupPointsResponseValidationError{
+					field:  fmt.Sprintf("PickupPoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPickupPointsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPickupPointsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPickupPointsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPickupPointsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPickupPointsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPickupPointsResponseMultiError) AllErrors() []error { return m }
+
+// ListPickupPointsResponseValidationError is the validation error returned by
+// ListPickupPointsResponse.Validate if the designated constraints aren't met.
+type ListPickupPointsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPickupPointsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPickupPointsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPickupPointsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPickupPointsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPickupPointsResponseValidationError) ErrorName() string {
+	return "ListPickupPointsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPickupPointsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPickupPointsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPickupPointsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPickupPointsResponseValidationError{}
+
+// Validate checks the field values on PackagingType with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PackagingType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackagingType with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackagingTypeMultiError, or
+// nil if none found.
+func (m *PackagingType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackagingType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := PackagingTypeValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WeightLimit
+
+	// no validation rules for Cost
+
+	// no validation rules for CanWrap
+
+	for idx, item := range m.GetWraps() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := PackagingTypeValidationError{
+				field:  fmt.Sprintf("Wraps[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for MaxLength
+
+	// no validation rules for MaxWidth
+
+	// no validation rules for MaxHeight
+
+	if len(errors) > 0 {
+		return PackagingTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackagingTypeMultiError is an error wrapping multiple validation errors
+// returned by PackagingType.ValidateAll() if the designated constraints
+// aren't met.
+type PackagingTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackagingTypeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackagingTypeMultiError) AllErrors() []error { return m }
+
+// PackagingTypeValidationError is the validation error returned by
+// PackagingType.Validate if the designated constraints aren't met.
+type PackagingTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackagingTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackagingTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackagingTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackagingTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackagingTypeValidationError) ErrorName() string { return "PackagingTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackagingTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackagingType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackagingTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackagingTypeValidationError{}
+
+// Validate checks the field values on SavePackagingTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SavePackagingTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavePackagingTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavePackagingTypeRequestMultiError, or nil if none found.
+func (m *SavePackagingTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavePackagingTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPackagingType() == nil {
+		err := SavePackagingTypeRequestValidationError{
+			field:  "PackagingType",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackagingType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavePackagingTypeRequestValidationError{
+					field:  "PackagingType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavePackagingTypeRequestValidationError{
+					field:  "PackagingType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackagingType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavePackagingTypeRequestValidationError{
+				field:  "PackagingType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SavePackagingTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavePackagingTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by SavePackagingTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SavePackagingTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavePackagingTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavePackagingTypeRequestMultiError) AllErrors() []error { return m }
+
+// SavePackagingTypeRequestValidationError is the validation error returned by
+// SavePackagingTypeRequest.Validate if the designated constraints aren't met.
+type SavePackagingTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavePackagingTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavePackagingTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavePackagingTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavePackagingTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavePackagingTypeRequestValidationError) ErrorName() string {
+	return "SavePackagingTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavePackagingTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavePackagingTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptOrderRequestValidationError{}
+var _ error = SavePackagingTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -315,73 +4324,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptOrderRequestValidationError{}
+} = SavePackagingTypeRequestValidationError{}
 
-// Validate checks the field values on AcceptOrderResponse with the rules
+// Validate checks the field values on SavePackagingTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptOrderResponse) Validate() error {
+func (m *SavePackagingTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SavePackagingTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptOrderResponseMultiError, or nil if none found.
-func (m *AcceptOrderResponse) ValidateAll() error {
+// SavePackagingTypeResponseMultiError, or nil if none found.
+func (m *SavePackagingTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptOrderResponse) validate(all bool) error {
+func (m *SavePackagingTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEmpty()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AcceptOrderResponseValidationError{
-					field:  "Empty",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AcceptOrderResponseValidationError{
-					field:  "Empty",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEmpty()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AcceptOrderResponseValidationError{
-				field:  "Empty",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Created
 
 	if len(errors) > 0 {
-		return AcceptOrderResponseMultiError(errors)
+		return SavePackagingTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptOrderResponseMultiError is an error wrapping multiple validation
-// errors returned by AcceptOrderResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AcceptOrderResponseMultiError []error
+// SavePackagingTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by SavePackagingTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SavePackagingTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptOrderResponseMultiError) Error() string {
+func (m SavePackagingTypeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -390,11 +4372,11 @@ func (m AcceptOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptOrderResponseMultiError) AllErrors() []error { return m }
+func (m SavePackagingTypeResponseMultiError) AllErrors() []error { return m }
 
-// AcceptOrderResponseValidationError is the validation error returned by
-// AcceptOrderResponse.Validate if the designated constraints aren't met.
-type AcceptOrderResponseValidationError struct {
+// SavePackagingTypeResponseValidationError is the validation error returned by
+// SavePackagingTypeResponse.Validate if the designated constraints aren't met.
+type SavePackagingTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -402,24 +4384,24 @@ type AcceptOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptOrderResponseValidationError) Field() string { return e.field }
+func (e SavePackagingTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptOrderResponseValidationError) Reason() string { return e.reason }
+func (e SavePackagingTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptOrderResponseValidationError) Cause() error { return e.cause }
+func (e SavePackagingTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptOrderResponseValidationError) Key() bool { return e.key }
+func (e SavePackagingTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptOrderResponseValidationError) ErrorName() string {
-	return "AcceptOrderResponseValidationError"
+func (e SavePackagingTypeResponseValidationError) ErrorName() string {
+	return "SavePackagingTypeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AcceptOrderResponseValidationError) Error() string {
+func (e SavePackagingTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -431,14 +4413,14 @@ func (e AcceptOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptOrderResponse.%s: %s%s",
+		"invalid %sSavePackagingTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptOrderResponseValidationError{}
+var _ error = SavePackagingTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -446,45 +4428,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptOrderResponseValidationError{}
+} = SavePackagingTypeResponseValidationError{}
 
-// Validate checks the field values on AcceptReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeletePackagingTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptReturnRequest) Validate() error {
+func (m *DeletePackagingTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeletePackagingTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptReturnRequestMultiError, or nil if none found.
-func (m *AcceptReturnRequest) ValidateAll() error {
+// DeletePackagingTypeRequestMultiError, or nil if none found.
+func (m *DeletePackagingTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptReturnRequest) validate(all bool) error {
+func (m *DeletePackagingTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetClientId() <= 0 {
-		err := AcceptReturnRequestValidationError{
-			field:  "ClientId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetOrderId() <= 0 {
-		err := AcceptReturnRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeletePackagingTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -493,19 +4464,19 @@ func (m *AcceptReturnRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AcceptReturnRequestMultiError(errors)
+		return DeletePackagingTypeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by AcceptReturnRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AcceptReturnRequestMultiError []error
+// DeletePackagingTypeRequestMultiError is an error wrapping multiple
+// validation errors returned by DeletePackagingTypeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePackagingTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptReturnRequestMultiError) Error() string {
+func (m DeletePackagingTypeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -514,11 +4485,12 @@ func (m AcceptReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptReturnRequestMultiError) AllErrors() []error { return m }
+func (m DeletePackagingTypeRequestMultiError) AllErrors() []error { return m }
 
-// AcceptReturnRequestValidationError is the validation error returned by
-// AcceptReturnRequest.Validate if the designated constraints aren't met.
-type AcceptReturnRequestValidationError struct {
+// DeletePackagingTypeRequestValidationError is the validation error returned
+// by DeletePackagingTypeRequest.Validate if the designated constraints aren't
+// met.
+type DeletePackagingTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -526,24 +4498,24 @@ type AcceptReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptReturnRequestValidationError) Field() string { return e.field }
+func (e DeletePackagingTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptReturnRequestValidationError) Reason() string { return e.reason }
+func (e DeletePackagingTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptReturnRequestValidationError) Cause() error { return e.cause }
+func (e DeletePackagingTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptReturnRequestValidationError) Key() bool { return e.key }
+func (e DeletePackagingTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptReturnRequestValidationError) ErrorName() string {
-	return "AcceptReturnRequestValidationError"
+func (e DeletePackagingTypeRequestValidationError) ErrorName() string {
+	return "DeletePackagingTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AcceptReturnRequestValidationError) Error() string {
+func (e DeletePackagingTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -555,14 +4527,14 @@ func (e AcceptReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptReturnRequest.%s: %s%s",
+		"invalid %sDeletePackagingTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptReturnRequestValidationError{}
+var _ error = DeletePackagingTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -570,24 +4542,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptReturnRequestValidationError{}
+} = DeletePackagingTypeRequestValidationError{}
 
-// Validate checks the field values on AcceptReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeletePackagingTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptReturnResponse) Validate() error {
+func (m *DeletePackagingTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeletePackagingTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptReturnResponseMultiError, or nil if none found.
-func (m *AcceptReturnResponse) ValidateAll() error {
+// DeletePackagingTypeResponseMultiError, or nil if none found.
+func (m *DeletePackagingTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptReturnResponse) validate(all bool) error {
+func (m *DeletePackagingTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -598,7 +4570,7 @@ func (m *AcceptReturnResponse) validate(all bool) error {
 		switch v := interface{}(m.GetEmpty()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AcceptReturnResponseValidationError{
+				errors = append(errors, DeletePackagingTypeResponseValidationError{
 					field:  "Empty",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -606,7 +4578,7 @@ func (m *AcceptReturnResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AcceptReturnResponseValidationError{
+				errors = append(errors, DeletePackagingTypeResponseValidationError{
 					field:  "Empty",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -615,7 +4587,7 @@ func (m *AcceptReturnResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetEmpty()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AcceptReturnResponseValidationError{
+			return DeletePackagingTypeResponseValidationError{
 				field:  "Empty",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -624,19 +4596,19 @@ func (m *AcceptReturnResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AcceptReturnResponseMultiError(errors)
+		return DeletePackagingTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptReturnResponseMultiError is an error wrapping multiple validation
-// errors returned by AcceptReturnResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AcceptReturnResponseMultiError []error
+// DeletePackagingTypeResponseMultiError is an error wrapping multiple
+// validation errors returned by DeletePackagingTypeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePackagingTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptReturnResponseMultiError) Error() string {
+func (m DeletePackagingTypeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -645,11 +4617,12 @@ func (m AcceptReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptReturnResponseMultiError) AllErrors() []error { return m }
+func (m DeletePackagingTypeResponseMultiError) AllErrors() []error { return m }
 
-// AcceptReturnResponseValidationError is the validation error returned by
-// AcceptReturnResponse.Validate if the designated constraints aren't met.
-type AcceptReturnResponseValidationError struct {
+// DeletePackagingTypeResponseValidationError is the validation error returned
+// by DeletePackagingTypeResponse.Validate if the designated constraints
+// aren't met.
+type DeletePackagingTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -657,24 +4630,24 @@ type AcceptReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptReturnResponseValidationError) Field() string { return e.field }
+func (e DeletePackagingTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptReturnResponseValidationError) Reason() string { return e.reason }
+func (e DeletePackagingTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptReturnResponseValidationError) Cause() error { return e.cause }
+func (e DeletePackagingTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptReturnResponseValidationError) Key() bool { return e.key }
+func (e DeletePackagingTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptReturnResponseValidationError) ErrorName() string {
-	return "AcceptReturnResponseValidationError"
+func (e DeletePackagingTypeResponseValidationError) ErrorName() string {
+	return "DeletePackagingTypeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AcceptReturnResponseValidationError) Error() string {
+func (e DeletePackagingTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -686,14 +4659,14 @@ func (e AcceptReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptReturnResponse.%s: %s%s",
+		"invalid %sDeletePackagingTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptReturnResponseValidationError{}
+var _ error = DeletePackagingTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -701,55 +4674,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptReturnResponseValidationError{}
+} = DeletePackagingTypeResponseValidationError{}
 
-// Validate checks the field values on CancelOrderRequest with the rules
+// Validate checks the field values on ListPackagingTypesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelOrderRequest) Validate() error {
+func (m *ListPackagingTypesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListPackagingTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelOrderRequestMultiError, or nil if none found.
-func (m *CancelOrderRequest) ValidateAll() error {
+// ListPackagingTypesRequestMultiError, or nil if none found.
+func (m *ListPackagingTypesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelOrderRequest) validate(all bool) error {
+func (m *ListPackagingTypesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetId() <= 0 {
-		err := CancelOrderRequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return CancelOrderRequestMultiError(errors)
+		return ListPackagingTypesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by CancelOrderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CancelOrderRequestMultiError []error
+// ListPackagingTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPackagingTypesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPackagingTypesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelOrderRequestMultiError) Error() string {
+func (m ListPackagingTypesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -758,11 +4720,11 @@ func (m CancelOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+func (m ListPackagingTypesRequestMultiError) AllErrors() []error { return m }
 
-// CancelOrderRequestValidationError is the validation error returned by
-// CancelOrderRequest.Validate if the designated constraints aren't met.
-type CancelOrderRequestValidationError struct {
+// ListPackagingTypesRequestValidationError is the validation error returned by
+// ListPackagingTypesRequest.Validate if the designated constraints aren't met.
+type ListPackagingTypesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -770,24 +4732,24 @@ type CancelOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelOrderRequestValidationError) Field() string { return e.field }
+func (e ListPackagingTypesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+func (e ListPackagingTypesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+func (e ListPackagingTypesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+func (e ListPackagingTypesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelOrderRequestValidationError) ErrorName() string {
-	return "CancelOrderRequestValidationError"
+func (e ListPackagingTypesRequestValidationError) ErrorName() string {
+	return "ListPackagingTypesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelOrderRequestValidationError) Error() string {
+func (e ListPackagingTypesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -799,14 +4761,14 @@ func (e CancelOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelOrderRequest.%s: %s%s",
+		"invalid %sListPackagingTypesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelOrderRequestValidationError{}
+var _ error = ListPackagingTypesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -814,73 +4776,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelOrderRequestValidationError{}
+} = ListPackagingTypesRequestValidationError{}
 
-// Validate checks the field values on CancelOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListPackagingTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelOrderResponse) Validate() error {
+func (m *ListPackagingTypesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListPackagingTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelOrderResponseMultiError, or nil if none found.
-func (m *CancelOrderResponse) ValidateAll() error {
+// ListPackagingTypesResponseMultiError, or nil if none found.
+func (m *ListPackagingTypesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelOrderResponse) validate(all bool) error {
+func (m *ListPackagingTypesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEmpty()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CancelOrderResponseValidationError{
-					field:  "Empty",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetPackagingTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPackagingTypesResponseValidationError{
+						field:  fmt.Sprintf("PackagingTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPackagingTypesResponseValidationError{
+						field:  fmt.Sprintf("PackagingTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CancelOrderResponseValidationError{
-					field:  "Empty",
+				return ListPackagingTypesResponseValidationError{
+					field:  fmt.Sprintf("PackagingTypes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEmpty()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CancelOrderResponseValidationError{
-				field:  "Empty",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CancelOrderResponseMultiError(errors)
+		return ListPackagingTypesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelOrderResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelOrderResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CancelOrderResponseMultiError []error
+// ListPackagingTypesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPackagingTypesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPackagingTypesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelOrderResponseMultiError) Error() string {
+func (m ListPackagingTypesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -889,11 +4856,12 @@ func (m CancelOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelOrderResponseMultiError) AllErrors() []error { return m }
+func (m ListPackagingTypesResponseMultiError) AllErrors() []error { return m }
 
-// CancelOrderResponseValidationError is the validation error returned by
-// CancelOrderResponse.Validate if the designated constraints aren't met.
-type CancelOrderResponseValidationError struct {
+// ListPackagingTypesResponseValidationError is the validation error returned
+// by ListPackagingTypesResponse.Validate if the designated constraints aren't
+// met.
+type ListPackagingTypesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -901,24 +4869,24 @@ type CancelOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelOrderResponseValidationError) Field() string { return e.field }
+func (e ListPackagingTypesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelOrderResponseValidationError) Reason() string { return e.reason }
+func (e ListPackagingTypesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelOrderResponseValidationError) Cause() error { return e.cause }
+func (e ListPackagingTypesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelOrderResponseValidationError) Key() bool { return e.key }
+func (e ListPackagingTypesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelOrderResponseValidationError) ErrorName() string {
-	return "CancelOrderResponseValidationError"
+func (e ListPackagingTypesResponseValidationError) ErrorName() string {
+	return "ListPackagingTypesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelOrderResponseValidationError) Error() string {
+func (e ListPackagingTypesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -930,14 +4898,14 @@ func (e CancelOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelOrderResponse.%s: %s%s",
+		"invalid %sListPackagingTypesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelOrderResponseValidationError{}
+var _ error = ListPackagingTypesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -945,33 +4913,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelOrderResponseValidationError{}
+} = ListPackagingTypesResponseValidationError{}
 
-// Validate checks the field values on GetOrdersRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetOrdersRequest) Validate() error {
+// Validate checks the field values on SuggestPackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestPackagingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOrdersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SuggestPackagingRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOrdersRequestMultiError, or nil if none found.
-func (m *GetOrdersRequest) ValidateAll() error {
+// SuggestPackagingRequestMultiError, or nil if none found.
+func (m *SuggestPackagingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOrdersRequest) validate(all bool) error {
+func (m *SuggestPackagingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
-
-	if m.GetClientId() <= 0 {
-		err := GetOrdersRequestValidationError{
-			field:  "ClientId",
+
+	if m.GetWeight() <= 0 {
+		err := SuggestPackagingRequestValidationError{
+			field:  "Weight",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -980,22 +4948,42 @@ func (m *GetOrdersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for LocalOnly
+	// no validation rules for Length
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	for idx, item := range m.GetRequired() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := SuggestPackagingRequestValidationError{
+				field:  fmt.Sprintf("Required[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetOrdersRequestMultiError(errors)
+		return SuggestPackagingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOrdersRequestMultiError is an error wrapping multiple validation errors
-// returned by GetOrdersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetOrdersRequestMultiError []error
+// SuggestPackagingRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestPackagingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestPackagingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOrdersRequestMultiError) Error() string {
+func (m SuggestPackagingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1004,11 +4992,11 @@ func (m GetOrdersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOrdersRequestMultiError) AllErrors() []error { return m }
+func (m SuggestPackagingRequestMultiError) AllErrors() []error { return m }
 
-// GetOrdersRequestValidationError is the validation error returned by
-// GetOrdersRequest.Validate if the designated constraints aren't met.
-type GetOrdersRequestValidationError struct {
+// SuggestPackagingRequestValidationError is the validation error returned by
+// SuggestPackagingRequest.Validate if the designated constraints aren't met.
+type SuggestPackagingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1016,22 +5004,24 @@ type GetOrdersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOrdersRequestValidationError) Field() string { return e.field }
+func (e SuggestPackagingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOrdersRequestValidationError) Reason() string { return e.reason }
+func (e SuggestPackagingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOrdersRequestValidationError) Cause() error { return e.cause }
+func (e SuggestPackagingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOrdersRequestValidationError) Key() bool { return e.key }
+func (e SuggestPackagingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOrdersRequestValidationError) ErrorName() string { return "GetOrdersRequestValidationError" }
+func (e SuggestPackagingRequestValidationError) ErrorName() string {
+	return "SuggestPackagingRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetOrdersRequestValidationError) Error() string {
+func (e SuggestPackagingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1043,14 +5033,14 @@ func (e GetOrdersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOrdersRequest.%s: %s%s",
+		"invalid %sSuggestPackagingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOrdersRequestValidationError{}
+var _ error = SuggestPackagingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1058,47 +5048,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOrdersRequestValidationError{}
+} = SuggestPackagingRequestValidationError{}
 
-// Validate checks the field values on GetOrdersResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetOrdersResponse) Validate() error {
+// Validate checks the field values on SuggestPackagingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestPackagingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOrdersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SuggestPackagingResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOrdersResponseMultiError, or nil if none found.
-func (m *GetOrdersResponse) ValidateAll() error {
+// SuggestPackagingResponseMultiError, or nil if none found.
+func (m *SuggestPackagingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOrdersResponse) validate(all bool) error {
+func (m *SuggestPackagingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOrders() {
+	for idx, item := range m.GetLayers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetOrdersResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
+					errors = append(errors, SuggestPackagingResponseValidationError{
+						field:  fmt.Sprintf("Layers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetOrdersResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
+					errors = append(errors, SuggestPackagingResponseValidationError{
+						field:  fmt.Sprintf("Layers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1106,8 +5096,8 @@ func (m *GetOrdersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetOrdersResponseValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
+				return SuggestPackagingResponseValidationError{
+					field:  fmt.Sprintf("Layers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1116,20 +5106,22 @@ func (m *GetOrdersResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Cost
+
 	if len(errors) > 0 {
-		return GetOrdersResponseMultiError(errors)
+		return SuggestPackagingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOrdersResponseMultiError is an error wrapping multiple validation errors
-// returned by GetOrdersResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetOrdersResponseMultiError []error
+// SuggestPackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by SuggestPackagingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestPackagingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOrdersResponseMultiError) Error() string {
+func (m SuggestPackagingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1138,11 +5130,11 @@ func (m GetOrdersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOrdersResponseMultiError) AllErrors() []error { return m }
+func (m SuggestPackagingResponseMultiError) AllErrors() []error { return m }
 
-// GetOrdersResponseValidationError is the validation error returned by
-// GetOrdersResponse.Validate if the designated constraints aren't met.
-type GetOrdersResponseValidationError struct {
+// SuggestPackagingResponseValidationError is the validation error returned by
+// SuggestPackagingResponse.Validate if the designated constraints aren't met.
+type SuggestPackagingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1150,24 +5142,24 @@ type GetOrdersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOrdersResponseValidationError) Field() string { return e.field }
+func (e SuggestPackagingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOrdersResponseValidationError) Reason() string { return e.reason }
+func (e SuggestPackagingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOrdersResponseValidationError) Cause() error { return e.cause }
+func (e SuggestPackagingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOrdersResponseValidationError) Key() bool { return e.key }
+func (e SuggestPackagingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOrdersResponseValidationError) ErrorName() string {
-	return "GetOrdersResponseValidationError"
+func (e SuggestPackagingResponseValidationError) ErrorName() string {
+	return "SuggestPackagingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetOrdersResponseValidationError) Error() string {
+func (e SuggestPackagingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1179,14 +5171,14 @@ func (e GetOrdersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOrdersResponse.%s: %s%s",
+		"invalid %sSuggestPackagingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOrdersResponseValidationError{}
+var _ error = SuggestPackagingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1194,57 +5186,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOrdersResponseValidationError{}
+} = SuggestPackagingResponseValidationError{}
 
-// Validate checks the field values on GetReturnedRequest with the rules
+// Validate checks the field values on GetCourierManifestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnedRequest) Validate() error {
+func (m *GetCourierManifestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnedRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCourierManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnedRequestMultiError, or nil if none found.
-func (m *GetReturnedRequest) ValidateAll() error {
+// GetCourierManifestRequestMultiError, or nil if none found.
+func (m *GetCourierManifestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnedRequest) validate(all bool) error {
+func (m *GetCourierManifestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	if m.GetPerPage() <= 0 {
-		err := GetReturnedRequestValidationError{
-			field:  "PerPage",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
 	}
 
 	if len(errors) > 0 {
-		return GetReturnedRequestMultiError(errors)
+		return GetCourierManifestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnedRequestMultiError is an error wrapping multiple validation errors
-// returned by GetReturnedRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetReturnedRequestMultiError []error
+// GetCourierManifestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCourierManifestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCourierManifestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnedRequestMultiError) Error() string {
+func (m GetCourierManifestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1253,11 +5236,11 @@ func (m GetReturnedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnedRequestMultiError) AllErrors() []error { return m }
+func (m GetCourierManifestRequestMultiError) AllErrors() []error { return m }
 
-// GetReturnedRequestValidationError is the validation error returned by
-// GetReturnedRequest.Validate if the designated constraints aren't met.
-type GetReturnedRequestValidationError struct {
+// GetCourierManifestRequestValidationError is the validation error returned by
+// GetCourierManifestRequest.Validate if the designated constraints aren't met.
+type GetCourierManifestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1265,24 +5248,24 @@ type GetReturnedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnedRequestValidationError) Field() string { return e.field }
+func (e GetCourierManifestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnedRequestValidationError) Reason() string { return e.reason }
+func (e GetCourierManifestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnedRequestValidationError) Cause() error { return e.cause }
+func (e GetCourierManifestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnedRequestValidationError) Key() bool { return e.key }
+func (e GetCourierManifestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnedRequestValidationError) ErrorName() string {
-	return "GetReturnedRequestValidationError"
+func (e GetCourierManifestRequestValidationError) ErrorName() string {
+	return "GetCourierManifestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnedRequestValidationError) Error() string {
+func (e GetCourierManifestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1294,14 +5277,14 @@ func (e GetReturnedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnedRequest.%s: %s%s",
+		"invalid %sGetCourierManifestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnedRequestValidationError{}
+var _ error = GetCourierManifestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1309,24 +5292,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnedRequestValidationError{}
+} = GetCourierManifestRequestValidationError{}
 
-// Validate checks the field values on GetReturnedResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCourierManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnedResponse) Validate() error {
+func (m *GetCourierManifestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnedResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCourierManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnedResponseMultiError, or nil if none found.
-func (m *GetReturnedResponse) ValidateAll() error {
+// GetCourierManifestResponseMultiError, or nil if none found.
+func (m *GetCourierManifestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnedResponse) validate(all bool) error {
+func (m *GetCourierManifestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1340,7 +5323,7 @@ func (m *GetReturnedResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetReturnedResponseValidationError{
+					errors = append(errors, GetCourierManifestResponseValidationError{
 						field:  fmt.Sprintf("Orders[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1348,7 +5331,7 @@ func (m *GetReturnedResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetReturnedResponseValidationError{
+					errors = append(errors, GetCourierManifestResponseValidationError{
 						field:  fmt.Sprintf("Orders[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1357,7 +5340,7 @@ func (m *GetReturnedResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetReturnedResponseValidationError{
+				return GetCourierManifestResponseValidationError{
 					field:  fmt.Sprintf("Orders[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1368,19 +5351,19 @@ func (m *GetReturnedResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetReturnedResponseMultiError(errors)
+		return GetCourierManifestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnedResponseMultiError is an error wrapping multiple validation
-// errors returned by GetReturnedResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetReturnedResponseMultiError []error
+// GetCourierManifestResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCourierManifestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCourierManifestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnedResponseMultiError) Error() string {
+func (m GetCourierManifestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1389,11 +5372,12 @@ func (m GetReturnedResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnedResponseMultiError) AllErrors() []error { return m }
+func (m GetCourierManifestResponseMultiError) AllErrors() []error { return m }
 
-// GetReturnedResponseValidationError is the validation error returned by
-// GetReturnedResponse.Validate if the designated constraints aren't met.
-type GetReturnedResponseValidationError struct {
+// GetCourierManifestResponseValidationError is the validation error returned
+// by GetCourierManifestResponse.Validate if the designated constraints aren't
+// met.
+type GetCourierManifestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1401,24 +5385,24 @@ type GetReturnedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnedResponseValidationError) Field() string { return e.field }
+func (e GetCourierManifestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnedResponseValidationError) Reason() string { return e.reason }
+func (e GetCourierManifestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnedResponseValidationError) Cause() error { return e.cause }
+func (e GetCourierManifestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnedResponseValidationError) Key() bool { return e.key }
+func (e GetCourierManifestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnedResponseValidationError) ErrorName() string {
-	return "GetReturnedResponseValidationError"
+func (e GetCourierManifestResponseValidationError) ErrorName() string {
+	return "GetCourierManifestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnedResponseValidationError) Error() string {
+func (e GetCourierManifestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1430,14 +5414,14 @@ func (e GetReturnedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnedResponse.%s: %s%s",
+		"invalid %sGetCourierManifestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnedResponseValidationError{}
+var _ error = GetCourierManifestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1445,34 +5429,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnedResponseValidationError{}
+} = GetCourierManifestResponseValidationError{}
 
-// Validate checks the field values on IssueOrderRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *IssueOrderRequest) Validate() error {
+// Validate checks the field values on CourierHandoverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CourierHandoverRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueOrderRequest with the rules
+// ValidateAll checks the field values on CourierHandoverRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueOrderRequestMultiError, or nil if none found.
-func (m *IssueOrderRequest) ValidateAll() error {
+// CourierHandoverRequestMultiError, or nil if none found.
+func (m *CourierHandoverRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueOrderRequest) validate(all bool) error {
+func (m *CourierHandoverRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetIds()) < 1 {
-		err := IssueOrderRequestValidationError{
-			field:  "Ids",
-			reason: "value must contain at least 1 item(s)",
+	if m.GetCourierId() <= 0 {
+		err := CourierHandoverRequestValidationError{
+			field:  "CourierId",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1480,12 +5464,12 @@ func (m *IssueOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetIds() {
+	for idx, item := range m.GetOrderIds() {
 		_, _ = idx, item
 
 		if item <= 0 {
-			err := IssueOrderRequestValidationError{
-				field:  fmt.Sprintf("Ids[%v]", idx),
+			err := CourierHandoverRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
 				reason: "value must be greater than 0",
 			}
 			if !all {
@@ -1496,20 +5480,24 @@ func (m *IssueOrderRequest) validate(all bool) error {
 
 	}
 
+	if m.PickupPointId != nil {
+		// no validation rules for PickupPointId
+	}
+
 	if len(errors) > 0 {
-		return IssueOrderRequestMultiError(errors)
+		return CourierHandoverRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by IssueOrderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type IssueOrderRequestMultiError []error
+// CourierHandoverRequestMultiError is an error wrapping multiple validation
+// errors returned by CourierHandoverRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CourierHandoverRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueOrderRequestMultiError) Error() string {
+func (m CourierHandoverRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1518,11 +5506,11 @@ func (m IssueOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueOrderRequestMultiError) AllErrors() []error { return m }
+func (m CourierHandoverRequestMultiError) AllErrors() []error { return m }
 
-// IssueOrderRequestValidationError is the validation error returned by
-// IssueOrderRequest.Validate if the designated constraints aren't met.
-type IssueOrderRequestValidationError struct {
+// CourierHandoverRequestValidationError is the validation error returned by
+// CourierHandoverRequest.Validate if the designated constraints aren't met.
+type CourierHandoverRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1530,24 +5518,24 @@ type IssueOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueOrderRequestValidationError) Field() string { return e.field }
+func (e CourierHandoverRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueOrderRequestValidationError) Reason() string { return e.reason }
+func (e CourierHandoverRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueOrderRequestValidationError) Cause() error { return e.cause }
+func (e CourierHandoverRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueOrderRequestValidationError) Key() bool { return e.key }
+func (e CourierHandoverRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueOrderRequestValidationError) ErrorName() string {
-	return "IssueOrderRequestValidationError"
+func (e CourierHandoverRequestValidationError) ErrorName() string {
+	return "CourierHandoverRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueOrderRequestValidationError) Error() string {
+func (e CourierHandoverRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1559,14 +5547,14 @@ func (e IssueOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueOrderRequest.%s: %s%s",
+		"invalid %sCourierHandoverRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueOrderRequestValidationError{}
+var _ error = CourierHandoverRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1574,78 +5562,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueOrderRequestValidationError{}
+} = CourierHandoverRequestValidationError{}
 
-// Validate checks the field values on IssueOrderResponse with the rules
+// Validate checks the field values on CourierHandoverResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueOrderResponse) Validate() error {
+func (m *CourierHandoverResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CourierHandoverResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueOrderResponseMultiError, or nil if none found.
-func (m *IssueOrderResponse) ValidateAll() error {
+// CourierHandoverResponseMultiError, or nil if none found.
+func (m *CourierHandoverResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueOrderResponse) validate(all bool) error {
+func (m *CourierHandoverResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOrders() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, IssueOrderResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, IssueOrderResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetHandover()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourierHandoverResponseValidationError{
+					field:  "Handover",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return IssueOrderResponseValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
+				errors = append(errors, CourierHandoverResponseValidationError{
+					field:  "Handover",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHandover()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourierHandoverResponseValidationError{
+				field:  "Handover",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return IssueOrderResponseMultiError(errors)
+		return CourierHandoverResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueOrderResponseMultiError is an error wrapping multiple validation errors
-// returned by IssueOrderResponse.ValidateAll() if the designated constraints
-// aren't met.
-type IssueOrderResponseMultiError []error
+// CourierHandoverResponseMultiError is an error wrapping multiple validation
+// errors returned by CourierHandoverResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CourierHandoverResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueOrderResponseMultiError) Error() string {
+func (m CourierHandoverResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1654,11 +5637,11 @@ func (m IssueOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueOrderResponseMultiError) AllErrors() []error { return m }
+func (m CourierHandoverResponseMultiError) AllErrors() []error { return m }
 
-// IssueOrderResponseValidationError is the validation error returned by
-// IssueOrderResponse.Validate if the designated constraints aren't met.
-type IssueOrderResponseValidationError struct {
+// CourierHandoverResponseValidationError is the validation error returned by
+// CourierHandoverResponse.Validate if the designated constraints aren't met.
+type CourierHandoverResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1666,24 +5649,24 @@ type IssueOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueOrderResponseValidationError) Field() string { return e.field }
+func (e CourierHandoverResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueOrderResponseValidationError) Reason() string { return e.reason }
+func (e CourierHandoverResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueOrderResponseValidationError) Cause() error { return e.cause }
+func (e CourierHandoverResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueOrderResponseValidationError) Key() bool { return e.key }
+func (e CourierHandoverResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueOrderResponseValidationError) ErrorName() string {
-	return "IssueOrderResponseValidationError"
+func (e CourierHandoverResponseValidationError) ErrorName() string {
+	return "CourierHandoverResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueOrderResponseValidationError) Error() string {
+func (e CourierHandoverResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1695,14 +5678,14 @@ func (e IssueOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueOrderResponse.%s: %s%s",
+		"invalid %sCourierHandoverResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueOrderResponseValidationError{}
+var _ error = CourierHandoverResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1710,4 +5693,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueOrderResponseValidationError{}
+} = CourierHandoverResponseValidationError{}
@@ -19,12 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_AcceptOrder_FullMethodName  = "/api.order_service.v1.OrderService/AcceptOrder"
-	OrderService_AcceptReturn_FullMethodName = "/api.order_service.v1.OrderService/AcceptReturn"
-	OrderService_CancelOrder_FullMethodName  = "/api.order_service.v1.OrderService/CancelOrder"
-	OrderService_GetOrders_FullMethodName    = "/api.order_service.v1.OrderService/GetOrders"
-	OrderService_GetReturned_FullMethodName  = "/api.order_service.v1.OrderService/GetReturned"
-	OrderService_IssueOrder_FullMethodName   = "/api.order_service.v1.OrderService/IssueOrder"
+	OrderService_AcceptOrder_FullMethodName         = "/api.order_service.v1.OrderService/AcceptOrder"
+	OrderService_AcceptReturn_FullMethodName        = "/api.order_service.v1.OrderService/AcceptReturn"
+	OrderService_CancelOrder_FullMethodName         = "/api.order_service.v1.OrderService/CancelOrder"
+	OrderService_GetOrders_FullMethodName           = "/api.order_service.v1.OrderService/GetOrders"
+	OrderService_GetReturned_FullMethodName         = "/api.order_service.v1.OrderService/GetReturned"
+	OrderService_SearchOrders_FullMethodName        = "/api.order_service.v1.OrderService/SearchOrders"
+	OrderService_IssueOrder_FullMethodName          = "/api.order_service.v1.OrderService/IssueOrder"
+	OrderService_GetOrderHistory_FullMethodName     = "/api.order_service.v1.OrderService/GetOrderHistory"
+	OrderService_CreatePickupPoint_FullMethodName   = "/api.order_service.v1.OrderService/CreatePickupPoint"
+	OrderService_UpdatePickupPoint_FullMethodName   = "/api.order_service.v1.OrderService/UpdatePickupPoint"
+	OrderService_ListPickupPoints_FullMethodName    = "/api.order_service.v1.OrderService/ListPickupPoints"
+	OrderService_SavePackagingType_FullMethodName   = "/api.order_service.v1.OrderService/SavePackagingType"
+	OrderService_DeletePackagingType_FullMethodName = "/api.order_service.v1.OrderService/DeletePackagingType"
+	OrderService_ListPackagingTypes_FullMethodName  = "/api.order_service.v1.OrderService/ListPackagingTypes"
+	OrderService_SuggestPackaging_FullMethodName    = "/api.order_service.v1.OrderService/SuggestPackaging"
+	OrderService_GetCourierManifest_FullMethodName  = "/api.order_service.v1.OrderService/GetCourierManifest"
+	OrderService_CourierHandover_FullMethodName     = "/api.order_service.v1.OrderService/CourierHandover"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -36,7 +47,18 @@ type OrderServiceClient interface {
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
 	GetReturned(ctx context.Context, in *GetReturnedRequest, opts ...grpc.CallOption) (*GetReturnedResponse, error)
+	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error)
 	IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*IssueOrderResponse, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*CreatePickupPointResponse, error)
+	UpdatePickupPoint(ctx context.Context, in *UpdatePickupPointRequest, opts ...grpc.CallOption) (*UpdatePickupPointResponse, error)
+	ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error)
+	SavePackagingType(ctx context.Context, in *SavePackagingTypeRequest, opts ...grpc.CallOption) (*SavePackagingTypeResponse, error)
+	DeletePackagingType(ctx context.Context, in *DeletePackagingTypeRequest, opts ...grpc.CallOption) (*DeletePackagingTypeResponse, error)
+	ListPackagingTypes(ctx context.Context, in *ListPackagingTypesRequest, opts ...grpc.CallOption) (*ListPackagingTypesResponse, error)
+	SuggestPackaging(ctx context.Context, in *SuggestPackagingRequest, opts ...grpc.CallOption) (*SuggestPackagingResponse, error)
+	GetCourierManifest(ctx context.Context, in *GetCourierManifestRequest, opts ...grpc.CallOption) (*GetCourierManifestResponse, error)
+	CourierHandover(ctx context.Context, in *CourierHandoverRequest, opts ...grpc.CallOption) (*CourierHandoverResponse, error)
 }
 
 type orderServiceClient struct {
@@ -97,6 +119,16 @@ func (c *orderServiceClient) GetReturned(ctx context.Context, in *GetReturnedReq
 	return out, nil
 }
 
+func (c *orderServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*IssueOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IssueOrderResponse)
@@ -107,6 +139,106 @@ func (c *orderServiceClient) IssueOrder(ctx context.Context, in *IssueOrderReque
 	return out, nil
 }
 
+func (c *orderServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*CreatePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePickupPointResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdatePickupPoint(ctx context.Context, in *UpdatePickupPointRequest, opts ...grpc.CallOption) (*UpdatePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePickupPointResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpdatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPickupPointsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListPickupPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SavePackagingType(ctx context.Context, in *SavePackagingTypeRequest, opts ...grpc.CallOption) (*SavePackagingTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavePackagingTypeResponse)
+	err := c.cc.Invoke(ctx, OrderService_SavePackagingType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeletePackagingType(ctx context.Context, in *DeletePackagingTypeRequest, opts ...grpc.CallOption) (*DeletePackagingTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePackagingTypeResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeletePackagingType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListPackagingTypes(ctx context.Context, in *ListPackagingTypesRequest, opts ...grpc.CallOption) (*ListPackagingTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPackagingTypesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListPackagingTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SuggestPackaging(ctx context.Context, in *SuggestPackagingRequest, opts ...grpc.CallOption) (*SuggestPackagingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestPackagingResponse)
+	err := c.cc.Invoke(ctx, OrderService_SuggestPackaging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetCourierManifest(ctx context.Context, in *GetCourierManifestRequest, opts ...grpc.CallOption) (*GetCourierManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourierManifestResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetCourierManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CourierHandover(ctx context.Context, in *CourierHandoverRequest, opts ...grpc.CallOption) (*CourierHandoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourierHandoverResponse)
+	err := c.cc.Invoke(ctx, OrderService_CourierHandover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -116,7 +248,18 @@ type OrderServiceServer interface {
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
 	GetReturned(context.Context, *GetReturnedRequest) (*GetReturnedResponse, error)
+	SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error)
 	IssueOrder(context.Context, *IssueOrderRequest) (*IssueOrderResponse, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*CreatePickupPointResponse, error)
+	UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error)
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error)
+	SavePackagingType(context.Context, *SavePackagingTypeRequest) (*SavePackagingTypeResponse, error)
+	DeletePackagingType(context.Context, *DeletePackagingTypeRequest) (*DeletePackagingTypeResponse, error)
+	ListPackagingTypes(context.Context, *ListPackagingTypesRequest) (*ListPackagingTypesResponse, error)
+	SuggestPackaging(context.Context, *SuggestPackagingRequest) (*SuggestPackagingResponse, error)
+	GetCourierManifest(context.Context, *GetCourierManifestRequest) (*GetCourierManifestResponse, error)
+	CourierHandover(context.Context, *CourierHandoverRequest) (*CourierHandoverResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -142,9 +285,42 @@ func (UnimplementedOrderServiceServer) GetOrders(context.Context, *GetOrdersRequ
 func (UnimplementedOrderServiceServer) GetReturned(context.Context, *GetReturnedRequest) (*GetReturnedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReturned not implemented")
 }
+func (UnimplementedOrderServiceServer) SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
 func (UnimplementedOrderServiceServer) IssueOrder(context.Context, *IssueOrderRequest) (*IssueOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrderServiceServer) CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*CreatePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePickupPoint not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePickupPoint not implemented")
+}
+func (UnimplementedOrderServiceServer) ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPickupPoints not implemented")
+}
+func (UnimplementedOrderServiceServer) SavePackagingType(context.Context, *SavePackagingTypeRequest) (*SavePackagingTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePackagingType not implemented")
+}
+func (UnimplementedOrderServiceServer) DeletePackagingType(context.Context, *DeletePackagingTypeRequest) (*DeletePackagingTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePackagingType not implemented")
+}
+func (UnimplementedOrderServiceServer) ListPackagingTypes(context.Context, *ListPackagingTypesRequest) (*ListPackagingTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPackagingTypes not implemented")
+}
+func (UnimplementedOrderServiceServer) SuggestPackaging(context.Context, *SuggestPackagingRequest) (*SuggestPackagingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestPackaging not implemented")
+}
+func (UnimplementedOrderServiceServer) GetCourierManifest(context.Context, *GetCourierManifestRequest) (*GetCourierManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourierManifest not implemented")
+}
+func (UnimplementedOrderServiceServer) CourierHandover(context.Context, *CourierHandoverRequest) (*CourierHandoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CourierHandover not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -256,6 +432,24 @@ func _OrderService_GetReturned_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SearchOrders(ctx, req.(*SearchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_IssueOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IssueOrderRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +468,186 @@ func _OrderService_IssueOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreatePickupPoint(ctx, req.(*CreatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdatePickupPoint(ctx, req.(*UpdatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListPickupPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListPickupPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListPickupPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListPickupPoints(ctx, req.(*ListPickupPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SavePackagingType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePackagingTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SavePackagingType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SavePackagingType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SavePackagingType(ctx, req.(*SavePackagingTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeletePackagingType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePackagingTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeletePackagingType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeletePackagingType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeletePackagingType(ctx, req.(*DeletePackagingTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListPackagingTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackagingTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListPackagingTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListPackagingTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListPackagingTypes(ctx, req.(*ListPackagingTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SuggestPackaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestPackagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SuggestPackaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SuggestPackaging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SuggestPackaging(ctx, req.(*SuggestPackagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCourierManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourierManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCourierManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCourierManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCourierManifest(ctx, req.(*GetCourierManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CourierHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourierHandoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CourierHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CourierHandover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CourierHandover(ctx, req.(*CourierHandoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,10 +675,54 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReturned",
 			Handler:    _OrderService_GetReturned_Handler,
 		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _OrderService_SearchOrders_Handler,
+		},
 		{
 			MethodName: "IssueOrder",
 			Handler:    _OrderService_IssueOrder_Handler,
 		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrderService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "CreatePickupPoint",
+			Handler:    _OrderService_CreatePickupPoint_Handler,
+		},
+		{
+			MethodName: "UpdatePickupPoint",
+			Handler:    _OrderService_UpdatePickupPoint_Handler,
+		},
+		{
+			MethodName: "ListPickupPoints",
+			Handler:    _OrderService_ListPickupPoints_Handler,
+		},
+		{
+			MethodName: "SavePackagingType",
+			Handler:    _OrderService_SavePackagingType_Handler,
+		},
+		{
+			MethodName: "DeletePackagingType",
+			Handler:    _OrderService_DeletePackagingType_Handler,
+		},
+		{
+			MethodName: "ListPackagingTypes",
+			Handler:    _OrderService_ListPackagingTypes_Handler,
+		},
+		{
+			MethodName: "SuggestPackaging",
+			Handler:    _OrderService_SuggestPackaging_Handler,
+		},
+		{
+			MethodName: "GetCourierManifest",
+			Handler:    _OrderService_GetCourierManifest_Handler,
+		},
+		{
+			MethodName: "CourierHandover",
+			Handler:    _OrderService_CourierHandover_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order-service/v1/order_service.proto",
@@ -7,10 +7,11 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/vlad1028/order-manager/internal/cache"
 	"github.com/vlad1028/order-manager/internal/db"
-	"github.com/vlad1028/order-manager/internal/kafka"
 	"github.com/vlad1028/order-manager/internal/models/order"
 	orderRepo "github.com/vlad1028/order-manager/internal/order"
 	"github.com/vlad1028/order-manager/internal/order/service"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"io"
 	"testing"
 	"time"
 
@@ -36,7 +37,8 @@ func (suite *OrderManagerSuite) SetupSuite() {
 	suite.db = pool
 	suite.repo = db.SetupOrderRepository(pool)
 
-	orderService := service.NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, suite.repo, kafka.NewMockProducer(), cache.NewCacheMock())
+	codes := verification.NewVerifier(db.SetupPickupCodeStorage(pool), verification.NewWriterNotifier(io.Discard))
+	orderService := service.NewOrderService(0, 24*7*time.Hour, 2*24*time.Hour, suite.repo, cache.NewCacheMock(), cache.NewCacheMock(), codes)
 	orderHandler := cli.NewOrderServiceAdaptor(orderService)
 
 	suite.shell = cli.NewOrderManagerCLI(orderHandler, suite.input, suite.output)
@@ -47,7 +49,7 @@ func (suite *OrderManagerSuite) TearDownSuite() {
 }
 
 func (suite *OrderManagerSuite) SetupTest() {
-	_, err := suite.db.Exec(context.Background(), "TRUNCATE TABLE orders RESTART IDENTITY CASCADE")
+	_, err := suite.db.Exec(context.Background(), "TRUNCATE TABLE orders, order_ids RESTART IDENTITY CASCADE")
 	suite.Require().NoError(err)
 }
 
@@ -71,6 +73,8 @@ func (suite *OrderManagerSuite) TestAddOrder() {
 	gotOrder, err := suite.repo.Get(context.Background(), 1)
 	suite.Require().NoError(err)
 	gotOrder.StatusUpdated = o.StatusUpdated
+	suite.Empty(gotOrder.Packaging)
+	gotOrder.Packaging = o.Packaging
 	suite.Equal(o, gotOrder)
 }
 
@@ -2,15 +2,23 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/stretchr/testify/suite"
 	"github.com/vlad1028/order-manager/internal/db"
 	"github.com/vlad1028/order-manager/internal/models/basetypes"
 	"github.com/vlad1028/order-manager/internal/models/order"
+	"github.com/vlad1028/order-manager/internal/models/pickuppoint"
+	"github.com/vlad1028/order-manager/internal/notify"
 	orderRepo "github.com/vlad1028/order-manager/internal/order"
+	"github.com/vlad1028/order-manager/internal/verification"
+	"io"
 	"math/rand"
 	"testing"
+	"time"
 )
 
 type OrderRepositoryTestSuite struct {
@@ -34,7 +42,7 @@ func (suite *OrderRepositoryTestSuite) TearDownSuite() {
 
 func (suite *OrderRepositoryTestSuite) SetupTest() {
 	suite.ctx = context.Background()
-	_, err := suite.db.Exec(suite.ctx, "TRUNCATE TABLE orders RESTART IDENTITY CASCADE")
+	_, err := suite.db.Exec(suite.ctx, "TRUNCATE TABLE orders, order_ids, orders_archive, order_status_history, notifications, outbox RESTART IDENTITY CASCADE")
 	suite.Require().NoError(err)
 }
 
@@ -71,6 +79,59 @@ func (suite *OrderRepositoryTestSuite) TestAddOrUpdate() {
 	suite.Require().True(exists, "Order should exist after adding")
 }
 
+func (suite *OrderRepositoryTestSuite) TestAddOrUpdateDuplicate() {
+	ctx := context.Background()
+	storage := db.SetupNotificationStorage(suite.db)
+	fakeOrder := generateFakeOrder()
+	fakeOrder.Status = order.Stored
+	duplicate := *fakeOrder
+
+	exists, err := suite.repo.AddOrUpdate(ctx, fakeOrder, order.Event{OrderID: fakeOrder.ID, Operation: notify.KindAccept})
+	suite.Require().NoError(err)
+	suite.Require().False(exists)
+
+	exists, err = suite.repo.AddOrUpdate(ctx, &duplicate, order.Event{OrderID: duplicate.ID, Operation: notify.KindAccept})
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+
+	history, err := suite.repo.GetHistory(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 1)
+
+	var events int
+	err = suite.db.QueryRow(ctx, "SELECT COUNT(*) FROM outbox WHERE key = $1", fakeOrder.ID.String()).Scan(&events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, events)
+
+	pending, err := storage.GetPendingNotifications(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(pending, 1)
+}
+
+func (suite *OrderRepositoryTestSuite) TestAddOrUpdateReturnsStatusUpdated() {
+	fakeOrder := generateFakeOrder()
+	fakeOrder.Status = order.Stored
+	ctx := context.Background()
+
+	_, err := suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+	suite.Require().False(fakeOrder.StatusUpdated.IsZero())
+
+	fetchedOrder, err := suite.repo.Get(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(fakeOrder.StatusUpdated.Equal(fetchedOrder.StatusUpdated))
+
+	accepted := fakeOrder.StatusUpdated
+	fakeOrder.SetStatus(order.Canceled)
+	suite.Require().NoError(suite.repo.Update(ctx, fakeOrder))
+	suite.Require().False(fakeOrder.StatusUpdated.Before(accepted))
+
+	fetchedOrder, err = suite.repo.Get(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(fakeOrder.StatusUpdated.Equal(fetchedOrder.StatusUpdated))
+	suite.Require().Equal(fetchedOrder.Version, fakeOrder.Version)
+}
+
 func (suite *OrderRepositoryTestSuite) TestGet() {
 	fakeOrder := generateFakeOrder()
 	ctx := context.Background()
@@ -84,6 +145,22 @@ func (suite *OrderRepositoryTestSuite) TestGet() {
 	suite.Require().Equal(fakeOrder.ID, fetchedOrder.ID)
 }
 
+func (suite *OrderRepositoryTestSuite) TestGetPackaging() {
+	fakeOrder := generateFakeOrder()
+	fakeOrder.BaseCost = fakeOrder.Cost
+	fakeOrder.Cost += 21
+	fakeOrder.Packaging = []order.PackagingLayer{{Type: "box", Cost: 20}, {Type: "film", Cost: 1}}
+	ctx := context.Background()
+
+	_, err := suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+
+	fetchedOrder, err := suite.repo.Get(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fakeOrder.BaseCost, fetchedOrder.BaseCost)
+	suite.Require().Equal(fakeOrder.Packaging, fetchedOrder.Packaging)
+}
+
 func (suite *OrderRepositoryTestSuite) TestDelete() {
 	fakeOrder := generateFakeOrder()
 	ctx := context.Background()
@@ -91,12 +168,57 @@ func (suite *OrderRepositoryTestSuite) TestDelete() {
 	_, err := suite.repo.AddOrUpdate(ctx, fakeOrder)
 	suite.Require().NoError(err)
 
-	err = suite.repo.Delete(ctx, fakeOrder.ID)
+	deleted, err := suite.repo.Delete(ctx, fakeOrder.ID)
 	suite.Require().NoError(err)
+	suite.Require().Equal(fakeOrder.ClientID, deleted.ClientID)
 
 	deletedOrder, err := suite.repo.Get(ctx, fakeOrder.ID)
 	suite.Require().Error(err)
 	suite.Require().Nil(deletedOrder)
+
+	found, err := suite.repo.GetBy(ctx, &order.Filter{ClientID: &fakeOrder.ClientID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(found)
+
+	_, err = suite.repo.Delete(ctx, fakeOrder.ID)
+	suite.Require().ErrorIs(err, orderRepo.ErrOrderNotFound)
+
+	err = suite.repo.Update(ctx, fakeOrder)
+	suite.Require().ErrorIs(err, orderRepo.ErrOrderNotFound)
+
+	// the ID stays taken until the order is archived
+	fakeOrder.Status = order.Stored
+	fakeOrder.Version = 0
+	exists, err := suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+}
+
+func (suite *OrderRepositoryTestSuite) TestArchiveOrders() {
+	canceled, stored, deleted := generateFakeOrder(), generateFakeOrder(), generateFakeOrder()
+	canceled.Status, stored.Status, deleted.Status = order.Canceled, order.Stored, order.Stored
+	stored.ClientID, deleted.ClientID = canceled.ClientID, canceled.ClientID
+	ctx := context.Background()
+
+	suite.Require().NoError(suite.repo.AddOrUpdateList(ctx, []*order.Order{canceled, stored, deleted}))
+	_, err := suite.repo.Delete(ctx, deleted.ID)
+	suite.Require().NoError(err)
+
+	storage := db.SetupArchiveStorage(suite.db)
+	archived, err := storage.ArchiveOrders(ctx, []order.Status{order.Canceled}, time.Now().Add(time.Minute), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(archived, 2)
+	suite.Require().ElementsMatch([]basetypes.ID{canceled.ID, deleted.ID}, []basetypes.ID{archived[0].ID, archived[1].ID})
+
+	live, err := suite.repo.GetBy(ctx, &order.Filter{ClientID: &canceled.ClientID})
+	suite.Require().NoError(err)
+	suite.Require().Len(live, 1)
+	suite.Require().Equal(stored.ID, live[0].ID)
+
+	all, err := suite.repo.GetBy(ctx, &order.Filter{ClientID: &canceled.ClientID, IncludeArchived: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(all, 2)
+	suite.Require().ElementsMatch([]basetypes.ID{canceled.ID, stored.ID}, []basetypes.ID{all[0].ID, all[1].ID})
 }
 
 func (suite *OrderRepositoryTestSuite) TestAddOrUpdateList() {
@@ -135,3 +257,533 @@ func (suite *OrderRepositoryTestSuite) TestGetBy() {
 	suite.Require().Len(fetchedOrders, 1)
 	suite.Require().Equal(orders[0].ClientID, fetchedOrders[0].ClientID)
 }
+
+func (suite *OrderRepositoryTestSuite) TestGetPage() {
+	orders := []*order.Order{
+		generateFakeOrder(),
+		generateFakeOrder(),
+		generateFakeOrder(),
+	}
+	for _, o := range orders {
+		o.ClientID = orders[0].ClientID
+	}
+	ctx := context.Background()
+
+	// orders stored in one transaction share the status update time, so the pages are ordered by IDs
+	err := suite.repo.AddOrUpdateList(ctx, orders)
+	suite.Require().NoError(err)
+
+	filter := &order.Filter{ClientID: &orders[0].ClientID}
+	all, err := suite.repo.GetBy(ctx, filter)
+	suite.Require().NoError(err)
+	suite.Require().Len(all, 3)
+
+	var paged []*order.Order
+	var after *order.Cursor
+	for {
+		page, err := suite.repo.GetPage(ctx, filter, nil, after, 2)
+		suite.Require().NoError(err)
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		after = order.CursorOf(page[len(page)-1])
+	}
+	suite.Require().Equal(all, paged)
+	suite.Require().Less(all[0].ID, all[1].ID)
+	suite.Require().Less(all[1].ID, all[2].ID)
+}
+
+func (suite *OrderRepositoryTestSuite) TestGetPageSorted() {
+	orders := []*order.Order{
+		generateFakeOrder(),
+		generateFakeOrder(),
+		generateFakeOrder(),
+		generateFakeOrder(),
+	}
+	for i, o := range orders {
+		o.ClientID = orders[0].ClientID
+		o.Status = order.Stored
+		o.Weight = 10 * uint(i+1)
+		o.Cost = 100
+	}
+	orders[3].Status = order.Expired
+	ctx := context.Background()
+
+	err := suite.repo.AddOrUpdateList(ctx, orders)
+	suite.Require().NoError(err)
+
+	filter := &order.Filter{
+		ClientID: &orders[0].ClientID,
+		Statuses: []order.Status{order.Stored, order.Expired},
+		Weight:   order.UintRange{Min: ptr(uint(20))},
+		Cost:     order.UintRange{Max: ptr(uint(100))},
+	}
+	sort := []order.Sort{{Field: order.SortByCost}, {Field: order.SortByWeight, Desc: true}}
+
+	var paged []*order.Order
+	var after *order.Cursor
+	for {
+		page, err := suite.repo.GetPage(ctx, filter, sort, after, 2)
+		suite.Require().NoError(err)
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		after = order.CursorOf(page[len(page)-1])
+	}
+
+	suite.Require().Len(paged, 3)
+	suite.Require().Equal(orders[3].ID, paged[0].ID)
+	suite.Require().Equal(orders[2].ID, paged[1].ID)
+	suite.Require().Equal(orders[1].ID, paged[2].ID)
+
+	future := time.Now().Add(time.Hour)
+	filter.StatusUpdated.From = &future
+	found, err := suite.repo.GetPage(ctx, filter, sort, nil, -1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(found)
+}
+
+func (suite *OrderRepositoryTestSuite) TestUpdateConcurrentModification() {
+	fakeOrder := generateFakeOrder()
+	fakeOrder.Status = order.Stored
+	ctx := context.Background()
+
+	_, err := suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), fakeOrder.Version)
+
+	first, err := suite.repo.Get(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	second, err := suite.repo.Get(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+
+	first.SetStatus(order.ReachedClient)
+	suite.Require().NoError(suite.repo.Update(ctx, first))
+	suite.Require().Equal(uint64(2), first.Version)
+
+	second.SetStatus(order.Canceled)
+	err = suite.repo.Update(ctx, second)
+	suite.Require().ErrorIs(err, orderRepo.ErrConcurrentModification)
+
+	stored, err := suite.repo.Get(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(order.ReachedClient, stored.Status)
+	suite.Require().Equal(uint64(2), stored.Version)
+}
+
+func (suite *OrderRepositoryTestSuite) TestGetHistory() {
+	fakeOrder := generateFakeOrder()
+	fakeOrder.Status = order.Stored
+	ctx := context.Background()
+
+	_, err := suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+
+	fakeOrder.SetStatus(order.ReachedClient)
+	_, err = suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+
+	history, err := suite.repo.GetHistory(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(order.Stored, history[0].Status)
+	suite.Require().Equal(order.ReachedClient, history[1].Status)
+}
+
+func (suite *OrderRepositoryTestSuite) TestGetHistoryWithoutChanges() {
+	fakeOrder := generateFakeOrder()
+	ctx := context.Background()
+
+	_, err := suite.repo.GetHistory(ctx, fakeOrder.ID)
+	suite.Require().ErrorIs(err, orderRepo.ErrOrderNotFound)
+
+	_, err = suite.repo.AddOrUpdate(ctx, fakeOrder)
+	suite.Require().NoError(err)
+	_, err = suite.db.Exec(ctx, "DELETE FROM order_status_history WHERE order_id = $1", fakeOrder.ID)
+	suite.Require().NoError(err)
+
+	history, err := suite.repo.GetHistory(ctx, fakeOrder.ID)
+	suite.Require().NoError(err)
+	suite.Require().Empty(history)
+}
+
+func (suite *OrderRepositoryTestSuite) TestAddOrUpdateWithinCapacity() {
+	ctx := context.Background()
+	point := &pickuppoint.PickupPoint{ID: basetypes.ID(gofakeit.Uint32()), Name: "test", Address: "test", MaxOrders: 1}
+	suite.Require().NoError(suite.repo.CreatePickupPoint(ctx, point))
+
+	first := generateFakeOrder()
+	first.Status = order.Stored
+	first.PickupPointID = point.ID
+	_, err := suite.repo.AddOrUpdateWithinCapacity(ctx, first)
+	suite.Require().NoError(err)
+
+	second := generateFakeOrder()
+	second.Status = order.Stored
+	second.PickupPointID = point.ID
+	_, err = suite.repo.AddOrUpdateWithinCapacity(ctx, second)
+	suite.Require().ErrorIs(err, orderRepo.ErrPickupPointFull)
+
+	again := *first
+	again.Version = 0
+	exists, err := suite.repo.AddOrUpdateWithinCapacity(ctx, &again)
+	suite.Require().NoError(err)
+	suite.Require().True(exists, "an accepted order is reported as existing at a full pickup point")
+
+	fetchedPoint, err := suite.repo.GetPickupPoint(ctx, point.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint(1), fetchedPoint.Occupancy.Orders)
+}
+
+func (suite *OrderRepositoryTestSuite) TestPickupPointExists() {
+	ctx := context.Background()
+	point := &pickuppoint.PickupPoint{ID: basetypes.ID(gofakeit.Uint32()), Name: "test", Address: "test"}
+
+	exists, err := suite.repo.PickupPointExists(ctx, point.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(exists)
+
+	suite.Require().NoError(suite.repo.CreatePickupPoint(ctx, point))
+	exists, err = suite.repo.PickupPointExists(ctx, point.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+}
+
+func (suite *OrderRepositoryTestSuite) TestPackagingTypes() {
+	ctx := context.Background()
+	t := &order.PackagingType{Name: gofakeit.UUID(), WeightLimit: 50, Cost: 30, CanWrap: true, Wraps: []string{"film"}}
+
+	exists, err := suite.repo.AddOrUpdatePackagingType(ctx, t)
+	suite.Require().NoError(err)
+	suite.Require().False(exists)
+
+	t.Cost = 40
+	exists, err = suite.repo.AddOrUpdatePackagingType(ctx, t)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+
+	fetched, err := suite.repo.GetPackagingType(ctx, t.Name)
+	suite.Require().NoError(err)
+	suite.Require().Equal(t, fetched)
+
+	suite.Require().NoError(suite.repo.DeletePackagingType(ctx, t.Name))
+	_, err = suite.repo.GetPackagingType(ctx, t.Name)
+	suite.Require().ErrorIs(err, orderRepo.ErrPackagingNotFound)
+}
+
+func (suite *OrderRepositoryTestSuite) TestUpdateList() {
+	first, second := generateFakeOrder(), generateFakeOrder()
+	first.Status, second.Status = order.Stored, order.Stored
+	ctx := context.Background()
+
+	suite.Require().NoError(suite.repo.AddOrUpdateList(ctx, []*order.Order{first, second}))
+
+	err := suite.repo.UpdateList(ctx, []basetypes.ID{first.ID, second.ID, 0}, func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+		suite.Require().Len(found, 2)
+		for _, o := range found {
+			o.SetStatus(order.ReachedClient)
+		}
+		return found[:1], nil, nil
+	})
+	suite.Require().NoError(err)
+
+	rejected := errors.New("rejected")
+	err = suite.repo.UpdateList(ctx, []basetypes.ID{first.ID, second.ID}, func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+		for _, o := range found {
+			o.SetStatus(order.Canceled)
+		}
+		return found, nil, rejected
+	})
+	suite.Require().ErrorIs(err, rejected)
+
+	var issued int
+	for _, id := range []basetypes.ID{first.ID, second.ID} {
+		o, err := suite.repo.Get(ctx, id)
+		suite.Require().NoError(err)
+		suite.Require().NotEqual(order.Canceled, o.Status)
+		if o.Status == order.ReachedClient {
+			issued++
+		}
+	}
+	suite.Require().Equal(1, issued)
+}
+
+func (suite *OrderRepositoryTestSuite) TestIssueList() {
+	ctx := context.Background()
+	storage := db.SetupPickupCodeStorage(suite.db)
+	o := generateFakeOrder()
+	o.Status, o.PickupPointID = order.Stored, 1
+	suite.Require().NoError(suite.repo.AddOrUpdateList(ctx, []*order.Order{o}))
+	suite.Require().NoError(storage.SavePickupCode(ctx, &verification.PickupCode{ClientID: o.ClientID, PickupPointID: 1, Hash: "hash", Salt: "salt"}))
+
+	issue := func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+		for _, o := range found {
+			o.SetStatus(order.ReachedClient)
+		}
+		return found, nil, nil
+	}
+	verifier := verification.NewVerifier(storage, verification.NewWriterNotifier(io.Discard))
+	redeem := func(code string) func(context.Context, verification.Storage) error {
+		return func(ctx context.Context, codes verification.Storage) error {
+			return verifier.Redeem(ctx, codes, o.ClientID, 1, code)
+		}
+	}
+
+	// the failed attempt is kept although nothing is issued
+	err := suite.repo.IssueList(ctx, []basetypes.ID{o.ID}, redeem("wrong"), issue)
+	suite.Require().ErrorIs(err, verification.ErrInvalidPickupCode)
+	c, err := storage.GetPickupCode(ctx, o.ClientID, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint(1), c.Attempts)
+	fetched, err := suite.repo.Get(ctx, o.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(order.Stored, fetched.Status)
+
+	// the code is consumed together with the issue
+	accept := func(ctx context.Context, codes verification.Storage) error {
+		_, err := codes.GetPickupCode(ctx, o.ClientID, 1)
+		if err != nil {
+			return err
+		}
+		return codes.DeletePickupCode(ctx, o.ClientID, 1)
+	}
+	suite.Require().NoError(suite.repo.IssueList(ctx, []basetypes.ID{o.ID}, accept, issue))
+	_, err = storage.GetPickupCode(ctx, o.ClientID, 1)
+	suite.Require().ErrorIs(err, verification.ErrPickupCodeNotFound)
+	fetched, err = suite.repo.Get(ctx, o.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(order.ReachedClient, fetched.Status)
+}
+
+func (suite *OrderRepositoryTestSuite) TestPickupCodes() {
+	ctx := context.Background()
+	storage := db.SetupPickupCodeStorage(suite.db)
+	c := &verification.PickupCode{
+		ClientID:      basetypes.ID(gofakeit.Int64()),
+		PickupPointID: 1,
+		Hash:          "hash",
+		Salt:          "salt",
+	}
+
+	suite.Require().NoError(storage.SavePickupCode(ctx, c))
+	attempts, err := storage.AddFailedAttempt(ctx, c.ClientID, c.PickupPointID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint(1), attempts)
+
+	until := time.Now().Add(time.Minute).UTC().Truncate(time.Microsecond)
+	suite.Require().NoError(storage.LockPickupCode(ctx, c.ClientID, c.PickupPointID, until))
+	fetched, err := storage.GetPickupCode(ctx, c.ClientID, c.PickupPointID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint(0), fetched.Attempts)
+	suite.Require().True(until.Equal(*fetched.LockedUntil))
+
+	// saving a new code resets the attempts and the lock
+	suite.Require().NoError(storage.SavePickupCode(ctx, c))
+	fetched, err = storage.GetPickupCode(ctx, c.ClientID, c.PickupPointID)
+	suite.Require().NoError(err)
+	suite.Require().Nil(fetched.LockedUntil)
+
+	suite.Require().NoError(storage.DeletePickupCode(ctx, c.ClientID, c.PickupPointID))
+	_, err = storage.GetPickupCode(ctx, c.ClientID, c.PickupPointID)
+	suite.Require().ErrorIs(err, verification.ErrPickupCodeNotFound)
+}
+
+func (suite *OrderRepositoryTestSuite) TestNotifications() {
+	ctx := context.Background()
+	storage := db.SetupNotificationStorage(suite.db)
+
+	accepted := generateFakeOrder()
+	accepted.Status = order.Stored
+	accepted.StatusUpdated = time.Now()
+	_, err := suite.repo.AddOrUpdate(ctx, accepted, order.Event{OrderID: accepted.ID, Operation: notify.KindAccept})
+	suite.Require().NoError(err)
+
+	expiring := generateFakeOrder()
+	expiring.Status = order.Stored
+	expiring.StatusUpdated = time.Now().Add(-time.Hour)
+	_, err = suite.repo.AddOrUpdate(ctx, expiring)
+	suite.Require().NoError(err)
+
+	scheduled, err := storage.ScheduleExpiryReminders(ctx, 2*time.Hour, time.Now().Add(2*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, scheduled)
+	scheduled, err = storage.ScheduleExpiryReminders(ctx, 2*time.Hour, time.Now().Add(2*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Zero(scheduled)
+
+	pending, err := storage.GetPendingNotifications(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(pending, 3)
+	suite.Require().Equal(accepted.ID, pending[0].OrderID)
+	suite.Require().Equal(notify.KindAccept, pending[0].Kind)
+
+	retryAt := time.Now().Add(time.Hour)
+	suite.Require().NoError(storage.MarkNotificationFailed(ctx, pending[0].ID, &retryAt, "unavailable"))
+	suite.Require().NoError(storage.MarkNotificationSent(ctx, pending[1].ID))
+	suite.Require().NoError(storage.CancelNotification(ctx, pending[2].ID, "issued"))
+
+	pending, err = storage.GetPendingNotifications(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pending)
+}
+
+func (suite *OrderRepositoryTestSuite) TestGetExpiredIDs() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	expired, fresh, ownDeadline := generateFakeOrder(), generateFakeOrder(), generateFakeOrder()
+	for _, o := range []*order.Order{expired, fresh, ownDeadline} {
+		o.Status = order.Stored
+	}
+	expired.StatusUpdated = now.Add(-2 * time.Hour)
+	fresh.StatusUpdated = now
+	ownDeadline.StatusUpdated = now
+	ownDeadline.ExpiresAt = ptr(now.Add(-time.Minute))
+	suite.Require().NoError(suite.repo.AddOrUpdateList(ctx, []*order.Order{expired, fresh, ownDeadline}))
+
+	ids, err := suite.repo.GetExpiredIDs(ctx, time.Hour, now, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]basetypes.ID{expired.ID, ownDeadline.ID}, ids)
+}
+
+func (suite *OrderRepositoryTestSuite) TestAdvisoryLock() {
+	ctx := context.Background()
+	first, second := db.NewAdvisoryLock(suite.db, 42), db.NewAdvisoryLock(suite.db, 42)
+
+	locked, err := first.TryLock(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(locked)
+
+	locked, err = second.TryLock(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(locked)
+
+	suite.Require().NoError(first.Unlock(ctx))
+	locked, err = second.TryLock(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(locked)
+	suite.Require().NoError(second.Unlock(ctx))
+}
+
+func (suite *OrderRepositoryTestSuite) TestPartitionManager() {
+	ctx := context.Background()
+	now := time.Date(2030, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	_, err := suite.db.Exec(ctx,
+		"CREATE TABLE IF NOT EXISTS orders_archive_202701 PARTITION OF orders_archive FOR VALUES FROM ('2027-01-01T00:00:00Z') TO ('2027-02-01T00:00:00Z')")
+	suite.Require().NoError(err)
+
+	lock := db.NewAdvisoryLock(suite.db, 43)
+	defer lock.Unlock(ctx)
+
+	m := db.NewPartitionManager(suite.db, lock, time.Hour,
+		db.PartitionedTable{Name: "orders_archive", Ahead: 1, Retention: 365 * 24 * time.Hour, DetachNonEmpty: true})
+	suite.Require().NoError(m.Maintain(ctx, now))
+
+	partitions := func() []string {
+		var names []string
+		err := pgxscan.Select(ctx, suite.db, &names,
+			"SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid WHERE i.inhparent = 'orders_archive'::regclass")
+		suite.Require().NoError(err)
+		return names
+	}
+	suite.Require().Subset(partitions(), []string{"orders_archive_203003", "orders_archive_203004"})
+	suite.Require().NotContains(partitions(), "orders_archive_202701")
+
+	for _, p := range []string{"orders_archive_202701", "orders_archive_203003", "orders_archive_203004"} {
+		_, err = suite.db.Exec(ctx, "DROP TABLE "+p)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *OrderRepositoryTestSuite) TestOrdersStayInCreationPartition() {
+	ctx := context.Background()
+	o := generateFakeOrder()
+	o.Status = order.Stored
+	_, err := suite.repo.AddOrUpdate(ctx, o)
+	suite.Require().NoError(err)
+
+	o.SetStatus(order.ReachedClient)
+	_, err = suite.repo.AddOrUpdate(ctx, o)
+	suite.Require().NoError(err)
+
+	var partition string
+	err = suite.db.QueryRow(ctx, "SELECT tableoid::regclass::text FROM orders WHERE id = $1", o.ID).Scan(&partition)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fmt.Sprintf("orders_%s", time.Now().UTC().Format("200601")), partition)
+
+	// the ID stays unique at the DB level whatever the creation time of a copy
+	_, err = suite.db.Exec(ctx, `
+		INSERT INTO orders (id, client_id, pickup_point_id, status, status_updated, version, created_at)
+		VALUES ($1, 1, 1, 'stored', NOW(), 1, NOW() - INTERVAL '1 month')`, o.ID)
+	suite.Require().Error(err)
+}
+
+func (suite *OrderRepositoryTestSuite) TestOutboxBackoffAndDeadLetter() {
+	ctx := context.Background()
+	storage := db.SetupOutboxStorage(suite.db)
+
+	_, err := suite.db.Exec(ctx, `INSERT INTO outbox (key, payload) VALUES ('1', '"accept"'), ('1', '"issue"'), ('2', '"accept"')`)
+	suite.Require().NoError(err)
+
+	messages, err := storage.GetPendingMessages(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 3)
+	first := messages[0]
+
+	// the following message with the key waits for the failed one
+	suite.Require().NoError(storage.MarkFailed(ctx, first.ID, time.Hour))
+	messages, err = storage.GetPendingMessages(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 1)
+	suite.Require().Equal("2", messages[0].Key)
+
+	suite.Require().NoError(storage.MarkDead(ctx, first.ID))
+	messages, err = storage.GetPendingMessages(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 2)
+	suite.Require().Equal("1", messages[0].Key)
+	suite.Require().NotEqual(first.ID, messages[0].ID)
+
+	var attempts int
+	err = suite.db.QueryRow(ctx, "SELECT attempts FROM outbox WHERE id = $1 AND dead_at IS NOT NULL", first.ID).Scan(&attempts)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, attempts)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func (suite *OrderRepositoryTestSuite) TestAddHandover() {
+	ctx := context.Background()
+	returned, stored := generateFakeOrder(), generateFakeOrder()
+	returned.Status, stored.Status = order.Returned, order.Stored
+	suite.Require().NoError(suite.repo.AddOrUpdateList(ctx, []*order.Order{returned, stored}))
+
+	h := &order.Handover{CourierID: 1, SignedOffAt: time.Now().UTC().Truncate(time.Microsecond), OrderIDs: []basetypes.ID{returned.ID, stored.ID}}
+	err := suite.repo.AddHandover(ctx, h, func(found []*order.Order) ([]*order.Order, []order.Event, error) {
+		suite.Require().Len(found, 2)
+		for _, o := range found {
+			if o.ID == returned.ID {
+				o.SetStatus(order.Canceled)
+				return []*order.Order{o}, nil, nil
+			}
+		}
+		return nil, nil, nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotZero(h.ID)
+	suite.Require().Equal([]basetypes.ID{returned.ID}, h.OrderIDs)
+
+	fetched, err := suite.repo.GetHandover(ctx, h.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(h.OrderIDs, fetched.OrderIDs)
+	suite.Require().True(h.SignedOffAt.Equal(fetched.SignedOffAt))
+
+	o, err := suite.repo.Get(ctx, returned.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(order.Canceled, o.Status)
+}